@@ -22,6 +22,7 @@ import (
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/policy/rbac"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tracing"
 	"github.com/open-edge-platform/infra-managers/host/internal/hostmgr/handlers"
+	"github.com/open-edge-platform/infra-managers/host/pkg/alivemgr"
 	"github.com/open-edge-platform/infra-managers/host/pkg/config"
 	"github.com/open-edge-platform/infra-managers/host/pkg/hostmgr"
 )
@@ -50,13 +51,85 @@ var (
 		hostmgr.AllowHostDiscoveryValue,
 		hostmgr.AllowHostDiscoveryDescription,
 	)
+	hostDiscoveryRate = flag.Float64(
+		hostmgr.HostDiscoveryRate,
+		hostmgr.HostDiscoveryRateValue,
+		hostmgr.HostDiscoveryRateDescription,
+	)
+	hostDiscoveryBurst = flag.Int(
+		hostmgr.HostDiscoveryBurst,
+		hostmgr.HostDiscoveryBurstValue,
+		hostmgr.HostDiscoveryBurstDescription,
+	)
 	disabledProvisioning = flag.Bool(
 		hostmgr.DisabledProvisioning,
 		hostmgr.DisabledProvisioningValue,
 		hostmgr.DisabledProvisioningDescription,
 	)
+	systemInfoApplyParallelism = flag.Int(
+		hostmgr.SystemInfoApplyParallelism,
+		hostmgr.SystemInfoApplyParallelismValue,
+		hostmgr.SystemInfoApplyParallelismDescription,
+	)
+	systemInfoDryRun = flag.Bool(
+		hostmgr.SystemInfoDryRun,
+		hostmgr.SystemInfoDryRunValue,
+		hostmgr.SystemInfoDryRunDescription,
+	)
+	enforceStatusTransitions = flag.Bool(
+		hostmgr.EnforceStatusTransitions,
+		hostmgr.EnforceStatusTransitionsValue,
+		hostmgr.EnforceStatusTransitionsDescription,
+	)
+	identityMismatchPolicy = flag.String(
+		hostmgr.IdentityMismatchPolicy,
+		hostmgr.IdentityMismatchPolicyValue,
+		hostmgr.IdentityMismatchPolicyDescription,
+	)
+	clockSkewThreshold = flag.Duration(
+		hostmgr.ClockSkewThreshold,
+		hostmgr.ClockSkewThresholdValue,
+		hostmgr.ClockSkewThresholdDescription,
+	)
+	operatorRbacRules = flag.String(
+		hostmgr.OperatorRbacRules,
+		hostmgr.OperatorRbacRulesValue,
+		hostmgr.OperatorRbacRulesDescription,
+	)
+	hostStateDir = flag.String(
+		hostmgr.HostStateDir,
+		hostmgr.HostStateDirValue,
+		hostmgr.HostStateDirDescription,
+	)
+	pciDevicesDir = flag.String(
+		hostmgr.PCIDevicesDir,
+		hostmgr.PCIDevicesDirValue,
+		hostmgr.PCIDevicesDirDescription,
+	)
+	hwJournalDir = flag.String(
+		hostmgr.HwJournalDir,
+		hostmgr.HwJournalDirValue,
+		hostmgr.HwJournalDirDescription,
+	)
+	hwJournalMaxEntries = flag.Int(
+		hostmgr.HwJournalMaxEntries,
+		hostmgr.HwJournalMaxEntriesValue,
+		hostmgr.HwJournalMaxEntriesDescription,
+	)
+	labelRules = flag.String(
+		hostmgr.LabelRules,
+		"",
+		hostmgr.LabelRulesDescription,
+	)
+	complianceProfiles = flag.String(
+		hostmgr.ComplianceProfiles,
+		"",
+		hostmgr.ComplianceProfilesDescription,
+	)
+	minAgentVersions     = flag.String(hostmgr.MinAgentVersions, "", hostmgr.MinAgentVersionsDescription)
 	enableAuth           = flag.Bool(rbac.EnableAuth, true, rbac.EnableAuthDescription)
-	rbacRules            = flag.String(rbac.RbacRules, "/rego/authz.rego", rbac.RbacRulesDescription)
+	rbacRules            = flag.String(rbac.RbacRules, hostmgr.RbacRulesValue, rbac.RbacRulesDescription)
+	adminRbacRules       = flag.String(hostmgr.AdminRbacRules, hostmgr.AdminRbacRulesValue, hostmgr.AdminRbacRulesDescription)
 	invCacheUUIDEnable   = flag.Bool(client.InvCacheUUIDEnable, false, client.InvCacheUUIDEnableDescription)
 	invCacheStaleTimeout = flag.Duration(
 		client.InvCacheStaleTimeout, client.InvCacheStaleTimeoutDefault, client.InvCacheStaleTimeoutDescription)
@@ -95,14 +168,20 @@ func SetTracing(traceURL string) func(context.Context) error {
 
 func setOAM(oamAddress string, termChan, readyChan chan bool, wg *sync.WaitGroup) {
 	if oamAddress != "" {
-		// Add oam grpc server
+		lc := net.ListenConfig{}
+		lis, err := lc.Listen(context.Background(), "tcp", oamAddress)
+		if err != nil {
+			zlog.InfraSec().Fatal().Err(err).Msgf("Error listening with TCP on %s", oamAddress)
+		}
+		// Add oam grpc server, it also serves the admin service
 		wg.Add(1)
 		zlog.Info().Msg("initReadinessServer start.")
-		go func() {
-			if err := oam.StartOamGrpcServer(termChan, readyChan, wg, oamAddress, false); err != nil {
-				zlog.InfraSec().InfraErr(err).Msg("Cannot start Host manager OAM gRPC server")
-			}
-		}()
+		go hostmgr.StartOamGrpcSrv(lis, readyChan, termChan, wg,
+			hostmgr.EnableTracing(*enableTracing),
+			hostmgr.EnableAuth(*enableAuth),
+			hostmgr.WithAdminRbacRulesPath(*adminRbacRules),
+			hostmgr.WithOperatorRbacRulesPath(*operatorRbacRules),
+		)
 	}
 }
 
@@ -119,14 +198,34 @@ func main() {
 		TLSCertPath:          *tlsCertPath,
 		InsecureGRPC:         *insecureGrpc,
 		EnableHostDiscovery:  *allowHostDiscovery,
+		HostDiscoveryRate:    *hostDiscoveryRate,
+		HostDiscoveryBurst:   *hostDiscoveryBurst,
 		DisabledProvisioning: *disabledProvisioning,
 		EnableUUIDCache:      *invCacheUUIDEnable,
 		UUIDCacheTTL:         *invCacheStaleTimeout,
 		UUIDCacheTTLOffset:   int(*invCacheStaleTimeoutOffset),
+
+		SystemInfoApplyParallelism: *systemInfoApplyParallelism,
+		SystemInfoDryRun:           *systemInfoDryRun,
+		EnforceStatusTransitions:   *enforceStatusTransitions,
+		MinAgentVersions:           *minAgentVersions,
+		IdentityMismatchPolicy:     *identityMismatchPolicy,
+		ClockSkewThreshold:         *clockSkewThreshold,
+		HostStateDir:               *hostStateDir,
+		PCIDevicesDir:              *pciDevicesDir,
+		HwJournalDir:               *hwJournalDir,
+		HwJournalMaxEntries:        *hwJournalMaxEntries,
+		LabelRulesPath:             *labelRules,
+		ComplianceProfilesPath:     *complianceProfiles,
+
+		DisableCredentialsManagement: *flags.FlagDisableCredentialsManagement,
 	}
 	if err := conf.Validate(); err != nil {
 		zlog.InfraSec().Fatal().Err(err).Msgf("Failed to start due to invalid configuration: %v", conf)
 	}
+	if err := alivemgr.ValidateFlags(); err != nil {
+		zlog.InfraSec().Fatal().Err(err).Msgf("Failed to start due to invalid heartbeat configuration")
+	}
 
 	zlog.Info().Msgf("Starting Host Manager conf %v", conf)
 	// Print a summary of the build
@@ -193,4 +292,6 @@ func main() {
 		hostmgr.WithMetricsAddress(*metricsAddress),
 	)
 	wg.Wait()
+	// persists the state of the hosts once the servers are stopped
+	hostmgr.CloseHostData()
 }
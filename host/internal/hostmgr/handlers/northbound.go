@@ -18,8 +18,16 @@ import (
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/validator"
 	"github.com/open-edge-platform/infra-managers/host/pkg/alivemgr"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hostcache"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hostmgr"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hoststate"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hwjournal"
 	"github.com/open-edge-platform/infra-managers/host/pkg/invclient"
+	"github.com/open-edge-platform/infra-managers/host/pkg/pcidevice"
+	"github.com/open-edge-platform/infra-managers/host/pkg/secretstore"
+	"github.com/open-edge-platform/infra-managers/host/pkg/sessionmgr"
 	hrm_status "github.com/open-edge-platform/infra-managers/host/pkg/status"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
 )
 
 // Filter returns true if the event must be processed.
@@ -101,7 +109,7 @@ func (nbh *HostManagerNBHandler) controlLoop(ticker *time.Ticker) {
 			if !nbh.filterEvent(ev.Event) {
 				continue
 			}
-			nbh.reconcileResource(ev.Event.Resource)
+			nbh.reconcileResource(ev.Event.EventKind, ev.Event.Resource)
 		case <-ticker.C:
 			// Full periodic reconcile action
 			if err := nbh.reconcileAll(); err != nil {
@@ -133,6 +141,8 @@ func (nbh *HostManagerNBHandler) initializeAliveMgrWithHosts() error {
 		return err
 	}
 
+	// The agents of the running hosts may still be reconnecting after a restart of the Host Manager
+	alivemgr.StartGracePeriod()
 	for _, host := range hosts {
 		if host.GetHostStatus() == hrm_status.HostStatusRunning.Status ||
 			(host.Instance != nil && host.Instance.GetCurrentState() == computev1.InstanceState_INSTANCE_STATE_RUNNING) {
@@ -188,28 +198,43 @@ func (nbh *HostManagerNBHandler) reconcileAll() error {
 	// (or already deleted and therefore not included in hostIDs) will be removed from
 	// the heartbeat map.
 	alivemgr.SyncHosts(hostIDs)
+	// The same applies to the Hosts cached for the southbound handlers
+	hostcache.Sync(hostIDs)
 
 	return nil
 }
 
 // Helper function to reconcile the resources.
-func (nbh *HostManagerNBHandler) reconcileResource(resource *inv_v1.Resource) {
+func (nbh *HostManagerNBHandler) reconcileResource(
+	eventKind inv_v1.SubscribeEventsResponse_EventKind,
+	resource *inv_v1.Resource,
+) {
 	expectedKind := util.GetResourceKindFromResource(resource)
 
+	deleted := eventKind == inv_v1.SubscribeEventsResponse_EVENT_KIND_DELETED
 	switch expectedKind {
 	case inv_v1.ResourceKind_RESOURCE_KIND_HOST:
-		reconcileHost(resource)
+		reconcileHost(resource, deleted)
 	case inv_v1.ResourceKind_RESOURCE_KIND_INSTANCE:
-		monitorInstanceRunning(resource)
+		monitorInstanceRunning(resource, deleted)
 	default:
 		zlog.Debug().Msgf("Unsupported resource kind %s, ignoring", expectedKind)
 	}
 }
 
-func monitorInstanceRunning(resource *inv_v1.Resource) {
+func monitorInstanceRunning(resource *inv_v1.Resource, deleted bool) {
 	instance := resource.GetInstance()
 	host := instance.Host
 
+	// The Instance is cached along with its Host, that has to be read again
+	hostcache.Invalidate(hmgr_util.TenantIDResourceIDTuple{TenantID: instance.GetTenantId(), ResourceID: host.GetResourceId()})
+	// Instance changes may change the action pushed to the agent (e.g., maintenance)
+	sessionmgr.NotifyHostChanged(host)
+	if deleted {
+		// The deleted Instance is reported with its last state, its Host must not be tracked again
+		return
+	}
+
 	// check if host has been provisioned, if so, start checking heartbeat
 	if !alivemgr.IsHostTracked(host) && instance.GetCurrentState() == computev1.InstanceState_INSTANCE_STATE_RUNNING {
 		zlog.Debug().Msgf("Host %s has been provisioned, start monitoring heartbeat", host.GetResourceId())
@@ -220,10 +245,14 @@ func monitorInstanceRunning(resource *inv_v1.Resource) {
 	}
 }
 
-func reconcileHost(resource *inv_v1.Resource) {
+func reconcileHost(resource *inv_v1.Resource, deleted bool) {
 	host := resource.GetHost()
 
 	zlog.Debug().Msgf("Reconciling host (tID=%s, resID=%s)", host.GetTenantId(), host.GetResourceId())
+	// The cached Host is dropped before notifying the session, that reads the Host again
+	hostcache.Invalidate(hmgr_util.NewTenantIDResourceIDTupleFromHost(host))
+	// Let the open session, if any, reload the Host and push the derived action
+	sessionmgr.NotifyHostChanged(host)
 	// current state should be enough but in case of any potential issues/races
 	// it's more robust to use both desired and current states.
 	if host.GetDesiredState() == computev1.HostState_HOST_STATE_UNTRUSTED ||
@@ -234,12 +263,38 @@ func reconcileHost(resource *inv_v1.Resource) {
 			host.GetResourceId())
 		alivemgr.ForgetHost(host)
 	}
+	if deleted {
+		forgetDeletedHost(host)
+	}
+}
+
+// forgetDeletedHost drops the state kept for a Host that has been removed from Inventory.
+func forgetDeletedHost(host *computev1.HostResource) {
+	hostID := hmgr_util.NewTenantIDResourceIDTupleFromHost(host)
+	hostmgr.ForgetStatusUpdates(host.GetTenantId(), host.GetUuid())
+	if err := hwjournal.Default().Delete(hostID); err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Cannot delete the hardware journal of Host %s", hostID)
+	}
+	if err := pcidevice.Default().DeleteHost(hostID); err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Cannot delete the PCI devices of Host %s", hostID)
+	}
+	if err := hoststate.Default().Delete(hostID); err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Cannot delete the state of Host %s", hostID)
+	}
+	for _, key := range []string{secretstore.KubeconfigKey(hostID), secretstore.BmcCredentialsKey(hostID)} {
+		if err := secretstore.Default().Delete(key); err != nil {
+			zlog.InfraSec().InfraErr(err).Msgf("Cannot delete the secret %s of Host %s", key, hostID)
+		}
+	}
 }
 
+// filterHostEvents accepts the deletions too, the deleted Hosts must not be served from the cache.
 func filterHostEvents(event *inv_v1.SubscribeEventsResponse) bool {
-	return event.EventKind == inv_v1.SubscribeEventsResponse_EVENT_KIND_UPDATED
+	return event.EventKind == inv_v1.SubscribeEventsResponse_EVENT_KIND_UPDATED ||
+		event.EventKind == inv_v1.SubscribeEventsResponse_EVENT_KIND_DELETED
 }
 
+// filterInstanceEvents accepts the creations and deletions too, they change the Instance of the cached Host.
 func filterInstanceEvents(event *inv_v1.SubscribeEventsResponse) bool {
-	return event.EventKind == inv_v1.SubscribeEventsResponse_EVENT_KIND_UPDATED
+	return event.EventKind != inv_v1.SubscribeEventsResponse_EVENT_KIND_UNSPECIFIED
 }
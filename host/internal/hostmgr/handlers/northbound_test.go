@@ -11,14 +11,22 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
 	inv_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/inventory/v1"
+	inv_errors "github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
 	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
 	"github.com/open-edge-platform/infra-managers/host/internal/hostmgr/handlers"
 	"github.com/open-edge-platform/infra-managers/host/pkg/alivemgr"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hostcache"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hoststate"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hwjournal"
+	"github.com/open-edge-platform/infra-managers/host/pkg/pcidevice"
+	"github.com/open-edge-platform/infra-managers/host/pkg/secretstore"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
 	test_utils "github.com/open-edge-platform/infra-managers/host/test/utils"
 )
 
@@ -37,6 +45,8 @@ func TestMain(m *testing.M) {
 	migrationsDir := projectRoot + "/out"
 
 	inv_testing.StartTestingEnvironment(policyPath, "", migrationsDir)
+	// Vault is not available in the tests
+	secretstore.SetDefault(secretstore.NewMemoryStore())
 	run := m.Run() // run all tests
 	inv_testing.StopTestingEnvironment()
 
@@ -206,6 +216,19 @@ func TestHostReconcile(t *testing.T) {
 	require.False(t, alivemgr.IsHostTracked(host3T1))
 	require.True(t, alivemgr.IsHostTracked(host1T2))
 
+	host2T1ID := hmgr_util.NewTenantIDResourceIDTupleFromHost(host2T1)
+	require.NoError(t, hwjournal.Default().Record(host2T1ID, time.Now(), []hwjournal.Change{
+		{Kind: hwjournal.ChangeAdded, Component: hwjournal.ComponentDisk, ID: "sda"},
+	}))
+	kubeconfigKey := secretstore.KubeconfigKey(host2T1ID)
+	require.NoError(t, secretstore.Default().Put(kubeconfigKey, []byte("kubeconfig")))
+	require.NoError(t, pcidevice.Default().Put(host2T1ID, pcidevice.Device{Slot: "0000:03:00.0", VendorID: "8086"}))
+	_, err = hoststate.Default().Update(host2T1ID, func(state *hoststate.State) bool {
+		state.TPMEKHash = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+		return true
+	})
+	require.NoError(t, err)
+
 	// delete, generates event
 	dao.HardDeleteHost(t, tenant1, host2T1.GetResourceId())
 
@@ -216,6 +239,19 @@ func TestHostReconcile(t *testing.T) {
 	require.False(t, alivemgr.IsHostTracked(host2T1))
 	require.False(t, alivemgr.IsHostTracked(host3T1))
 	require.True(t, alivemgr.IsHostTracked(host1T2))
+
+	// The hardware journal, the PCI devices, the state and the secrets of the deleted Host are dropped
+	history, err := hwjournal.Default().History(host2T1ID)
+	require.NoError(t, err)
+	assert.Empty(t, history)
+	devices, err := pcidevice.Default().Devices(host2T1ID)
+	require.NoError(t, err)
+	assert.Empty(t, devices)
+	state, err := hoststate.Default().Get(host2T1ID)
+	require.NoError(t, err)
+	assert.Equal(t, hoststate.State{}, state)
+	_, err = secretstore.Default().Get(kubeconfigKey)
+	assert.True(t, inv_errors.IsNotFound(err))
 }
 
 func TestInitializeAliveMgrWithHosts(t *testing.T) {
@@ -313,4 +349,76 @@ func TestReconcileResource(t *testing.T) {
 
 	require.True(t, alivemgr.IsHostTracked(host1T1))
 	require.False(t, alivemgr.IsHostTracked(host2T1))
+
+	// A running Instance starts the tracking of its Host, but not once deleted
+	host3T1 := dao.CreateHost(t, tenant1)
+	insHost3 := dao.CreateInstanceWithOpts(t, tenant1, host3T1, hostOs, false)
+	res.GetInstance().ResourceId = insHost3.GetResourceId()
+	updateCtx, updateCancel := context.WithTimeout(context.Background(), time.Second)
+	defer updateCancel()
+	_, err = inv_testing.TestClients[inv_testing.RMClient].GetTenantAwareInventoryClient().
+		Update(updateCtx, tenant1, insHost3.GetResourceId(), &fmkCurrent, res)
+	require.NoError(t, err)
+	time.Sleep(1 * time.Second)
+	require.True(t, alivemgr.IsHostTracked(host3T1))
+
+	alivemgr.ForgetHost(host3T1)
+	dao.HardDeleteInstance(t, tenant1, insHost3.GetResourceId())
+	time.Sleep(1 * time.Second)
+	require.False(t, alivemgr.IsHostTracked(host3T1))
+}
+
+func TestHostCacheInvalidation(t *testing.T) {
+	dao := inv_testing.NewInvResourceDAOOrFail(t)
+	test_utils.CreateHrmClient(t)
+	err := test_utils.CreateNBHandler(t)
+	require.NoError(t, err)
+
+	host1T1 := dao.CreateHost(t, tenant1)
+	host2T1 := dao.CreateHost(t, tenant1)
+
+	hostcache.SetTTL(time.Minute)
+	t.Cleanup(func() { hostcache.SetTTL(0) })
+	loads := 0
+	getHost := func(host *computev1.HostResource) {
+		t.Helper()
+		_, getErr := hostcache.Get(tenant1, host.GetUuid(), func() (*computev1.HostResource, error) {
+			loads++
+			return host, nil
+		})
+		require.NoError(t, getErr)
+	}
+	getHost(host1T1)
+	getHost(host2T1)
+	require.Equal(t, 2, loads)
+
+	handlers.TickerPeriod = 2 * time.Minute
+	err = test_utils.HostManagerNBHandler.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		test_utils.HostManagerNBHandler.Stop()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	res := &inv_v1.Resource{
+		Resource: &inv_v1.Resource_Host{
+			Host: &computev1.HostResource{
+				ResourceId: host1T1.GetResourceId(),
+				Name:       "renamed-host",
+			},
+		},
+	}
+	fmk := fieldmaskpb.FieldMask{Paths: []string{computev1.HostResourceFieldName}}
+	_, err = inv_testing.TestClients[inv_testing.APIClient].GetTenantAwareInventoryClient().
+		Update(ctx, tenant1, host1T1.GetResourceId(), &fmk, res)
+	require.NoError(t, err)
+
+	// give time to handle event
+	time.Sleep(1 * time.Second)
+
+	// Only the changed Host is read again
+	getHost(host1T1)
+	getHost(host2T1)
+	assert.Equal(t, 3, loads)
 }
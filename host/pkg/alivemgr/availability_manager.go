@@ -3,6 +3,9 @@
 // SPDX-License-Identifier: Apache-2.0
 
 // Package alivemgr implements the availability manager for tracking host heartbeats.
+//
+// Heartbeats are kept in a min-heap ordered by deadline and a single goroutine sleeps until
+// the earliest deadline, so the cost of tracking a host does not depend on the number of hosts.
 package alivemgr
 
 import (
@@ -15,7 +18,6 @@ import (
 	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
-	inv_util "github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
 )
 
@@ -24,7 +26,7 @@ var zlog = logging.GetLogger("HostManagerAlvMgr")
 const (
 	defaultBaseTimeDuration  = 10
 	defaultTimeoutTimes      = 3
-	defaultLoseConnHostsChan = 10
+	defaultLoseConnQueueSize = 10000
 )
 
 var (
@@ -43,22 +45,48 @@ var (
 		false,
 		"Flag to enable dynamic time out based on runtime heartbeat duration.",
 	)
-	onceOverflowChecker sync.Once
-	aliveTimeout        int64
+	loseConnQueueSize = flag.Int(
+		"loseConnQueueSize",
+		defaultLoseConnQueueSize,
+		"Flag to set the size of the queue of hosts that lost the connection, waiting to be updated in Inventory.",
+	)
+	onceSettings sync.Once
 )
 
 var alvMgr = aliverMgr{
-	hostHeartbeatMap:  sync.Map{},
-	loseConnHostsChan: make(chan util.TenantIDResourceIDTuple, defaultLoseConnHostsChan),
+	hostHeartbeatMap: make(map[util.TenantIDResourceIDTuple]*heartbeat),
+	wakeup:           make(chan struct{}, 1),
 }
 
 type aliverMgr struct {
-	hostHeartbeatMap  sync.Map
+	// lock protects the heartbeat map, the expiry heap and the heartbeats themselves
+	lock             sync.Mutex
+	hostHeartbeatMap map[util.TenantIDResourceIDTuple]*heartbeat
+	expiries         expiryHeap
+	// wakeup notifies the expiry loop that the earliest deadline has changed
+	wakeup            chan struct{}
+	onceQueue         sync.Once
 	loseConnHostsChan chan util.TenantIDResourceIDTuple
+	// settings are loaded from the flags on first use, they can be changed at runtime
+	settings Settings
+	// graceUntil is the end of the startup grace period, no heartbeat expires before
+	graceUntil time.Time
+	// storms detects the connection losses of a large share of the hosts of a tenant or site
+	storms *StormDetector
 }
 
 // StartAlvMgr starts the availability manager for tracking host heartbeats.
+// It returns the bounded queue of hosts that lost their heartbeat.
 func StartAlvMgr(termChan chan bool) chan util.TenantIDResourceIDTuple {
+	// Flags are parsed after the package initialization, the queue is allocated on start
+	alvMgr.onceQueue.Do(func() {
+		queueSize := *loseConnQueueSize
+		if queueSize <= 0 {
+			queueSize = defaultLoseConnQueueSize
+		}
+		alvMgr.loseConnHostsChan = make(chan util.TenantIDResourceIDTuple, queueSize)
+	})
+
 	go func(termChan chan bool) {
 		zlog.InfraSec().Info().Msg("Start Availability Manager")
 		initAlvMgr(termChan)
@@ -70,128 +98,149 @@ func StartAlvMgr(termChan chan bool) chan util.TenantIDResourceIDTuple {
 func initAlvMgr(termChan chan bool) {
 	zlog.InfraSec().Info().Msg("initial Availability Manager.")
 	for {
-		zlog.Debug().Msgf("Alv Mgr will checkout timers after %s.", time.Duration(*baseTimeDuration)*time.Second)
+		expired, next, hasNext := alvMgr.expire(clockNow())
+		for _, key := range expired {
+			zlog.Info().Msgf("%s lost heartbeat!", key)
+			// Blocking on a full queue applies back pressure, no expiry is dropped
+			select {
+			case alvMgr.loseConnHostsChan <- key:
+			case <-termChan:
+				return
+			}
+		}
+
+		var timerChan <-chan time.Time
+		if hasNext {
+			zlog.Debug().Msgf("Alv Mgr will checkout timers at %s.", next)
+			timerChan = getClock().WaitUntil(next)
+		}
 		select {
 		case <-termChan:
 			return
-		default:
-			alvMgr.hostHeartbeatMap.Range(func(key any, value any) bool {
-				tenantRes, ok := key.(util.TenantIDResourceIDTuple)
-				if !ok {
-					zlog.Error().Msgf("unexpected type for TenantIDResourceIDTuple: %T", key)
-					return true
-				}
-				hostHb, ok := value.(*heartbeat)
-				if !ok {
-					zlog.Error().Msgf("unexpected type for heartbeat: %T", value)
-					return true
-				}
-				go checkHeartbeat(tenantRes, hostHb, alvMgr.loseConnHostsChan)
-
-				return true
-			})
+		case <-alvMgr.wakeup:
+		case <-timerChan:
 		}
-
-		time.Sleep(time.Duration(*baseTimeDuration) * time.Second)
 	}
 }
 
-type heartbeat struct {
-	lastTimestamp int64
-	baseTimeOut   int64
-	timeout       time.Duration // int64
-	timer         *time.Timer
-	lock          sync.Mutex
+// expire marks as expired and unschedules all heartbeats whose deadline has passed.
+// It returns their keys together with the next deadline, if any.
+// During the startup grace period nothing expires, the next deadline is postponed to its end.
+func (am *aliverMgr) expire(now time.Time) (expired []util.TenantIDResourceIDTuple, next time.Time, hasNext bool) {
+	am.lock.Lock()
+	defer am.lock.Unlock()
+	if now.Before(am.graceUntil) {
+		next, hasNext = am.expiries.next()
+		if hasNext && next.Before(am.graceUntil) {
+			next = am.graceUntil
+		}
+		return nil, next, hasNext
+	}
+	for _, hb := range am.expiries.popExpired(now) {
+		if !hb.expired {
+			am.stormDetector().Lost(hb.key.TenantID, hb.siteID, now)
+		}
+		hb.expired = true
+		expired = append(expired, hb.key)
+	}
+	next, hasNext = am.expiries.next()
+	return expired, next, hasNext
 }
 
-func newheartbeat() *heartbeat {
-	// Avoid logging overflow error multiple times for the alive timeout
-	onceOverflowChecker.Do(func() {
-		err := error(nil)
-		aliveTimeout, err = inv_util.MulInt64(int64(*timeoutTimes), *baseTimeDuration)
-		if err != nil {
-			zlog.InfraSec().Err(err).Msgf("continuing with default timeout values")
-			// We assume default values do not cause overflow
-			aliveTimeout = defaultTimeoutTimes * defaultBaseTimeDuration
+// schedule (re)schedules the heartbeat and wakes the expiry loop up if it becomes the earliest deadline.
+// Must be called with the lock held.
+func (am *aliverMgr) schedule(hb *heartbeat) {
+	am.expiries.schedule(hb)
+	if am.expiries[0] == hb {
+		select {
+		case am.wakeup <- struct{}{}:
+		default:
 		}
-	})
-
-	timeoutDuration := time.Duration(aliveTimeout) * time.Second
-	return &heartbeat{
-		lastTimestamp: time.Now().UTC().Unix(),
-		baseTimeOut:   *baseTimeDuration,
-		timeout:       timeoutDuration,
-		timer:         time.NewTimer(timeoutDuration),
-		lock:          sync.Mutex{},
 	}
 }
 
-func checkHeartbeat(key util.TenantIDResourceIDTuple, hostHb *heartbeat, timeoutChan chan util.TenantIDResourceIDTuple) {
-	if hostHb == nil {
-		return
-	}
-	// Avoid data race between the check heartbeat and the reset timer
-	hostHb.lock.Lock()
-	defer hostHb.lock.Unlock()
-	if hostHb.timer == nil {
-		return
-	}
-	select {
-	default:
-		zlog.Debug().Msgf("Check heartbeat of %s, the timer is not time out.", key)
-	case <-hostHb.timer.C:
-		zlog.Info().Msgf("%s lost heartbeat!", key)
-		hostHb.timer = nil
-		timeoutChan <- key
+// remove stops tracking the heartbeat of the given host. Must be called with the lock held.
+func (am *aliverMgr) remove(hbk util.TenantIDResourceIDTuple) {
+	if hb, ok := am.hostHeartbeatMap[hbk]; ok {
+		am.expiries.unschedule(hb)
+		delete(am.hostHeartbeatMap, hbk)
+		am.stormDetector().Untrack(hbk.TenantID, hb.siteID, clockNow())
 	}
 }
 
-func (hb *heartbeat) resetTimer() {
-	hb.updateDuration()
+type heartbeat struct {
+	key           util.TenantIDResourceIDTuple
+	lastHeartbeat time.Time
+	timeout       time.Duration // int64
+	staticTimeout time.Duration
+	siteID        string
+	detector      *PhiAccrualDetector
+	deadline      time.Time
+	expired       bool
+	// index in the expiry heap, -1 if not scheduled
+	index int
+}
 
-	// Avoid data race between the reset timer and the check heartbeat
-	hb.lock.Lock()
-	defer hb.lock.Unlock()
-	zlog.Debug().Msgf("Debug resetTimer %v, %s.", hb.timer, hb.timeout)
-	if hb.timer == nil {
-		zlog.Debug().Msgf("Create new timer with timeout %s.", hb.timeout)
-		hb.timer = time.NewTimer(hb.timeout)
-	} else {
-		zlog.Debug().Msgf("Reset timer %v with timeout %s.", hb.timer, hb.timeout)
-		hb.timer.Reset(hb.timeout)
+func newheartbeat(
+	hbk util.TenantIDResourceIDTuple, host *computev1.HostResource, timeout time.Duration, now time.Time,
+) *heartbeat {
+	detector := NewPhiAccrualDetector(defaultMaxSampleSize, defaultMinStdDeviation)
+	detector.Heartbeat(now)
+	return &heartbeat{
+		key:           hbk,
+		lastHeartbeat: now,
+		timeout:       timeout,
+		staticTimeout: timeout,
+		siteID:        host.GetSite().GetResourceId(),
+		detector:      detector,
+		deadline:      now.Add(timeout),
+		index:         -1,
 	}
 }
 
-func (hb *heartbeat) updateTimeStamp() {
-	hb.lastTimestamp = time.Now().UTC().Unix()
+// resetTimer moves the deadline of the heartbeat. Must be called with the lock held.
+func (hb *heartbeat) resetTimer(now time.Time, dynamic bool) {
+	hb.updateDuration(now, dynamic)
+	zlog.Debug().Msgf("Reset deadline of %s with timeout %s.", hb.key, hb.timeout)
+	hb.deadline = now.Add(hb.timeout)
+	hb.expired = false
 }
 
-func (hb *heartbeat) updateDuration() {
-	// Placeholder for dynamic timeout
-	if *dynamicTimeOut {
-		zlog.Debug().Msg("Dynamic timeout is unimplemented")
+func (hb *heartbeat) updateTimeStamp(now time.Time) {
+	hb.lastHeartbeat = now
+}
+
+// updateDuration records the heartbeat arrival and, if dynamic timeout is enabled,
+// derives the new timeout from the suspicion level of the host. Must be called with the lock held.
+func (hb *heartbeat) updateDuration(now time.Time, dynamic bool) {
+	hb.detector.Heartbeat(now)
+	if !dynamic {
+		hb.timeout = hb.staticTimeout
+		return
 	}
+	hb.timeout = dynamicTimeout(hb.detector, hb.siteID, hb.staticTimeout)
+	zlog.Debug().Msgf("Dynamic timeout of site %q updated to %s after %d samples",
+		hb.siteID, hb.timeout, hb.detector.Samples())
 }
 
 // UpdateHostHeartBeat updates the heartbeat timestamp for a host.
 func UpdateHostHeartBeat(host *computev1.HostResource) error {
 	hbk := util.NewTenantIDResourceIDTupleFromHost(host)
-	value, has := alvMgr.hostHeartbeatMap.Load(hbk)
+	now := clockNow()
 
+	alvMgr.lock.Lock()
+	defer alvMgr.lock.Unlock()
+	settings := alvMgr.currentSettings()
+	hostHeartbeat, has := alvMgr.hostHeartbeatMap[hbk]
 	if has {
-		// Based on the mechanism of sync.Map.Load,
-		// hostHeartbeat will be an un-nil heartbeat pointer in this condition.
-		hostHeartbeat, ok := value.(*heartbeat)
-		if !ok {
-			zlog.InfraSec().InfraError("casting heartbeat pointer has failed").Msg("UpdateHostHeartBeat")
-			return errors.Errorfc(codes.Internal, "casting heartbeat pointer has failed")
-		}
-
-		hostHeartbeat.resetTimer()
-		hostHeartbeat.updateTimeStamp()
+		hostHeartbeat.resetTimer(now, settings.DynamicTimeout)
+		hostHeartbeat.updateTimeStamp(now)
 	} else {
-		alvMgr.hostHeartbeatMap.Store(hbk, newheartbeat())
+		hostHeartbeat = newheartbeat(hbk, host, settings.Timeout(), now)
+		alvMgr.hostHeartbeatMap[hbk] = hostHeartbeat
+		alvMgr.stormDetector().Track(hbk.TenantID, hostHeartbeat.siteID)
 	}
+	alvMgr.schedule(hostHeartbeat)
 
 	return nil
 }
@@ -199,7 +248,9 @@ func UpdateHostHeartBeat(host *computev1.HostResource) error {
 // ForgetHost removes a host from the heartbeat tracking map.
 func ForgetHost(host *computev1.HostResource) {
 	hbk := util.NewTenantIDResourceIDTupleFromHost(host)
-	alvMgr.hostHeartbeatMap.Delete(hbk)
+	alvMgr.lock.Lock()
+	alvMgr.remove(hbk)
+	alvMgr.lock.Unlock()
 	zlog.Debug().Msgf("Host %s has been removed from the heartbeat list", hbk)
 }
 
@@ -213,52 +264,38 @@ func SyncHosts(desiredHostsList []util.TenantIDResourceIDTuple) {
 		hbksMap[host] = struct{}{}
 	}
 
-	hbksToRemove := make([]util.TenantIDResourceIDTuple, 0)
-	alvMgr.hostHeartbeatMap.Range(func(key, _ any) bool {
-		zlog.Debug().Msgf("host %s", key)
-		hbk, ok := key.(util.TenantIDResourceIDTuple)
-		if !ok {
-			return true
-		}
+	alvMgr.lock.Lock()
+	defer alvMgr.lock.Unlock()
+	for hbk := range alvMgr.hostHeartbeatMap {
 		if _, exists := hbksMap[hbk]; !exists {
 			zlog.Debug().Msgf("Host %s doesn't exist in desired host lists, removing from heartbeat map",
 				hbk)
-			hbksToRemove = append(hbksToRemove, hbk)
+			alvMgr.remove(hbk)
 		}
-		return true
-	})
-
-	for _, hbk := range hbksToRemove {
-		alvMgr.hostHeartbeatMap.Delete(hbk)
 	}
 }
 
 // IsHostTracked checks if host is tracked by availability manager. Currently, used for testing only.
 func IsHostTracked(host *computev1.HostResource) bool {
-	_, exists := alvMgr.hostHeartbeatMap.Load(util.NewTenantIDResourceIDTupleFromHost(host))
+	alvMgr.lock.Lock()
+	defer alvMgr.lock.Unlock()
+	_, exists := alvMgr.hostHeartbeatMap[util.NewTenantIDResourceIDTupleFromHost(host)]
 	return exists
 }
 
 // GetHostHeartBeat retrieves the heartbeat information for a host.
 func GetHostHeartBeat(host *computev1.HostResource) (bool, error) {
 	hbk := util.NewTenantIDResourceIDTupleFromHost(host)
-	value, has := alvMgr.hostHeartbeatMap.Load(hbk)
-
-	if has {
-		// Based on the mechanism of sync.Map.Load,
-		// hostHeartbeat will be an un-nil heartbeat pointer in this condition.
-		hostHeartbeat, ok := value.(*heartbeat)
-		if !ok {
-			zlog.InfraSec().InfraError("casting heartbeat pointer has failed").Msg("UpdateHostHeartBeat")
-			return false, errors.Errorfc(codes.Internal, "casting heartbeat pointer has failed")
-		}
-
-		if hostHeartbeat.timer != nil {
-			zlog.Debug().Msgf("Check heartbeat of %s, the timer is not time out.", hbk)
-			return true, nil
-		}
-		return false, nil
+	alvMgr.lock.Lock()
+	defer alvMgr.lock.Unlock()
+	hostHeartbeat, has := alvMgr.hostHeartbeatMap[hbk]
+	if !has {
+		return false, errors.Errorfc(codes.Internal, "host has no heartbeat")
 	}
 
-	return false, errors.Errorfc(codes.Internal, "host has no heartbeat")
+	if !hostHeartbeat.expired {
+		zlog.Debug().Msgf("Check heartbeat of %s, the timer is not time out.", hbk)
+		return true, nil
+	}
+	return false, nil
 }
@@ -6,10 +6,14 @@ package alivemgr_test
 
 import (
 	"errors"
+	"fmt"
+	"runtime"
+	"sync/atomic"
 	"testing"
 
 	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
 	"github.com/open-edge-platform/infra-managers/host/pkg/alivemgr"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
 )
 
 func TestStartAlvMgr(t *testing.T) {
@@ -66,3 +70,75 @@ func TestUpdateHostHeartBeat(t *testing.T) {
 		})
 	}
 }
+
+const benchmarkHosts = 100000
+
+func benchmarkHostList(b *testing.B) []*computev1.HostResource {
+	b.Helper()
+	hosts := make([]*computev1.HostResource, benchmarkHosts)
+	for i := range hosts {
+		hosts[i] = &computev1.HostResource{
+			ResourceId: fmt.Sprintf("host-%08x", i),
+			TenantId:   "11111111-1111-1111-1111-111111111111",
+		}
+		if err := alivemgr.UpdateHostHeartBeat(hosts[i]); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.Cleanup(func() {
+		alivemgr.SyncHosts(nil)
+	})
+	return hosts
+}
+
+// BenchmarkUpdateHostHeartBeat_100kHosts measures a heartbeat while 100k hosts are tracked,
+// with the expiry loop running. Goroutines are reported to show they do not grow with the hosts.
+func BenchmarkUpdateHostHeartBeat_100kHosts(b *testing.B) {
+	termChan := make(chan bool)
+	defer close(termChan)
+	alivemgr.StartAlvMgr(termChan)
+	hosts := benchmarkHostList(b)
+	goroutines := runtime.NumGoroutine()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := alivemgr.UpdateHostHeartBeat(hosts[i%benchmarkHosts]); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	b.ReportMetric(float64(runtime.NumGoroutine()-goroutines), "extra-goroutines")
+}
+
+// BenchmarkUpdateHostHeartBeat_100kHostsParallel measures concurrent heartbeats from 100k hosts.
+func BenchmarkUpdateHostHeartBeat_100kHostsParallel(b *testing.B) {
+	hosts := benchmarkHostList(b)
+	var next atomic.Int64
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if err := alivemgr.UpdateHostHeartBeat(hosts[next.Add(1)%benchmarkHosts]); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+}
+
+// BenchmarkSyncHosts_100kHosts measures the periodic reconciliation of 100k tracked hosts.
+func BenchmarkSyncHosts_100kHosts(b *testing.B) {
+	hosts := benchmarkHostList(b)
+	desired := make([]util.TenantIDResourceIDTuple, 0, len(hosts))
+	for _, host := range hosts {
+		desired = append(desired, util.NewTenantIDResourceIDTupleFromHost(host))
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		alivemgr.SyncHosts(desired)
+	}
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package alivemgr
+
+import (
+	"container/heap"
+	"time"
+)
+
+// expiryHeap is a min-heap of heartbeats ordered by deadline, it implements heap.Interface.
+// Each heartbeat keeps its own index so that it can be fixed or removed in O(log n).
+type expiryHeap []*heartbeat
+
+func (h expiryHeap) Len() int { return len(h) }
+
+func (h expiryHeap) Less(i, j int) bool { return h[i].deadline.Before(h[j].deadline) }
+
+func (h expiryHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *expiryHeap) Push(x any) {
+	hb, ok := x.(*heartbeat)
+	if !ok {
+		zlog.Error().Msgf("unexpected type for heartbeat: %T", x)
+		return
+	}
+	hb.index = len(*h)
+	*h = append(*h, hb)
+}
+
+func (h *expiryHeap) Pop() any {
+	old := *h
+	n := len(old)
+	hb := old[n-1]
+	old[n-1] = nil
+	hb.index = -1
+	*h = old[:n-1]
+	return hb
+}
+
+// schedule adds the heartbeat to the heap or moves it to its new deadline.
+func (h *expiryHeap) schedule(hb *heartbeat) {
+	if hb.index >= 0 {
+		heap.Fix(h, hb.index)
+		return
+	}
+	heap.Push(h, hb)
+}
+
+// unschedule removes the heartbeat from the heap, if present.
+func (h *expiryHeap) unschedule(hb *heartbeat) {
+	if hb.index >= 0 {
+		heap.Remove(h, hb.index)
+	}
+}
+
+// next returns the earliest deadline, false if the heap is empty.
+func (h expiryHeap) next() (time.Time, bool) {
+	if len(h) == 0 {
+		return time.Time{}, false
+	}
+	return h[0].deadline, true
+}
+
+// popExpired removes and returns all heartbeats whose deadline is not after now.
+func (h *expiryHeap) popExpired(now time.Time) []*heartbeat {
+	var expired []*heartbeat
+	for len(*h) > 0 && !(*h)[0].deadline.After(now) {
+		hb, ok := heap.Pop(h).(*heartbeat)
+		if !ok {
+			continue
+		}
+		expired = append(expired, hb)
+	}
+	return expired
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package alivemgr_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	"github.com/open-edge-platform/infra-managers/host/pkg/alivemgr"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+// collectLost returns the next n hosts of the test reported as lost, in the order they are reported.
+func collectLost(t *testing.T, lostHosts chan util.TenantIDResourceIDTuple, n int) []string {
+	t.Helper()
+	var lost []string
+	for len(lost) < n {
+		select {
+		case hbk := <-lostHosts:
+			if hbk.TenantID == graceTenant {
+				lost = append(lost, hbk.ResourceID)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("only %v have been reported as lost", lost)
+		}
+	}
+	return lost
+}
+
+func TestExpiry_DeadlineOrder(t *testing.T) {
+	clock := newFakeClock(t)
+	termChan := make(chan bool)
+	defer close(termChan)
+	lostHosts := alivemgr.StartAlvMgr(termChan)
+	timeout := alivemgr.GetSettings().Timeout()
+
+	hosts := make([]*computev1.HostResource, 4)
+	for i, resourceID := range []string{"host-88888891", "host-88888892", "host-88888893", "host-88888894"} {
+		hosts[i] = &computev1.HostResource{ResourceId: resourceID, TenantId: graceTenant}
+		t.Cleanup(func() { alivemgr.ForgetHost(hosts[i]) })
+	}
+	// Scheduled in reverse order of registration, the earliest deadline first
+	for _, i := range []int{2, 0, 3, 1} {
+		require.NoError(t, alivemgr.UpdateHostHeartBeat(hosts[i]))
+		clock.Advance(time.Second)
+	}
+
+	// All expire at once, they are reported by deadline
+	clock.Advance(timeout)
+	assert.Equal(t, []string{"host-88888893", "host-88888891", "host-88888894", "host-88888892"},
+		collectLost(t, lostHosts, 4))
+	expectNoneLost(t, lostHosts)
+}
+
+func TestExpiry_RescheduleAndUnschedule(t *testing.T) {
+	clock := newFakeClock(t)
+	termChan := make(chan bool)
+	defer close(termChan)
+	lostHosts := alivemgr.StartAlvMgr(termChan)
+	timeout := alivemgr.GetSettings().Timeout()
+
+	first := &computev1.HostResource{ResourceId: "host-88888895", TenantId: graceTenant}
+	second := &computev1.HostResource{ResourceId: "host-88888896", TenantId: graceTenant}
+	forgotten := &computev1.HostResource{ResourceId: "host-88888897", TenantId: graceTenant}
+	for _, host := range []*computev1.HostResource{first, second, forgotten} {
+		require.NoError(t, alivemgr.UpdateHostHeartBeat(host))
+		t.Cleanup(func() { alivemgr.ForgetHost(host) })
+		clock.Advance(time.Second)
+	}
+
+	// A new heartbeat moves the first host after the second one, a forgotten host is unscheduled
+	require.NoError(t, alivemgr.UpdateHostHeartBeat(first))
+	alivemgr.ForgetHost(forgotten)
+	tracked := alivemgr.ListTrackedHosts(graceTenant)
+	require.Len(t, tracked, 2)
+	assert.Equal(t, "host-88888896", tracked[0].Host.ResourceID)
+	assert.Equal(t, clock.Now().Add(timeout).UTC(), tracked[1].Deadline)
+
+	clock.Advance(timeout - time.Second)
+	assert.Equal(t, []string{"host-88888896"}, collectLost(t, lostHosts, 1))
+	expectNoneLost(t, lostHosts)
+
+	clock.Advance(time.Second)
+	assert.Equal(t, []string{"host-88888895"}, collectLost(t, lostHosts, 1))
+	expectNoneLost(t, lostHosts)
+}
+
+func TestExpiry_IdleHostsWakeups(t *testing.T) {
+	clock := newFakeClock(t)
+	termChan := make(chan bool)
+	defer close(termChan)
+	lostHosts := alivemgr.StartAlvMgr(termChan)
+	timeout := alivemgr.GetSettings().Timeout()
+
+	const idleHosts = 100000
+	for i := range idleHosts {
+		host := &computev1.HostResource{ResourceId: fmt.Sprintf("host-9%07x", i), TenantId: graceTenant}
+		require.NoError(t, alivemgr.UpdateHostHeartBeat(host))
+		t.Cleanup(func() { alivemgr.ForgetHost(host) })
+	}
+	expectNoneLost(t, lostHosts)
+
+	// The hosts stay idle until their deadline, the expiry loop sleeps until then whatever the number of hosts
+	for range 9 {
+		clock.Advance(timeout / 10)
+		expectNoneLost(t, lostHosts)
+	}
+	clock.Advance(timeout - 9*(timeout/10))
+	assert.Len(t, collectLost(t, lostHosts, idleHosts), idleHosts)
+	expectNoneLost(t, lostHosts)
+
+	// One timer for the first deadline, at most another one for the wakeup of the first registration
+	t.Logf("%d wakeups of the expiry loop for %d idle hosts", clock.Waits(), idleHosts)
+	assert.LessOrEqual(t, clock.Waits(), 2)
+}
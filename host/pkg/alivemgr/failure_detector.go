@@ -0,0 +1,210 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package alivemgr
+
+import (
+	"flag"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+)
+
+const (
+	defaultPhiThreshold       = 8.0
+	defaultDynamicTimeoutMax  = 300
+	defaultMaxSampleSize      = 100
+	defaultMinSamples         = 5
+	defaultMinStdDeviation    = 500 * time.Millisecond
+	maxPhiThreshold           = 300.0
+	siteOverridesSeparator    = ","
+	siteOverrideKeyValueSplit = "="
+)
+
+var (
+	phiThreshold = flag.Float64(
+		"phiThreshold",
+		defaultPhiThreshold,
+		"Flag to set the suspicion level (phi) above which a host is considered lost, used with dynamicTimeOut.",
+	)
+	phiThresholdSiteOverrides = flag.String(
+		"phiThresholdSiteOverrides",
+		"",
+		"Flag to override the phi threshold per site, as a comma separated list of siteID=threshold pairs, "+
+			"used with dynamicTimeOut.",
+	)
+	dynamicTimeoutMax = flag.Int64(
+		"dynamicTimeoutMax",
+		defaultDynamicTimeoutMax,
+		"Flag to set the upper bound of the dynamic time out of node agent heartbeat, the unit is seconds.",
+	)
+	// siteOverrides are parsed from the flags by ValidateFlags
+	siteOverrides map[string]float64
+)
+
+// PhiAccrualDetector implements the phi accrual failure detector for a single host.
+// It keeps a sliding window of heartbeat inter-arrival times and expresses the suspicion
+// that the host is lost as phi = -log10(P(no heartbeat yet)), assuming normally distributed
+// inter-arrival times. Not safe for concurrent use, callers must serialize the access.
+type PhiAccrualDetector struct {
+	intervals       []time.Duration
+	next            int
+	sum             float64
+	squaredSum      float64
+	lastArrival     time.Time
+	maxSampleSize   int
+	minStdDeviation time.Duration
+}
+
+// NewPhiAccrualDetector returns a detector keeping the last maxSampleSize inter-arrival times.
+func NewPhiAccrualDetector(maxSampleSize int, minStdDeviation time.Duration) *PhiAccrualDetector {
+	if maxSampleSize <= 0 {
+		maxSampleSize = defaultMaxSampleSize
+	}
+	return &PhiAccrualDetector{
+		intervals:       make([]time.Duration, 0, maxSampleSize),
+		maxSampleSize:   maxSampleSize,
+		minStdDeviation: minStdDeviation,
+	}
+}
+
+// Heartbeat records the arrival of a heartbeat at the given time.
+func (d *PhiAccrualDetector) Heartbeat(now time.Time) {
+	if !d.lastArrival.IsZero() && now.After(d.lastArrival) {
+		d.addInterval(now.Sub(d.lastArrival))
+	}
+	d.lastArrival = now
+}
+
+func (d *PhiAccrualDetector) addInterval(interval time.Duration) {
+	value := float64(interval)
+	if len(d.intervals) < d.maxSampleSize {
+		d.intervals = append(d.intervals, interval)
+	} else {
+		// Window is full, replace the oldest sample
+		oldest := float64(d.intervals[d.next])
+		d.sum -= oldest
+		d.squaredSum -= oldest * oldest
+		d.intervals[d.next] = interval
+		d.next = (d.next + 1) % d.maxSampleSize
+	}
+	d.sum += value
+	d.squaredSum += value * value
+}
+
+// Samples returns the number of inter-arrival times currently recorded.
+func (d *PhiAccrualDetector) Samples() int {
+	return len(d.intervals)
+}
+
+func (d *PhiAccrualDetector) stats() (mean, stdDeviation float64) {
+	n := float64(len(d.intervals))
+	if n == 0 {
+		return 0, float64(d.minStdDeviation)
+	}
+	mean = d.sum / n
+	variance := d.squaredSum/n - mean*mean
+	stdDeviation = math.Sqrt(math.Max(variance, 0))
+	return mean, math.Max(stdDeviation, float64(d.minStdDeviation))
+}
+
+// Phi returns the suspicion level at the given time, 0 if no interval has been recorded yet.
+func (d *PhiAccrualDetector) Phi(now time.Time) float64 {
+	if len(d.intervals) == 0 {
+		return 0
+	}
+	mean, stdDeviation := d.stats()
+	y := (float64(now.Sub(d.lastArrival)) - mean) / stdDeviation
+	// P(X > t) = erfc(y/sqrt(2))/2 for a normal distribution
+	pLater := math.Erfc(y/math.Sqrt2) / 2
+	if pLater <= 0 {
+		return math.Inf(1)
+	}
+	return -math.Log10(pLater)
+}
+
+// Timeout returns the time elapsed since the last heartbeat after which phi exceeds the given threshold.
+func (d *PhiAccrualDetector) Timeout(threshold float64) time.Duration {
+	mean, stdDeviation := d.stats()
+	// Inverse of Phi: P(X > t) = 10^-threshold
+	y := math.Sqrt2 * math.Erfcinv(2*math.Pow(10, -threshold))
+	return time.Duration(mean + y*stdDeviation)
+}
+
+// ParseSiteOverrides parses a comma separated list of siteID=threshold pairs.
+func ParseSiteOverrides(overrides string) (map[string]float64, error) {
+	parsed := make(map[string]float64)
+	for _, pair := range strings.Split(overrides, siteOverridesSeparator) {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		siteID, value, found := strings.Cut(pair, siteOverrideKeyValueSplit)
+		if !found || strings.TrimSpace(siteID) == "" {
+			return nil, errors.Errorfc(codes.InvalidArgument, "invalid site override: %s", pair)
+		}
+		threshold, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || !isValidPhiThreshold(threshold) {
+			return nil, errors.Errorfc(codes.InvalidArgument, "invalid phi threshold for site %s: %s", siteID, value)
+		}
+		parsed[strings.TrimSpace(siteID)] = threshold
+	}
+	return parsed, nil
+}
+
+// isValidPhiThreshold checks that the threshold has a finite timeout: 10^-threshold has to be positive,
+// and below 1 for a non-negative phi.
+func isValidPhiThreshold(threshold float64) bool {
+	return threshold > 0 && threshold <= maxPhiThreshold
+}
+
+// ValidateFlags checks the phi thresholds of the flags and loads the site overrides, it has to be called
+// once the flags are parsed, before the availability manager is started.
+func ValidateFlags() error {
+	if !isValidPhiThreshold(*phiThreshold) {
+		return errors.Errorfc(codes.InvalidArgument, "invalid phi threshold %v, it should be in (0, %v]",
+			*phiThreshold, maxPhiThreshold)
+	}
+	overrides, err := ParseSiteOverrides(*phiThresholdSiteOverrides)
+	if err != nil {
+		return err
+	}
+	siteOverrides = overrides
+	return nil
+}
+
+// getPhiThreshold returns the phi threshold of the given site.
+func getPhiThreshold(siteID string) float64 {
+	if threshold, ok := siteOverrides[siteID]; ok {
+		return threshold
+	}
+	return *phiThreshold
+}
+
+// dynamicTimeout derives the timeout of a host from its detector. The static timeout is used as
+// a lower bound and until enough samples are collected, so stable hosts keep the previous behavior
+// while hosts with jittery links get a longer window.
+func dynamicTimeout(detector *PhiAccrualDetector, siteID string, staticTimeout time.Duration) time.Duration {
+	if detector.Samples() < defaultMinSamples {
+		return staticTimeout
+	}
+	timeout := detector.Timeout(getPhiThreshold(siteID))
+	maxTimeout := time.Duration(*dynamicTimeoutMax) * time.Second
+	if maxTimeout < staticTimeout {
+		maxTimeout = staticTimeout
+	}
+	switch {
+	case timeout < staticTimeout:
+		return staticTimeout
+	case timeout > maxTimeout:
+		return maxTimeout
+	default:
+		return timeout
+	}
+}
@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package alivemgr_test
+
+import (
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/infra-managers/host/pkg/alivemgr"
+)
+
+func TestPhiAccrualDetector(t *testing.T) {
+	detector := alivemgr.NewPhiAccrualDetector(100, 100*time.Millisecond)
+	start := time.Unix(0, 0)
+	assert.Zero(t, detector.Phi(start))
+
+	last := start
+	for i := 0; i < 10; i++ {
+		detector.Heartbeat(last)
+		last = last.Add(10 * time.Second)
+	}
+	last = last.Add(-10 * time.Second)
+	assert.Equal(t, 9, detector.Samples())
+
+	// Heartbeat on time, half of the distribution is still to come
+	assert.InDelta(t, 0.3, detector.Phi(last.Add(10*time.Second)), 0.01)
+	// Suspicion grows with the time elapsed since the last heartbeat
+	assert.Less(t, detector.Phi(last.Add(10*time.Second)), detector.Phi(last.Add(11*time.Second)))
+	assert.Less(t, detector.Phi(last.Add(11*time.Second)), detector.Phi(last.Add(12*time.Second)))
+
+	// Timeout is the inverse of phi
+	timeout := detector.Timeout(8)
+	assert.Greater(t, timeout, 10*time.Second)
+	assert.InDelta(t, 8, detector.Phi(last.Add(timeout)), 0.01)
+	assert.Greater(t, detector.Timeout(12), timeout)
+}
+
+func TestPhiAccrualDetector_JitterExtendsTimeout(t *testing.T) {
+	stable := alivemgr.NewPhiAccrualDetector(100, 100*time.Millisecond)
+	flaky := alivemgr.NewPhiAccrualDetector(100, 100*time.Millisecond)
+	start := time.Unix(0, 0)
+	stableLast, flakyLast := start, start
+	for i := 0; i < 20; i++ {
+		stable.Heartbeat(stableLast)
+		flaky.Heartbeat(flakyLast)
+		stableLast = stableLast.Add(10 * time.Second)
+		// Lossy link: every other heartbeat is delayed
+		flakyLast = flakyLast.Add(time.Duration(5+10*(i%2)) * time.Second)
+	}
+	assert.Greater(t, flaky.Timeout(8), stable.Timeout(8))
+}
+
+func TestPhiAccrualDetector_SlidingWindow(t *testing.T) {
+	detector := alivemgr.NewPhiAccrualDetector(3, time.Millisecond)
+	now := time.Unix(0, 0)
+	detector.Heartbeat(now)
+	for i := 0; i < 3; i++ {
+		now = now.Add(time.Second)
+		detector.Heartbeat(now)
+	}
+	short := detector.Timeout(8)
+	for i := 0; i < 3; i++ {
+		now = now.Add(10 * time.Second)
+		detector.Heartbeat(now)
+	}
+	assert.Equal(t, 3, detector.Samples())
+	// Old samples have been evicted, only 10s intervals remain
+	assert.InDelta(t, float64(10*time.Second), float64(detector.Timeout(8)), float64(100*time.Millisecond))
+	assert.Greater(t, detector.Timeout(8), short)
+
+	// Out-of-order arrivals are ignored
+	detector.Heartbeat(now.Add(-time.Second))
+	assert.Equal(t, 3, detector.Samples())
+}
+
+func TestParseSiteOverrides(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides string
+		want      map[string]float64
+		wantErr   bool
+	}{
+		{
+			name:      "empty",
+			overrides: "",
+			want:      map[string]float64{},
+		},
+		{
+			name:      "single",
+			overrides: "site-12345678=12",
+			want:      map[string]float64{"site-12345678": 12},
+		},
+		{
+			name:      "multiple with spaces",
+			overrides: " site-12345678=12.5 , site-87654321=16,",
+			want:      map[string]float64{"site-12345678": 12.5, "site-87654321": 16},
+		},
+		{
+			name:      "missing threshold",
+			overrides: "site-12345678",
+			wantErr:   true,
+		},
+		{
+			name:      "missing site",
+			overrides: "=12",
+			wantErr:   true,
+		},
+		{
+			name:      "invalid threshold",
+			overrides: "site-12345678=abc",
+			wantErr:   true,
+		},
+		{
+			name:      "negative threshold",
+			overrides: "site-12345678=-1",
+			wantErr:   true,
+		},
+		{
+			name:      "infinite timeout threshold",
+			overrides: "site-12345678=400",
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := alivemgr.ParseSiteOverrides(tt.overrides)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidateFlags(t *testing.T) {
+	t.Cleanup(func() {
+		require.NoError(t, flag.Set("phiThreshold", "8"))
+		require.NoError(t, flag.Set("phiThresholdSiteOverrides", ""))
+		require.NoError(t, alivemgr.ValidateFlags())
+	})
+	require.NoError(t, alivemgr.ValidateFlags())
+
+	for _, threshold := range []string{"0", "-1", "NaN", "400"} {
+		require.NoError(t, flag.Set("phiThreshold", threshold))
+		require.Error(t, alivemgr.ValidateFlags(), threshold)
+	}
+
+	require.NoError(t, flag.Set("phiThreshold", "8"))
+	require.NoError(t, flag.Set("phiThresholdSiteOverrides", "site-12345678=0"))
+	require.Error(t, alivemgr.ValidateFlags())
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package alivemgr
+
+import (
+	"flag"
+	"sync/atomic"
+	"time"
+)
+
+const defaultStartupGracePeriod = time.Minute
+
+var startupGracePeriod = flag.Duration(
+	"startupGracePeriod",
+	defaultStartupGracePeriod,
+	"Flag to set the grace period after the start of the Host Manager in which no host is reported as lost, "+
+		"to let the agents reconnect. 0 disables the grace period.",
+)
+
+// Clock provides the time to the availability manager.
+type Clock interface {
+	Now() time.Time
+	// WaitUntil sends the current time on the returned channel once the deadline is reached.
+	WaitUntil(deadline time.Time) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+func (realClock) WaitUntil(deadline time.Time) <-chan time.Time {
+	return time.After(time.Until(deadline))
+}
+
+// clockHolder wraps the clock, atomic.Pointer cannot hold an interface.
+type clockHolder struct {
+	Clock
+}
+
+var clock atomic.Pointer[clockHolder]
+
+// SetClock replaces the clock of the availability manager, e.g. by a fake clock in tests.
+// A nil clock restores the real clock.
+func SetClock(c Clock) {
+	if c == nil {
+		clock.Store(nil)
+		return
+	}
+	clock.Store(&clockHolder{Clock: c})
+}
+
+func getClock() Clock {
+	if c := clock.Load(); c != nil {
+		return c.Clock
+	}
+	return realClock{}
+}
+
+func clockNow() time.Time {
+	return getClock().Now().UTC()
+}
+
+// StartGracePeriod starts the startup grace period, to be called when the hosts are registered after a start
+// of the Host Manager: their agents may still be reconnecting, e.g. through the load balancer after a rollout.
+// No host is reported as lost until the end of the grace period, the hosts that did not send
+// a heartbeat in the meantime are then reported as lost together.
+func StartGracePeriod() {
+	period := *startupGracePeriod
+	if period <= 0 {
+		return
+	}
+
+	alvMgr.lock.Lock()
+	defer alvMgr.lock.Unlock()
+	alvMgr.graceUntil = clockNow().Add(period)
+	zlog.InfraSec().Info().Msgf("Hosts are not reported as lost until the end of the grace period at %s",
+		alvMgr.graceUntil)
+	// The expiry loop must wait for the end of the grace period
+	select {
+	case alvMgr.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// InGracePeriod returns true during the startup grace period.
+func InGracePeriod() bool {
+	alvMgr.lock.Lock()
+	defer alvMgr.lock.Unlock()
+	return clockNow().Before(alvMgr.graceUntil)
+}
@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package alivemgr_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	"github.com/open-edge-platform/infra-managers/host/pkg/alivemgr"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+const graceTenant = "88888888-8888-8888-8888-888888888888"
+
+type fakeWaiter struct {
+	deadline time.Time
+	ch       chan time.Time
+}
+
+// fakeClock is a clock whose time only moves forward with Advance.
+type fakeClock struct {
+	lock    sync.Mutex
+	now     time.Time
+	waiters []fakeWaiter
+	// waits counts the timers set by the expiry loop, i.e. its wakeups
+	waits int
+}
+
+// fakeEpoch is the time at which the next fake clock starts. The fake time starts in the past, so that the state
+// left behind is expired with the real clock, and it never goes back, so that it does not leak into the next tests.
+var fakeEpoch = time.Now().Add(-time.Hour)
+
+// newFakeClock replaces the clock of the availability manager for the duration of the test.
+func newFakeClock(t *testing.T) *fakeClock {
+	t.Helper()
+	c := &fakeClock{now: fakeEpoch}
+	alivemgr.SetClock(c)
+	t.Cleanup(func() {
+		alivemgr.SetClock(nil)
+		fakeEpoch = c.Now()
+	})
+	return c
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) WaitUntil(deadline time.Time) <-chan time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.waits++
+	ch := make(chan time.Time, 1)
+	if !deadline.After(c.now) {
+		ch <- c.now
+		return ch
+	}
+	c.waiters = append(c.waiters, fakeWaiter{deadline: deadline, ch: ch})
+	return ch
+}
+
+// Waits returns the number of timers set on the clock.
+func (c *fakeClock) Waits() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.waits
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.now = c.now.Add(d)
+	waiters := c.waiters[:0]
+	for _, w := range c.waiters {
+		if w.deadline.After(c.now) {
+			waiters = append(waiters, w)
+			continue
+		}
+		w.ch <- c.now
+	}
+	c.waiters = waiters
+}
+
+// expectLost waits for the host to be reported as lost, ignoring the hosts of the other tests.
+func expectLost(t *testing.T, lostHosts chan util.TenantIDResourceIDTuple, host *computev1.HostResource) {
+	t.Helper()
+	want := util.NewTenantIDResourceIDTupleFromHost(host)
+	for {
+		select {
+		case lost := <-lostHosts:
+			if lost == want {
+				return
+			}
+			require.NotEqual(t, graceTenant, lost.TenantID, "unexpected lost host %s", lost)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s has not been reported as lost", want)
+		}
+	}
+}
+
+// expectNoneLost checks that no host of the test is reported as lost.
+func expectNoneLost(t *testing.T, lostHosts chan util.TenantIDResourceIDTuple) {
+	t.Helper()
+	timeout := time.After(200 * time.Millisecond)
+	for {
+		select {
+		case lost := <-lostHosts:
+			require.NotEqual(t, graceTenant, lost.TenantID, "unexpected lost host %s", lost)
+		case <-timeout:
+			return
+		}
+	}
+}
+
+func TestStartGracePeriod(t *testing.T) {
+	clock := newFakeClock(t)
+	termChan := make(chan bool)
+	defer close(termChan)
+	lostHosts := alivemgr.StartAlvMgr(termChan)
+	timeout := alivemgr.GetSettings().Timeout()
+	require.Less(t, timeout, time.Minute, "the timeout must be shorter than the default grace period")
+
+	// The Host Manager restarts and registers the running hosts
+	alivemgr.StartGracePeriod()
+	reconnecting := &computev1.HostResource{ResourceId: "host-88888881", TenantId: graceTenant}
+	disconnected := &computev1.HostResource{ResourceId: "host-88888882", TenantId: graceTenant}
+	for _, host := range []*computev1.HostResource{reconnecting, disconnected} {
+		require.NoError(t, alivemgr.UpdateHostHeartBeat(host))
+		t.Cleanup(func() { alivemgr.ForgetHost(host) })
+	}
+	assert.True(t, alivemgr.InGracePeriod())
+
+	// The timeout is over, but the hosts are not reported as lost during the grace period
+	clock.Advance(timeout + time.Second)
+	expectNoneLost(t, lostHosts)
+	alive, err := alivemgr.GetHostHeartBeat(disconnected)
+	require.NoError(t, err)
+	assert.True(t, alive)
+
+	// One of the agents reconnects
+	require.NoError(t, alivemgr.UpdateHostHeartBeat(reconnecting))
+
+	// At the end of the grace period, only the host without heartbeat is reported as lost
+	clock.Advance(time.Minute - timeout - time.Second)
+	assert.False(t, alivemgr.InGracePeriod())
+	expectLost(t, lostHosts, disconnected)
+	expectNoneLost(t, lostHosts)
+	alive, err = alivemgr.GetHostHeartBeat(reconnecting)
+	require.NoError(t, err)
+	assert.True(t, alive)
+
+	// Then the hosts expire as usual
+	clock.Advance(timeout)
+	expectLost(t, lostHosts, reconnecting)
+}
+
+func TestExpiry_FakeClock(t *testing.T) {
+	clock := newFakeClock(t)
+	termChan := make(chan bool)
+	defer close(termChan)
+	lostHosts := alivemgr.StartAlvMgr(termChan)
+	timeout := alivemgr.GetSettings().Timeout()
+
+	host := &computev1.HostResource{ResourceId: "host-88888883", TenantId: graceTenant}
+	require.NoError(t, alivemgr.UpdateHostHeartBeat(host))
+	t.Cleanup(func() { alivemgr.ForgetHost(host) })
+
+	clock.Advance(timeout - time.Second)
+	expectNoneLost(t, lostHosts)
+	tracked := alivemgr.ListTrackedHosts(graceTenant)
+	require.Len(t, tracked, 1)
+	assert.Equal(t, clock.Now().Add(time.Second).UTC(), tracked[0].Deadline)
+
+	clock.Advance(time.Second)
+	expectLost(t, lostHosts, host)
+}
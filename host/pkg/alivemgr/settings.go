@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package alivemgr
+
+import (
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	inv_util "github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+// Settings are the timeout settings of the heartbeats, initialized from the flags.
+type Settings struct {
+	// BaseTimeout is the expected interval between two heartbeats of a host.
+	BaseTimeout time.Duration
+	// TimeoutTimes is the number of base timeouts without heartbeat after which a host is lost.
+	TimeoutTimes int
+	// DynamicTimeout derives the timeout of each host from its heartbeat history.
+	DynamicTimeout bool
+}
+
+// Timeout returns the static timeout of the heartbeats.
+func (s Settings) Timeout() time.Duration {
+	return time.Duration(s.TimeoutTimes) * s.BaseTimeout
+}
+
+// Validate checks that the settings are valid.
+func (s Settings) Validate() error {
+	if s.BaseTimeout < time.Second {
+		return errors.Errorfc(codes.InvalidArgument, "invalid base timeout %s, it should be at least 1s", s.BaseTimeout)
+	}
+	if s.TimeoutTimes < 1 {
+		return errors.Errorfc(codes.InvalidArgument, "invalid timeout times %d, it should be at least 1", s.TimeoutTimes)
+	}
+	if _, err := inv_util.MulInt64(int64(s.TimeoutTimes), int64(s.BaseTimeout)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// currentSettings returns the settings, loading them from the flags on first use. Must be called with the lock held.
+func (am *aliverMgr) currentSettings() Settings {
+	onceSettings.Do(func() {
+		am.settings = Settings{
+			BaseTimeout:    time.Duration(*baseTimeDuration) * time.Second,
+			TimeoutTimes:   *timeoutTimes,
+			DynamicTimeout: *dynamicTimeOut,
+		}
+		if err := am.settings.Validate(); err != nil {
+			zlog.InfraSec().Err(err).Msgf("continuing with default timeout values")
+			am.settings.BaseTimeout = defaultBaseTimeDuration * time.Second
+			am.settings.TimeoutTimes = defaultTimeoutTimes
+		}
+	})
+	return am.settings
+}
+
+// GetSettings returns the current timeout settings of the heartbeats.
+func GetSettings() Settings {
+	alvMgr.lock.Lock()
+	defer alvMgr.lock.Unlock()
+	return alvMgr.currentSettings()
+}
+
+// SetSettings changes the timeout settings at runtime. The deadlines of the tracked hosts are moved
+// according to the new static timeout, the dynamic timeouts are updated on the next heartbeat.
+func SetSettings(settings Settings) error {
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+
+	alvMgr.lock.Lock()
+	defer alvMgr.lock.Unlock()
+	previous := alvMgr.currentSettings()
+	alvMgr.settings = settings
+	zlog.InfraSec().Info().Msgf("Heartbeat timeout settings changed from %+v to %+v", previous, settings)
+
+	timeout := settings.Timeout()
+	for _, hb := range alvMgr.hostHeartbeatMap {
+		hb.staticTimeout = timeout
+		if hb.expired {
+			continue
+		}
+		if !settings.DynamicTimeout || hb.timeout < timeout {
+			hb.timeout = timeout
+		}
+		hb.deadline = hb.lastHeartbeat.Add(hb.timeout)
+		alvMgr.schedule(hb)
+	}
+	return nil
+}
+
+// TrackedHost is the heartbeat state of a host tracked by the availability manager.
+type TrackedHost struct {
+	Host          util.TenantIDResourceIDTuple
+	LastHeartbeat time.Time
+	Timeout       time.Duration
+	// Deadline is the time at which the host is considered lost, if no heartbeat is received before
+	Deadline time.Time
+	Expired  bool
+}
+
+// ListTrackedHosts returns the hosts of the tenant tracked by the availability manager, sorted by deadline.
+func ListTrackedHosts(tenantID string) []TrackedHost {
+	alvMgr.lock.Lock()
+	defer alvMgr.lock.Unlock()
+	var hosts []TrackedHost
+	for hbk, hb := range alvMgr.hostHeartbeatMap {
+		if hbk.TenantID != tenantID {
+			continue
+		}
+		hosts = append(hosts, TrackedHost{
+			Host:          hbk,
+			LastHeartbeat: hb.lastHeartbeat,
+			Timeout:       hb.timeout,
+			Deadline:      hb.deadline,
+			Expired:       hb.expired,
+		})
+	}
+	sort.Slice(hosts, func(i, j int) bool {
+		if !hosts[i].Deadline.Equal(hosts[j].Deadline) {
+			return hosts[i].Deadline.Before(hosts[j].Deadline)
+		}
+		return hosts[i].Host.ResourceID < hosts[j].Host.ResourceID
+	})
+	return hosts
+}
+
+// ExpireHost expires the heartbeat of the host now, as if no heartbeat had been received in time:
+// the host is reported as lost. It returns false if the host is not tracked.
+func ExpireHost(hbk util.TenantIDResourceIDTuple) bool {
+	alvMgr.lock.Lock()
+	defer alvMgr.lock.Unlock()
+	hb, ok := alvMgr.hostHeartbeatMap[hbk]
+	if !ok {
+		return false
+	}
+	zlog.InfraSec().Info().Msgf("Heartbeat of %s is expired on demand", hbk)
+	hb.deadline = clockNow()
+	alvMgr.schedule(hb)
+	return true
+}
+
+// ForgetHostByID stops tracking the heartbeat of the host, until its next heartbeat.
+// It returns false if the host is not tracked.
+func ForgetHostByID(hbk util.TenantIDResourceIDTuple) bool {
+	alvMgr.lock.Lock()
+	defer alvMgr.lock.Unlock()
+	if _, ok := alvMgr.hostHeartbeatMap[hbk]; !ok {
+		return false
+	}
+	alvMgr.remove(hbk)
+	zlog.InfraSec().Info().Msgf("Host %s has been removed from the heartbeat list on demand", hbk)
+	return true
+}
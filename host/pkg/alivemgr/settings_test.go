@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package alivemgr_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	"github.com/open-edge-platform/infra-managers/host/pkg/alivemgr"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+const settingsTenant = "33333333-3333-3333-3333-333333333333"
+
+func TestSettings_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings alivemgr.Settings
+		valid    bool
+	}{
+		{
+			name:     "valid",
+			settings: alivemgr.Settings{BaseTimeout: 10 * time.Second, TimeoutTimes: 3},
+			valid:    true,
+		},
+		{
+			name:     "base timeout too short",
+			settings: alivemgr.Settings{BaseTimeout: time.Millisecond, TimeoutTimes: 3},
+		},
+		{
+			name:     "no timeout times",
+			settings: alivemgr.Settings{BaseTimeout: 10 * time.Second},
+		},
+		{
+			name:     "overflow",
+			settings: alivemgr.Settings{BaseTimeout: time.Duration(math.MaxInt64), TimeoutTimes: 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSetSettings(t *testing.T) {
+	previous := alivemgr.GetSettings()
+	t.Cleanup(func() {
+		require.NoError(t, alivemgr.SetSettings(previous))
+	})
+
+	host := &computev1.HostResource{ResourceId: "host-33333333", TenantId: settingsTenant}
+	require.NoError(t, alivemgr.UpdateHostHeartBeat(host))
+	t.Cleanup(func() { alivemgr.ForgetHost(host) })
+
+	// Invalid settings are rejected and not applied
+	require.Error(t, alivemgr.SetSettings(alivemgr.Settings{BaseTimeout: 0, TimeoutTimes: 1}))
+	assert.Equal(t, previous, alivemgr.GetSettings())
+
+	settings := alivemgr.Settings{BaseTimeout: time.Hour, TimeoutTimes: 2}
+	require.NoError(t, alivemgr.SetSettings(settings))
+	assert.Equal(t, settings, alivemgr.GetSettings())
+
+	// The tracked hosts are rescheduled with the new timeout
+	tracked := alivemgr.ListTrackedHosts(settingsTenant)
+	require.Len(t, tracked, 1)
+	assert.Equal(t, 2*time.Hour, tracked[0].Timeout)
+	assert.Equal(t, tracked[0].LastHeartbeat.Add(2*time.Hour), tracked[0].Deadline)
+}
+
+func TestListTrackedHosts(t *testing.T) {
+	hosts := []*computev1.HostResource{
+		{ResourceId: "host-3333333b", TenantId: settingsTenant},
+		{ResourceId: "host-3333333a", TenantId: settingsTenant},
+		{ResourceId: "host-3333333a", TenantId: "44444444-4444-4444-4444-444444444444"},
+	}
+	for _, host := range hosts {
+		require.NoError(t, alivemgr.UpdateHostHeartBeat(host))
+		t.Cleanup(func() { alivemgr.ForgetHost(host) })
+	}
+
+	// Only the hosts of the tenant are listed
+	tracked := alivemgr.ListTrackedHosts(settingsTenant)
+	require.Len(t, tracked, 2)
+	for _, th := range tracked {
+		assert.Equal(t, settingsTenant, th.Host.TenantID)
+		assert.False(t, th.Expired)
+		assert.False(t, th.LastHeartbeat.IsZero())
+		assert.True(t, th.Deadline.After(th.LastHeartbeat))
+	}
+	assert.Empty(t, alivemgr.ListTrackedHosts("55555555-5555-5555-5555-555555555555"))
+}
+
+func TestExpireHost(t *testing.T) {
+	termChan := make(chan bool)
+	defer close(termChan)
+	lostHosts := alivemgr.StartAlvMgr(termChan)
+
+	host := &computev1.HostResource{ResourceId: "host-3333333c", TenantId: settingsTenant}
+	hostID := util.NewTenantIDResourceIDTupleFromHost(host)
+	assert.False(t, alivemgr.ExpireHost(hostID))
+
+	require.NoError(t, alivemgr.UpdateHostHeartBeat(host))
+	t.Cleanup(func() { alivemgr.ForgetHost(host) })
+	require.True(t, alivemgr.ExpireHost(hostID))
+
+	select {
+	case lost := <-lostHosts:
+		assert.Equal(t, hostID, lost)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the expired host has not been reported as lost")
+	}
+	alive, err := alivemgr.GetHostHeartBeat(host)
+	require.NoError(t, err)
+	assert.False(t, alive)
+
+	// A new heartbeat tracks the host again
+	require.NoError(t, alivemgr.UpdateHostHeartBeat(host))
+	alive, err = alivemgr.GetHostHeartBeat(host)
+	require.NoError(t, err)
+	assert.True(t, alive)
+}
+
+func TestForgetHostByID(t *testing.T) {
+	host := &computev1.HostResource{ResourceId: "host-3333333d", TenantId: settingsTenant}
+	hostID := util.NewTenantIDResourceIDTupleFromHost(host)
+	assert.False(t, alivemgr.ForgetHostByID(hostID))
+
+	require.NoError(t, alivemgr.UpdateHostHeartBeat(host))
+	assert.True(t, alivemgr.IsHostTracked(host))
+	assert.True(t, alivemgr.ForgetHostByID(hostID))
+	assert.False(t, alivemgr.IsHostTracked(host))
+}
@@ -0,0 +1,234 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package alivemgr
+
+import (
+	"flag"
+	"sort"
+	"sync"
+	"time"
+
+	hrm_metrics "github.com/open-edge-platform/infra-managers/host/pkg/metrics"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+const (
+	defaultStormThreshold = 0.5
+	defaultStormWindow    = time.Minute
+	defaultStormMinHosts  = 10
+
+	stormScopeTenant = "tenant"
+	stormScopeSite   = "site"
+)
+
+var (
+	stormThreshold = flag.Float64(
+		"stormThreshold",
+		defaultStormThreshold,
+		"Flag to set the share of the hosts of a tenant or site that must lose their heartbeat within the storm "+
+			"window to declare a connection-loss storm, between 0 and 1.",
+	)
+	stormWindow = flag.Duration(
+		"stormWindow",
+		defaultStormWindow,
+		"Flag to set the sliding window in which the heartbeat losses of a tenant or site are counted.",
+	)
+	stormMinHosts = flag.Int(
+		"stormMinHosts",
+		defaultStormMinHosts,
+		"Flag to set the minimum number of hosts losing their heartbeat within the storm window to declare "+
+			"a connection-loss storm.",
+	)
+	onceStorms sync.Once
+)
+
+// StormGroup is a set of hosts whose heartbeats are lost together in a storm: all the hosts of a tenant,
+// or all the hosts of a site when SiteID is set.
+type StormGroup struct {
+	TenantID string
+	SiteID   string
+}
+
+func (g StormGroup) scope() string {
+	if g.SiteID != "" {
+		return stormScopeSite
+	}
+	return stormScopeTenant
+}
+
+// Storm is a connection-loss storm in progress.
+type Storm struct {
+	StormGroup
+	// Tracked is the number of hosts of the group whose heartbeat is tracked
+	Tracked int
+	// Lost is the number of hosts of the group that lost their heartbeat within the window
+	Lost  int
+	Since time.Time
+}
+
+type stormState struct {
+	tracked  int
+	losses   []time.Time
+	storming bool
+	since    time.Time
+}
+
+// StormDetector detects connection-loss storms: a large share of the hosts of a tenant or a site losing
+// their heartbeat within a short window, e.g. after a restart of the Host Manager or an uplink failure
+// of a site. A storm lasts until the losses within the window are below the threshold again.
+// Not safe for concurrent use, callers must serialize the access.
+type StormDetector struct {
+	threshold float64
+	window    time.Duration
+	minHosts  int
+	groups    map[StormGroup]*stormState
+}
+
+// NewStormDetector returns a detector declaring a storm when at least minHosts hosts, and at least
+// the given share of the hosts of a group, lose their heartbeat within the window.
+func NewStormDetector(threshold float64, window time.Duration, minHosts int) *StormDetector {
+	if threshold <= 0 || threshold > 1 {
+		zlog.InfraSec().Warn().Msgf("invalid storm threshold %f, continuing with default value", threshold)
+		threshold = defaultStormThreshold
+	}
+	if window <= 0 {
+		zlog.InfraSec().Warn().Msgf("invalid storm window %s, continuing with default value", window)
+		window = defaultStormWindow
+	}
+	if minHosts < 1 {
+		minHosts = 1
+	}
+	return &StormDetector{
+		threshold: threshold,
+		window:    window,
+		minHosts:  minHosts,
+		groups:    make(map[StormGroup]*stormState),
+	}
+}
+
+func hostStormGroups(tenantID, siteID string) []StormGroup {
+	groups := []StormGroup{{TenantID: tenantID}}
+	if siteID != "" {
+		groups = append(groups, StormGroup{TenantID: tenantID, SiteID: siteID})
+	}
+	return groups
+}
+
+// Track adds a host to the tracked hosts of its tenant and site.
+func (d *StormDetector) Track(tenantID, siteID string) {
+	for _, g := range hostStormGroups(tenantID, siteID) {
+		state, ok := d.groups[g]
+		if !ok {
+			state = &stormState{}
+			d.groups[g] = state
+		}
+		state.tracked++
+	}
+}
+
+// Untrack removes a host from the tracked hosts of its tenant and site.
+func (d *StormDetector) Untrack(tenantID, siteID string, now time.Time) {
+	for _, g := range hostStormGroups(tenantID, siteID) {
+		if state, ok := d.groups[g]; ok {
+			state.tracked--
+			d.evaluate(g, state, now)
+		}
+	}
+}
+
+// Lost records the loss of the heartbeat of a host of the tenant and site.
+func (d *StormDetector) Lost(tenantID, siteID string, now time.Time) {
+	for _, g := range hostStormGroups(tenantID, siteID) {
+		if state, ok := d.groups[g]; ok {
+			state.losses = append(state.losses, now)
+			d.evaluate(g, state, now)
+		}
+	}
+}
+
+// InStorm returns true if the tenant, or the site, is in a connection-loss storm.
+func (d *StormDetector) InStorm(tenantID, siteID string, now time.Time) bool {
+	inStorm := false
+	for _, g := range hostStormGroups(tenantID, siteID) {
+		if state, ok := d.groups[g]; ok && d.evaluate(g, state, now) {
+			inStorm = true
+		}
+	}
+	return inStorm
+}
+
+// Storms returns the storms in progress, sorted by tenant and site.
+func (d *StormDetector) Storms(now time.Time) []Storm {
+	var storms []Storm
+	for g, state := range d.groups {
+		if d.evaluate(g, state, now) {
+			storms = append(storms, Storm{StormGroup: g, Tracked: state.tracked, Lost: len(state.losses), Since: state.since})
+		}
+	}
+	sort.Slice(storms, func(i, j int) bool {
+		if storms[i].TenantID != storms[j].TenantID {
+			return storms[i].TenantID < storms[j].TenantID
+		}
+		return storms[i].SiteID < storms[j].SiteID
+	})
+	return storms
+}
+
+// evaluate drops the losses out of the window and updates the storm state of the group.
+// Groups without hosts nor losses are forgotten. It returns true if the group is in a storm.
+func (d *StormDetector) evaluate(g StormGroup, state *stormState, now time.Time) bool {
+	cutoff := now.Add(-d.window)
+	expired := 0
+	for expired < len(state.losses) && !state.losses[expired].After(cutoff) {
+		expired++
+	}
+	state.losses = state.losses[expired:]
+
+	lost := len(state.losses)
+	storming := lost >= d.minHosts && float64(lost) >= d.threshold*float64(max(state.tracked, 1))
+	switch {
+	case storming && !state.storming:
+		state.since = now
+		hrm_metrics.ConnectionLossStorms.WithLabelValues(g.scope()).Inc()
+		zlog.InfraSec().Warn().Msgf("Connection-loss storm started for %+v: %d of %d hosts lost their heartbeat within %s",
+			g, lost, state.tracked, d.window)
+	case !storming && state.storming:
+		hrm_metrics.ConnectionLossStorms.WithLabelValues(g.scope()).Dec()
+		zlog.InfraSec().Info().Msgf("Connection-loss storm ended for %+v after %s", g, now.Sub(state.since))
+	}
+	state.storming = storming
+
+	if state.tracked <= 0 && lost == 0 {
+		delete(d.groups, g)
+	}
+	return storming
+}
+
+// stormDetector returns the storm detector, created from the flags on first use. Must be called with the lock held.
+func (am *aliverMgr) stormDetector() *StormDetector {
+	onceStorms.Do(func() {
+		am.storms = NewStormDetector(*stormThreshold, *stormWindow, *stormMinHosts)
+	})
+	return am.storms
+}
+
+// InStorm returns true if the tenant or the site of the host is in a connection-loss storm,
+// the updates of the hosts that lost their heartbeat should be paused or batched.
+func InStorm(hbk util.TenantIDResourceIDTuple) bool {
+	alvMgr.lock.Lock()
+	defer alvMgr.lock.Unlock()
+	siteID := ""
+	if hb, ok := alvMgr.hostHeartbeatMap[hbk]; ok {
+		siteID = hb.siteID
+	}
+	return alvMgr.stormDetector().InStorm(hbk.TenantID, siteID, clockNow())
+}
+
+// ActiveStorms returns the connection-loss storms in progress. It also ends the storms that are over.
+func ActiveStorms() []Storm {
+	alvMgr.lock.Lock()
+	defer alvMgr.lock.Unlock()
+	return alvMgr.stormDetector().Storms(clockNow())
+}
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package alivemgr_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	locationv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/location/v1"
+	"github.com/open-edge-platform/infra-managers/host/pkg/alivemgr"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+const (
+	stormTenant  = "66666666-6666-6666-6666-666666666666"
+	stormTenant2 = "77777777-7777-7777-7777-777777777777"
+	stormSite    = "site-66666666"
+	stormSite2   = "site-66666667"
+)
+
+func TestStormDetector_Tenant(t *testing.T) {
+	now := time.Now()
+	d := alivemgr.NewStormDetector(0.5, time.Minute, 3)
+	for range 10 {
+		d.Track(stormTenant, "")
+	}
+	d.Track(stormTenant2, "")
+
+	// Below the share of the tracked hosts
+	for i := range 4 {
+		d.Lost(stormTenant, "", now.Add(time.Duration(i)*time.Second))
+	}
+	assert.False(t, d.InStorm(stormTenant, "", now.Add(5*time.Second)))
+
+	d.Lost(stormTenant, "", now.Add(5*time.Second))
+	assert.True(t, d.InStorm(stormTenant, "", now.Add(5*time.Second)))
+	assert.True(t, d.InStorm(stormTenant, stormSite, now.Add(5*time.Second)))
+	assert.False(t, d.InStorm(stormTenant2, "", now.Add(5*time.Second)))
+
+	storms := d.Storms(now.Add(10 * time.Second))
+	require.Len(t, storms, 1)
+	assert.Equal(t, alivemgr.StormGroup{TenantID: stormTenant}, storms[0].StormGroup)
+	assert.Equal(t, 10, storms[0].Tracked)
+	assert.Equal(t, 5, storms[0].Lost)
+	assert.Equal(t, now.Add(5*time.Second), storms[0].Since)
+
+	// The storm is over once the first losses are out of the window
+	assert.True(t, d.InStorm(stormTenant, "", now.Add(time.Minute-time.Millisecond)))
+	assert.False(t, d.InStorm(stormTenant, "", now.Add(time.Minute)))
+	assert.Empty(t, d.Storms(now.Add(time.Minute)))
+}
+
+func TestStormDetector_Site(t *testing.T) {
+	now := time.Now()
+	d := alivemgr.NewStormDetector(0.5, time.Minute, 3)
+	for range 4 {
+		d.Track(stormTenant, stormSite)
+	}
+	for range 16 {
+		d.Track(stormTenant, stormSite2)
+	}
+
+	// All the hosts of a site lose the connection, a small share of the tenant
+	for range 4 {
+		d.Lost(stormTenant, stormSite, now)
+	}
+	assert.True(t, d.InStorm(stormTenant, stormSite, now))
+	assert.False(t, d.InStorm(stormTenant, stormSite2, now))
+	assert.False(t, d.InStorm(stormTenant, "", now))
+
+	storms := d.Storms(now)
+	require.Len(t, storms, 1)
+	assert.Equal(t, alivemgr.StormGroup{TenantID: stormTenant, SiteID: stormSite}, storms[0].StormGroup)
+}
+
+func TestStormDetector_MinHosts(t *testing.T) {
+	now := time.Now()
+	d := alivemgr.NewStormDetector(0.5, time.Minute, 3)
+	d.Track(stormTenant, "")
+	d.Track(stormTenant, "")
+
+	// All the hosts are lost, but too few of them for a storm
+	d.Lost(stormTenant, "", now)
+	d.Lost(stormTenant, "", now)
+	assert.False(t, d.InStorm(stormTenant, "", now))
+
+	// Forgotten groups do not keep any state
+	d.Untrack(stormTenant, "", now)
+	d.Untrack(stormTenant, "", now)
+	assert.Empty(t, d.Storms(now.Add(time.Minute)))
+}
+
+func TestInStorm(t *testing.T) {
+	termChan := make(chan bool)
+	defer close(termChan)
+	lostHosts := alivemgr.StartAlvMgr(termChan)
+
+	site := &locationv1.SiteResource{ResourceId: stormSite}
+	hosts := make([]*computev1.HostResource, 20)
+	for i := range hosts {
+		hosts[i] = &computev1.HostResource{ResourceId: fmt.Sprintf("host-6666%04x", i), TenantId: stormTenant, Site: site}
+		require.NoError(t, alivemgr.UpdateHostHeartBeat(hosts[i]))
+		t.Cleanup(func() { alivemgr.ForgetHost(hosts[i]) })
+	}
+	otherTenantHost := &computev1.HostResource{ResourceId: "host-66660000", TenantId: stormTenant2}
+	require.NoError(t, alivemgr.UpdateHostHeartBeat(otherTenantHost))
+	t.Cleanup(func() { alivemgr.ForgetHost(otherTenantHost) })
+
+	// The site of the hosts loses its uplink
+	for _, host := range hosts {
+		require.True(t, alivemgr.ExpireHost(util.NewTenantIDResourceIDTupleFromHost(host)))
+	}
+	for range hosts {
+		select {
+		case <-lostHosts:
+		case <-time.After(5 * time.Second):
+			t.Fatal("the expired hosts have not been reported as lost")
+		}
+	}
+
+	assert.True(t, alivemgr.InStorm(util.NewTenantIDResourceIDTupleFromHost(hosts[0])))
+	assert.False(t, alivemgr.InStorm(util.NewTenantIDResourceIDTupleFromHost(otherTenantHost)))
+	storms := alivemgr.ActiveStorms()
+	require.Len(t, storms, 2)
+	assert.Equal(t, alivemgr.StormGroup{TenantID: stormTenant}, storms[0].StormGroup)
+	assert.Equal(t, alivemgr.StormGroup{TenantID: stormTenant, SiteID: stormSite}, storms[1].StormGroup)
+}
@@ -0,0 +1,1468 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: hostmgr/proto/hostmgr_admin.proto
+
+// buf:lint:ignore PACKAGE_VERSION_SUFFIX
+// buf:lint:ignore PACKAGE_DIRECTORY_MATCH
+
+package hostmgr_southbound
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListTrackedHostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListTrackedHostsRequest) Reset() {
+	*x = ListTrackedHostsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTrackedHostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrackedHostsRequest) ProtoMessage() {}
+
+func (x *ListTrackedHostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrackedHostsRequest.ProtoReflect.Descriptor instead.
+func (*ListTrackedHostsRequest) Descriptor() ([]byte, []int) {
+	return file_hostmgr_proto_hostmgr_admin_proto_rawDescGZIP(), []int{0}
+}
+
+type TrackedHost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceId string `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// Time of the last heartbeat, in milliseconds since the Unix epoch.
+	LastHeartbeatMs int64 `protobuf:"varint,2,opt,name=last_heartbeat_ms,json=lastHeartbeatMs,proto3" json:"last_heartbeat_ms,omitempty"`
+	// Current timeout of the heartbeat, in milliseconds.
+	TimeoutMs int64 `protobuf:"varint,3,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	// Time left before the host is reported as "No Connection", in milliseconds. 0 once expired.
+	RemainingTimeoutMs int64 `protobuf:"varint,4,opt,name=remaining_timeout_ms,json=remainingTimeoutMs,proto3" json:"remaining_timeout_ms,omitempty"`
+	// The host has been reported as "No Connection" and no heartbeat has been received since.
+	Expired bool `protobuf:"varint,5,opt,name=expired,proto3" json:"expired,omitempty"`
+}
+
+func (x *TrackedHost) Reset() {
+	*x = TrackedHost{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackedHost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackedHost) ProtoMessage() {}
+
+func (x *TrackedHost) ProtoReflect() protoreflect.Message {
+	mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackedHost.ProtoReflect.Descriptor instead.
+func (*TrackedHost) Descriptor() ([]byte, []int) {
+	return file_hostmgr_proto_hostmgr_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TrackedHost) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *TrackedHost) GetLastHeartbeatMs() int64 {
+	if x != nil {
+		return x.LastHeartbeatMs
+	}
+	return 0
+}
+
+func (x *TrackedHost) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *TrackedHost) GetRemainingTimeoutMs() int64 {
+	if x != nil {
+		return x.RemainingTimeoutMs
+	}
+	return 0
+}
+
+func (x *TrackedHost) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+type ListTrackedHostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hosts []*TrackedHost `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
+}
+
+func (x *ListTrackedHostsResponse) Reset() {
+	*x = ListTrackedHostsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTrackedHostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrackedHostsResponse) ProtoMessage() {}
+
+func (x *ListTrackedHostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrackedHostsResponse.ProtoReflect.Descriptor instead.
+func (*ListTrackedHostsResponse) Descriptor() ([]byte, []int) {
+	return file_hostmgr_proto_hostmgr_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTrackedHostsResponse) GetHosts() []*TrackedHost {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+type ExpireHostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceId string `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+}
+
+func (x *ExpireHostRequest) Reset() {
+	*x = ExpireHostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpireHostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireHostRequest) ProtoMessage() {}
+
+func (x *ExpireHostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireHostRequest.ProtoReflect.Descriptor instead.
+func (*ExpireHostRequest) Descriptor() ([]byte, []int) {
+	return file_hostmgr_proto_hostmgr_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExpireHostRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+type ExpireHostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExpireHostResponse) Reset() {
+	*x = ExpireHostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpireHostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireHostResponse) ProtoMessage() {}
+
+func (x *ExpireHostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireHostResponse.ProtoReflect.Descriptor instead.
+func (*ExpireHostResponse) Descriptor() ([]byte, []int) {
+	return file_hostmgr_proto_hostmgr_admin_proto_rawDescGZIP(), []int{4}
+}
+
+type ForgetHostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceId string `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+}
+
+func (x *ForgetHostRequest) Reset() {
+	*x = ForgetHostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForgetHostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgetHostRequest) ProtoMessage() {}
+
+func (x *ForgetHostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgetHostRequest.ProtoReflect.Descriptor instead.
+func (*ForgetHostRequest) Descriptor() ([]byte, []int) {
+	return file_hostmgr_proto_hostmgr_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ForgetHostRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+type ForgetHostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ForgetHostResponse) Reset() {
+	*x = ForgetHostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForgetHostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgetHostResponse) ProtoMessage() {}
+
+func (x *ForgetHostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgetHostResponse.ProtoReflect.Descriptor instead.
+func (*ForgetHostResponse) Descriptor() ([]byte, []int) {
+	return file_hostmgr_proto_hostmgr_admin_proto_rawDescGZIP(), []int{6}
+}
+
+type GetTimeoutSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetTimeoutSettingsRequest) Reset() {
+	*x = GetTimeoutSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTimeoutSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeoutSettingsRequest) ProtoMessage() {}
+
+func (x *GetTimeoutSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeoutSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetTimeoutSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_hostmgr_proto_hostmgr_admin_proto_rawDescGZIP(), []int{7}
+}
+
+type TimeoutSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Expected interval between two heartbeats, in seconds.
+	BaseTimeoutSeconds int64 `protobuf:"varint,1,opt,name=base_timeout_seconds,json=baseTimeoutSeconds,proto3" json:"base_timeout_seconds,omitempty"`
+	// Number of base timeouts without heartbeat after which a host is reported as "No Connection".
+	TimeoutTimes int32 `protobuf:"varint,2,opt,name=timeout_times,json=timeoutTimes,proto3" json:"timeout_times,omitempty"`
+	// Derive the timeout of each host from its heartbeat history, the static timeout being the lower bound.
+	DynamicTimeout bool `protobuf:"varint,3,opt,name=dynamic_timeout,json=dynamicTimeout,proto3" json:"dynamic_timeout,omitempty"`
+}
+
+func (x *TimeoutSettings) Reset() {
+	*x = TimeoutSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeoutSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeoutSettings) ProtoMessage() {}
+
+func (x *TimeoutSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeoutSettings.ProtoReflect.Descriptor instead.
+func (*TimeoutSettings) Descriptor() ([]byte, []int) {
+	return file_hostmgr_proto_hostmgr_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TimeoutSettings) GetBaseTimeoutSeconds() int64 {
+	if x != nil {
+		return x.BaseTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *TimeoutSettings) GetTimeoutTimes() int32 {
+	if x != nil {
+		return x.TimeoutTimes
+	}
+	return 0
+}
+
+func (x *TimeoutSettings) GetDynamicTimeout() bool {
+	if x != nil {
+		return x.DynamicTimeout
+	}
+	return false
+}
+
+type FindHostsByPCIDeviceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PCI vendor ID in hexadecimal, e.g. "8086".
+	VendorId string `protobuf:"bytes,1,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	// PCI device ID in hexadecimal, any device of the vendor if empty.
+	DeviceId string `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+}
+
+func (x *FindHostsByPCIDeviceRequest) Reset() {
+	*x = FindHostsByPCIDeviceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindHostsByPCIDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindHostsByPCIDeviceRequest) ProtoMessage() {}
+
+func (x *FindHostsByPCIDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindHostsByPCIDeviceRequest.ProtoReflect.Descriptor instead.
+func (*FindHostsByPCIDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_hostmgr_proto_hostmgr_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FindHostsByPCIDeviceRequest) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *FindHostsByPCIDeviceRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+type PCIDevice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PCI address of the device, such as "0000:03:00.0".
+	Slot     string `protobuf:"bytes,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	DevClass string `protobuf:"bytes,2,opt,name=dev_class,json=devClass,proto3" json:"dev_class,omitempty"`
+	VendorId string `protobuf:"bytes,3,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	DeviceId string `protobuf:"bytes,4,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Driver   string `protobuf:"bytes,5,opt,name=driver,proto3" json:"driver,omitempty"`
+	// NUMA node of the device, -1 if the platform has no NUMA node.
+	NumaNode int32 `protobuf:"varint,6,opt,name=numa_node,json=numaNode,proto3" json:"numa_node,omitempty"`
+}
+
+func (x *PCIDevice) Reset() {
+	*x = PCIDevice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PCIDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PCIDevice) ProtoMessage() {}
+
+func (x *PCIDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PCIDevice.ProtoReflect.Descriptor instead.
+func (*PCIDevice) Descriptor() ([]byte, []int) {
+	return file_hostmgr_proto_hostmgr_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PCIDevice) GetSlot() string {
+	if x != nil {
+		return x.Slot
+	}
+	return ""
+}
+
+func (x *PCIDevice) GetDevClass() string {
+	if x != nil {
+		return x.DevClass
+	}
+	return ""
+}
+
+func (x *PCIDevice) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *PCIDevice) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *PCIDevice) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *PCIDevice) GetNumaNode() int32 {
+	if x != nil {
+		return x.NumaNode
+	}
+	return 0
+}
+
+type HostPCIDevices struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceId string `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// The matching devices of the host, sorted by slot.
+	Devices []*PCIDevice `protobuf:"bytes,2,rep,name=devices,proto3" json:"devices,omitempty"`
+}
+
+func (x *HostPCIDevices) Reset() {
+	*x = HostPCIDevices{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostPCIDevices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostPCIDevices) ProtoMessage() {}
+
+func (x *HostPCIDevices) ProtoReflect() protoreflect.Message {
+	mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostPCIDevices.ProtoReflect.Descriptor instead.
+func (*HostPCIDevices) Descriptor() ([]byte, []int) {
+	return file_hostmgr_proto_hostmgr_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *HostPCIDevices) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *HostPCIDevices) GetDevices() []*PCIDevice {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type FindHostsByPCIDeviceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hosts with at least one matching device, sorted by resource ID.
+	Hosts []*HostPCIDevices `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
+}
+
+func (x *FindHostsByPCIDeviceResponse) Reset() {
+	*x = FindHostsByPCIDeviceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindHostsByPCIDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindHostsByPCIDeviceResponse) ProtoMessage() {}
+
+func (x *FindHostsByPCIDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindHostsByPCIDeviceResponse.ProtoReflect.Descriptor instead.
+func (*FindHostsByPCIDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_hostmgr_proto_hostmgr_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FindHostsByPCIDeviceResponse) GetHosts() []*HostPCIDevices {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+type GetHostStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceId string `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+}
+
+func (x *GetHostStateRequest) Reset() {
+	*x = GetHostStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHostStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHostStateRequest) ProtoMessage() {}
+
+func (x *GetHostStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHostStateRequest.ProtoReflect.Descriptor instead.
+func (*GetHostStateRequest) Descriptor() ([]byte, []int) {
+	return file_hostmgr_proto_hostmgr_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetHostStateRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+type HostState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceId string `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// SHA-256 of the TPM endorsement key pinned on the host, empty if not reported yet.
+	TpmEkHash string `protobuf:"bytes,2,opt,name=tpm_ek_hash,json=tpmEkHash,proto3" json:"tpm_ek_hash,omitempty"`
+	// The host reported another identity than its pinned one, its messages are rejected until it is released.
+	Quarantined bool `protobuf:"varint,3,opt,name=quarantined,proto3" json:"quarantined,omitempty"`
+	// Differences between the pinned identity and the one reported when the host was quarantined.
+	IdentityMismatch string `protobuf:"bytes,4,opt,name=identity_mismatch,json=identityMismatch,proto3" json:"identity_mismatch,omitempty"`
+	// Boot ID of the running kernel of the host, empty if not reported yet.
+	BootId string `protobuf:"bytes,5,opt,name=boot_id,json=bootId,proto3" json:"boot_id,omitempty"`
+	// Time of the last boot, in milliseconds since the Unix epoch. 0 if the uptime is not reported.
+	BootTimeMs int64 `protobuf:"varint,6,opt,name=boot_time_ms,json=bootTimeMs,proto3" json:"boot_time_ms,omitempty"`
+	// Number of reboots detected.
+	RebootCount uint64 `protobuf:"varint,7,opt,name=reboot_count,json=rebootCount,proto3" json:"reboot_count,omitempty"`
+	// Number of reboots detected while the host was not expected to reboot.
+	UnexpectedRebootCount uint64 `protobuf:"varint,8,opt,name=unexpected_reboot_count,json=unexpectedRebootCount,proto3" json:"unexpected_reboot_count,omitempty"`
+	// Last reboot detected, "unexpected" or the reason it was expected, such as "maintenance".
+	LastReboot string `protobuf:"bytes,9,opt,name=last_reboot,json=lastReboot,proto3" json:"last_reboot,omitempty"`
+	// Last clock skew measured on the host, in seconds, positive if its clock is ahead.
+	ClockSkewSeconds int64 `protobuf:"varint,10,opt,name=clock_skew_seconds,json=clockSkewSeconds,proto3" json:"clock_skew_seconds,omitempty"`
+	// The clock skew has been measured, the agent reports its time.
+	ClockSkewMeasured bool `protobuf:"varint,11,opt,name=clock_skew_measured,json=clockSkewMeasured,proto3" json:"clock_skew_measured,omitempty"`
+}
+
+func (x *HostState) Reset() {
+	*x = HostState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostState) ProtoMessage() {}
+
+func (x *HostState) ProtoReflect() protoreflect.Message {
+	mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostState.ProtoReflect.Descriptor instead.
+func (*HostState) Descriptor() ([]byte, []int) {
+	return file_hostmgr_proto_hostmgr_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *HostState) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *HostState) GetTpmEkHash() string {
+	if x != nil {
+		return x.TpmEkHash
+	}
+	return ""
+}
+
+func (x *HostState) GetQuarantined() bool {
+	if x != nil {
+		return x.Quarantined
+	}
+	return false
+}
+
+func (x *HostState) GetIdentityMismatch() string {
+	if x != nil {
+		return x.IdentityMismatch
+	}
+	return ""
+}
+
+func (x *HostState) GetBootId() string {
+	if x != nil {
+		return x.BootId
+	}
+	return ""
+}
+
+func (x *HostState) GetBootTimeMs() int64 {
+	if x != nil {
+		return x.BootTimeMs
+	}
+	return 0
+}
+
+func (x *HostState) GetRebootCount() uint64 {
+	if x != nil {
+		return x.RebootCount
+	}
+	return 0
+}
+
+func (x *HostState) GetUnexpectedRebootCount() uint64 {
+	if x != nil {
+		return x.UnexpectedRebootCount
+	}
+	return 0
+}
+
+func (x *HostState) GetLastReboot() string {
+	if x != nil {
+		return x.LastReboot
+	}
+	return ""
+}
+
+func (x *HostState) GetClockSkewSeconds() int64 {
+	if x != nil {
+		return x.ClockSkewSeconds
+	}
+	return 0
+}
+
+func (x *HostState) GetClockSkewMeasured() bool {
+	if x != nil {
+		return x.ClockSkewMeasured
+	}
+	return false
+}
+
+type ReleaseHostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceId string `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+}
+
+func (x *ReleaseHostRequest) Reset() {
+	*x = ReleaseHostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseHostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseHostRequest) ProtoMessage() {}
+
+func (x *ReleaseHostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseHostRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseHostRequest) Descriptor() ([]byte, []int) {
+	return file_hostmgr_proto_hostmgr_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReleaseHostRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+type ReleaseHostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReleaseHostResponse) Reset() {
+	*x = ReleaseHostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseHostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseHostResponse) ProtoMessage() {}
+
+func (x *ReleaseHostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hostmgr_proto_hostmgr_admin_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseHostResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseHostResponse) Descriptor() ([]byte, []int) {
+	return file_hostmgr_proto_hostmgr_admin_proto_rawDescGZIP(), []int{16}
+}
+
+var File_hostmgr_proto_hostmgr_admin_proto protoreflect.FileDescriptor
+
+var file_hostmgr_proto_hostmgr_admin_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x18, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75,
+	0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x19, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xc5, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x48, 0x6f, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x72, 0x74,
+	0x62, 0x65, 0x61, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c,
+	0x61, 0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x4d, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12, 0x30, 0x0a,
+	0x14, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0x57, 0x0a, 0x18, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73,
+	0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x68, 0x6f, 0x73,
+	0x74, 0x73, 0x22, 0x4f, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x48, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xfa, 0x42,
+	0x16, 0x72, 0x14, 0x32, 0x12, 0x5e, 0x68, 0x6f, 0x73, 0x74, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x61,
+	0x2d, 0x66, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x48, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x0a, 0x11, 0x46, 0x6f, 0x72,
+	0x67, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a,
+	0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x19, 0xfa, 0x42, 0x16, 0x72, 0x14, 0x32, 0x12, 0x5e, 0x68, 0x6f, 0x73,
+	0x74, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x66, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52, 0x0a,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x46, 0x6f,
+	0x72, 0x67, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xaa, 0x01,
+	0x0a, 0x0f, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x3d, 0x0a, 0x14, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x0b, 0xfa, 0x42, 0x08, 0x22, 0x06, 0x18, 0x80, 0xa3, 0x05, 0x28, 0x01, 0x52, 0x12, 0x62, 0x61,
+	0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x2f, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0xe8,
+	0x07, 0x28, 0x01, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x79, 0x6e, 0x61,
+	0x6d, 0x69, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x1b, 0x46,
+	0x69, 0x6e, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x50, 0x43, 0x49, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x09, 0x76, 0x65,
+	0x6e, 0x64, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xfa,
+	0x42, 0x19, 0x72, 0x17, 0x32, 0x15, 0x5e, 0x28, 0x30, 0x78, 0x29, 0x3f, 0x5b, 0x30, 0x2d, 0x39,
+	0x61, 0x2d, 0x66, 0x41, 0x2d, 0x46, 0x5d, 0x7b, 0x34, 0x7d, 0x24, 0x52, 0x08, 0x76, 0x65, 0x6e,
+	0x64, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xfa, 0x42, 0x1c, 0x72, 0x1a, 0x32,
+	0x18, 0x5e, 0x24, 0x7c, 0x5e, 0x28, 0x30, 0x78, 0x29, 0x3f, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d,
+	0x66, 0x41, 0x2d, 0x46, 0x5d, 0x7b, 0x34, 0x7d, 0x24, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x64, 0x22, 0xab, 0x01, 0x0a, 0x09, 0x50, 0x43, 0x49, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x5f, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x61, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x61, 0x4e, 0x6f, 0x64,
+	0x65, 0x22, 0x70, 0x0a, 0x0e, 0x48, 0x6f, 0x73, 0x74, 0x50, 0x43, 0x49, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f,
+	0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x50, 0x43, 0x49, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x22, 0x5e, 0x0a, 0x1c, 0x46, 0x69, 0x6e, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x73,
+	0x42, 0x79, 0x50, 0x43, 0x49, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75,
+	0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x6f,
+	0x73, 0x74, 0x50, 0x43, 0x49, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x05, 0x68, 0x6f,
+	0x73, 0x74, 0x73, 0x22, 0x51, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x19, 0xfa, 0x42, 0x16, 0x72, 0x14, 0x32, 0x12, 0x5e, 0x68, 0x6f, 0x73, 0x74, 0x2d, 0x5b, 0x30,
+	0x2d, 0x39, 0x61, 0x2d, 0x66, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x22, 0xb0, 0x03, 0x0a, 0x09, 0x48, 0x6f, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x70, 0x6d, 0x5f, 0x65, 0x6b, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x70, 0x6d, 0x45,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74,
+	0x69, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x71, 0x75, 0x61, 0x72,
+	0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x5f, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4d, 0x69, 0x73, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x0c, 0x62, 0x6f, 0x6f, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x6f, 0x6f, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x72, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x15, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52,
+	0x65, 0x62, 0x6f, 0x6f, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x72, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x63,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x6b, 0x65, 0x77, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x6b,
+	0x65, 0x77, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x73, 0x6b, 0x65, 0x77, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x6b, 0x65,
+	0x77, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x22, 0x50, 0x0a, 0x12, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3a, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xfa, 0x42, 0x16, 0x72, 0x14, 0x32, 0x12, 0x5e, 0x68, 0x6f,
+	0x73, 0x74, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x66, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52,
+	0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x32, 0xa8, 0x07, 0x0a, 0x0c, 0x48, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x12, 0x7b, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x31, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67,
+	0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x48, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x68, 0x6f, 0x73,
+	0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x64, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x69, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x2b,
+	0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x6f,
+	0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x48, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x0a, 0x46,
+	0x6f, 0x72, 0x67, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x2b, 0x2e, 0x68, 0x6f, 0x73, 0x74,
+	0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72,
+	0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x76, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x33, 0x2e, 0x68,
+	0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74,
+	0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x00, 0x12, 0x6f,
+	0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x29, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67,
+	0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x1a, 0x29, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75,
+	0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x00, 0x12,
+	0x87, 0x01, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x50,
+	0x43, 0x49, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x35, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d,
+	0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x50,
+	0x43, 0x49, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x36, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x48,
+	0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x50, 0x43, 0x49, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x48, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x2e, 0x68, 0x6f, 0x73, 0x74,
+	0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d,
+	0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x00, 0x12,
+	0x6c, 0x0a, 0x0b, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x2c,
+	0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x68,
+	0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x48,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x16, 0x5a,
+	0x14, 0x2e, 0x3b, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_hostmgr_proto_hostmgr_admin_proto_rawDescOnce sync.Once
+	file_hostmgr_proto_hostmgr_admin_proto_rawDescData = file_hostmgr_proto_hostmgr_admin_proto_rawDesc
+)
+
+func file_hostmgr_proto_hostmgr_admin_proto_rawDescGZIP() []byte {
+	file_hostmgr_proto_hostmgr_admin_proto_rawDescOnce.Do(func() {
+		file_hostmgr_proto_hostmgr_admin_proto_rawDescData = protoimpl.X.CompressGZIP(file_hostmgr_proto_hostmgr_admin_proto_rawDescData)
+	})
+	return file_hostmgr_proto_hostmgr_admin_proto_rawDescData
+}
+
+var file_hostmgr_proto_hostmgr_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_hostmgr_proto_hostmgr_admin_proto_goTypes = []interface{}{
+	(*ListTrackedHostsRequest)(nil),      // 0: hostmgr_southbound_proto.ListTrackedHostsRequest
+	(*TrackedHost)(nil),                  // 1: hostmgr_southbound_proto.TrackedHost
+	(*ListTrackedHostsResponse)(nil),     // 2: hostmgr_southbound_proto.ListTrackedHostsResponse
+	(*ExpireHostRequest)(nil),            // 3: hostmgr_southbound_proto.ExpireHostRequest
+	(*ExpireHostResponse)(nil),           // 4: hostmgr_southbound_proto.ExpireHostResponse
+	(*ForgetHostRequest)(nil),            // 5: hostmgr_southbound_proto.ForgetHostRequest
+	(*ForgetHostResponse)(nil),           // 6: hostmgr_southbound_proto.ForgetHostResponse
+	(*GetTimeoutSettingsRequest)(nil),    // 7: hostmgr_southbound_proto.GetTimeoutSettingsRequest
+	(*TimeoutSettings)(nil),              // 8: hostmgr_southbound_proto.TimeoutSettings
+	(*FindHostsByPCIDeviceRequest)(nil),  // 9: hostmgr_southbound_proto.FindHostsByPCIDeviceRequest
+	(*PCIDevice)(nil),                    // 10: hostmgr_southbound_proto.PCIDevice
+	(*HostPCIDevices)(nil),               // 11: hostmgr_southbound_proto.HostPCIDevices
+	(*FindHostsByPCIDeviceResponse)(nil), // 12: hostmgr_southbound_proto.FindHostsByPCIDeviceResponse
+	(*GetHostStateRequest)(nil),          // 13: hostmgr_southbound_proto.GetHostStateRequest
+	(*HostState)(nil),                    // 14: hostmgr_southbound_proto.HostState
+	(*ReleaseHostRequest)(nil),           // 15: hostmgr_southbound_proto.ReleaseHostRequest
+	(*ReleaseHostResponse)(nil),          // 16: hostmgr_southbound_proto.ReleaseHostResponse
+}
+var file_hostmgr_proto_hostmgr_admin_proto_depIdxs = []int32{
+	1,  // 0: hostmgr_southbound_proto.ListTrackedHostsResponse.hosts:type_name -> hostmgr_southbound_proto.TrackedHost
+	10, // 1: hostmgr_southbound_proto.HostPCIDevices.devices:type_name -> hostmgr_southbound_proto.PCIDevice
+	11, // 2: hostmgr_southbound_proto.FindHostsByPCIDeviceResponse.hosts:type_name -> hostmgr_southbound_proto.HostPCIDevices
+	0,  // 3: hostmgr_southbound_proto.HostmgrAdmin.ListTrackedHosts:input_type -> hostmgr_southbound_proto.ListTrackedHostsRequest
+	3,  // 4: hostmgr_southbound_proto.HostmgrAdmin.ExpireHost:input_type -> hostmgr_southbound_proto.ExpireHostRequest
+	5,  // 5: hostmgr_southbound_proto.HostmgrAdmin.ForgetHost:input_type -> hostmgr_southbound_proto.ForgetHostRequest
+	7,  // 6: hostmgr_southbound_proto.HostmgrAdmin.GetTimeoutSettings:input_type -> hostmgr_southbound_proto.GetTimeoutSettingsRequest
+	8,  // 7: hostmgr_southbound_proto.HostmgrAdmin.UpdateTimeoutSettings:input_type -> hostmgr_southbound_proto.TimeoutSettings
+	9,  // 8: hostmgr_southbound_proto.HostmgrAdmin.FindHostsByPCIDevice:input_type -> hostmgr_southbound_proto.FindHostsByPCIDeviceRequest
+	13, // 9: hostmgr_southbound_proto.HostmgrAdmin.GetHostState:input_type -> hostmgr_southbound_proto.GetHostStateRequest
+	15, // 10: hostmgr_southbound_proto.HostmgrAdmin.ReleaseHost:input_type -> hostmgr_southbound_proto.ReleaseHostRequest
+	2,  // 11: hostmgr_southbound_proto.HostmgrAdmin.ListTrackedHosts:output_type -> hostmgr_southbound_proto.ListTrackedHostsResponse
+	4,  // 12: hostmgr_southbound_proto.HostmgrAdmin.ExpireHost:output_type -> hostmgr_southbound_proto.ExpireHostResponse
+	6,  // 13: hostmgr_southbound_proto.HostmgrAdmin.ForgetHost:output_type -> hostmgr_southbound_proto.ForgetHostResponse
+	8,  // 14: hostmgr_southbound_proto.HostmgrAdmin.GetTimeoutSettings:output_type -> hostmgr_southbound_proto.TimeoutSettings
+	8,  // 15: hostmgr_southbound_proto.HostmgrAdmin.UpdateTimeoutSettings:output_type -> hostmgr_southbound_proto.TimeoutSettings
+	12, // 16: hostmgr_southbound_proto.HostmgrAdmin.FindHostsByPCIDevice:output_type -> hostmgr_southbound_proto.FindHostsByPCIDeviceResponse
+	14, // 17: hostmgr_southbound_proto.HostmgrAdmin.GetHostState:output_type -> hostmgr_southbound_proto.HostState
+	16, // 18: hostmgr_southbound_proto.HostmgrAdmin.ReleaseHost:output_type -> hostmgr_southbound_proto.ReleaseHostResponse
+	11, // [11:19] is the sub-list for method output_type
+	3,  // [3:11] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_hostmgr_proto_hostmgr_admin_proto_init() }
+func file_hostmgr_proto_hostmgr_admin_proto_init() {
+	if File_hostmgr_proto_hostmgr_admin_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_hostmgr_proto_hostmgr_admin_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTrackedHostsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hostmgr_proto_hostmgr_admin_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackedHost); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hostmgr_proto_hostmgr_admin_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTrackedHostsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hostmgr_proto_hostmgr_admin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpireHostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hostmgr_proto_hostmgr_admin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpireHostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hostmgr_proto_hostmgr_admin_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForgetHostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hostmgr_proto_hostmgr_admin_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForgetHostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hostmgr_proto_hostmgr_admin_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimeoutSettingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hostmgr_proto_hostmgr_admin_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeoutSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hostmgr_proto_hostmgr_admin_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindHostsByPCIDeviceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hostmgr_proto_hostmgr_admin_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PCIDevice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hostmgr_proto_hostmgr_admin_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostPCIDevices); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hostmgr_proto_hostmgr_admin_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindHostsByPCIDeviceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hostmgr_proto_hostmgr_admin_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHostStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hostmgr_proto_hostmgr_admin_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hostmgr_proto_hostmgr_admin_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseHostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hostmgr_proto_hostmgr_admin_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseHostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_hostmgr_proto_hostmgr_admin_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_hostmgr_proto_hostmgr_admin_proto_goTypes,
+		DependencyIndexes: file_hostmgr_proto_hostmgr_admin_proto_depIdxs,
+		MessageInfos:      file_hostmgr_proto_hostmgr_admin_proto_msgTypes,
+	}.Build()
+	File_hostmgr_proto_hostmgr_admin_proto = out.File
+	file_hostmgr_proto_hostmgr_admin_proto_rawDesc = nil
+	file_hostmgr_proto_hostmgr_admin_proto_goTypes = nil
+	file_hostmgr_proto_hostmgr_admin_proto_depIdxs = nil
+}
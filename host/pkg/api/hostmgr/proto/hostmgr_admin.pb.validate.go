@@ -0,0 +1,2015 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: hostmgr/proto/hostmgr_admin.proto
+
+package hostmgr_southbound
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _hostmgr_admin_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on ListTrackedHostsRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ListTrackedHostsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTrackedHostsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTrackedHostsRequestMultiError, or nil if none found.
+func (m *ListTrackedHostsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTrackedHostsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListTrackedHostsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTrackedHostsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTrackedHostsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTrackedHostsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTrackedHostsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTrackedHostsRequestMultiError) AllErrors() []error { return m }
+
+// ListTrackedHostsRequestValidationError is the validation error returned by
+// ListTrackedHostsRequest.Validate if the designated constraints aren't met.
+type ListTrackedHostsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTrackedHostsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTrackedHostsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTrackedHostsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTrackedHostsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTrackedHostsRequestValidationError) ErrorName() string {
+	return "ListTrackedHostsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTrackedHostsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTrackedHostsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTrackedHostsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTrackedHostsRequestValidationError{}
+
+// Validate checks the field values on TrackedHost with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TrackedHost) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TrackedHost with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in TrackedHostMultiError, or nil if
+// none found.
+func (m *TrackedHost) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TrackedHost) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResourceId
+
+	// no validation rules for LastHeartbeatMs
+
+	// no validation rules for TimeoutMs
+
+	// no validation rules for RemainingTimeoutMs
+
+	// no validation rules for Expired
+
+	if len(errors) > 0 {
+		return TrackedHostMultiError(errors)
+	}
+
+	return nil
+}
+
+// TrackedHostMultiError is an error wrapping multiple validation errors
+// returned by TrackedHost.ValidateAll() if the designated constraints aren't
+// met.
+type TrackedHostMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TrackedHostMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TrackedHostMultiError) AllErrors() []error { return m }
+
+// TrackedHostValidationError is the validation error returned by
+// TrackedHost.Validate if the designated constraints aren't met.
+type TrackedHostValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrackedHostValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrackedHostValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrackedHostValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrackedHostValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrackedHostValidationError) ErrorName() string { return "TrackedHostValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TrackedHostValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrackedHost.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrackedHostValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrackedHostValidationError{}
+
+// Validate checks the field values on ListTrackedHostsResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ListTrackedHostsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTrackedHostsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTrackedHostsResponseMultiError, or nil if none found.
+func (m *ListTrackedHostsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTrackedHostsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHosts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTrackedHostsResponseValidationError{
+						field:  fmt.Sprintf("Hosts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTrackedHostsResponseValidationError{
+						field:  fmt.Sprintf("Hosts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTrackedHostsResponseValidationError{
+					field:  fmt.Sprintf("Hosts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTrackedHostsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTrackedHostsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTrackedHostsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListTrackedHostsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTrackedHostsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTrackedHostsResponseMultiError) AllErrors() []error { return m }
+
+// ListTrackedHostsResponseValidationError is the validation error returned by
+// ListTrackedHostsResponse.Validate if the designated constraints aren't met.
+type ListTrackedHostsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTrackedHostsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTrackedHostsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTrackedHostsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTrackedHostsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTrackedHostsResponseValidationError) ErrorName() string {
+	return "ListTrackedHostsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTrackedHostsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTrackedHostsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTrackedHostsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTrackedHostsResponseValidationError{}
+
+// Validate checks the field values on ExpireHostRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExpireHostRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExpireHostRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ExpireHostRequestMultiError, or nil if none found.
+func (m *ExpireHostRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExpireHostRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ExpireHostRequest_ResourceId_Pattern.MatchString(m.GetResourceId()) {
+		err := ExpireHostRequestValidationError{
+			field:  "ResourceId",
+			reason: "value does not match regex pattern \"^host-[0-9a-f]{8}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExpireHostRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExpireHostRequestMultiError is an error wrapping multiple validation errors
+// returned by ExpireHostRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ExpireHostRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExpireHostRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExpireHostRequestMultiError) AllErrors() []error { return m }
+
+// ExpireHostRequestValidationError is the validation error returned by
+// ExpireHostRequest.Validate if the designated constraints aren't met.
+type ExpireHostRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExpireHostRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExpireHostRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExpireHostRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExpireHostRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExpireHostRequestValidationError) ErrorName() string {
+	return "ExpireHostRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExpireHostRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExpireHostRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExpireHostRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExpireHostRequestValidationError{}
+
+var _ExpireHostRequest_ResourceId_Pattern = regexp.MustCompile("^host-[0-9a-f]{8}$")
+
+// Validate checks the field values on ExpireHostResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ExpireHostResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExpireHostResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ExpireHostResponseMultiError, or nil if none found.
+func (m *ExpireHostResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExpireHostResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ExpireHostResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExpireHostResponseMultiError is an error wrapping multiple validation errors
+// returned by ExpireHostResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ExpireHostResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExpireHostResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExpireHostResponseMultiError) AllErrors() []error { return m }
+
+// ExpireHostResponseValidationError is the validation error returned by
+// ExpireHostResponse.Validate if the designated constraints aren't met.
+type ExpireHostResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExpireHostResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExpireHostResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExpireHostResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExpireHostResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExpireHostResponseValidationError) ErrorName() string {
+	return "ExpireHostResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExpireHostResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExpireHostResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExpireHostResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExpireHostResponseValidationError{}
+
+// Validate checks the field values on ForgetHostRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ForgetHostRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForgetHostRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ForgetHostRequestMultiError, or nil if none found.
+func (m *ForgetHostRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForgetHostRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ForgetHostRequest_ResourceId_Pattern.MatchString(m.GetResourceId()) {
+		err := ForgetHostRequestValidationError{
+			field:  "ResourceId",
+			reason: "value does not match regex pattern \"^host-[0-9a-f]{8}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ForgetHostRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForgetHostRequestMultiError is an error wrapping multiple validation errors
+// returned by ForgetHostRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ForgetHostRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForgetHostRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForgetHostRequestMultiError) AllErrors() []error { return m }
+
+// ForgetHostRequestValidationError is the validation error returned by
+// ForgetHostRequest.Validate if the designated constraints aren't met.
+type ForgetHostRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForgetHostRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForgetHostRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForgetHostRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForgetHostRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForgetHostRequestValidationError) ErrorName() string {
+	return "ForgetHostRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForgetHostRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForgetHostRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForgetHostRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForgetHostRequestValidationError{}
+
+var _ForgetHostRequest_ResourceId_Pattern = regexp.MustCompile("^host-[0-9a-f]{8}$")
+
+// Validate checks the field values on ForgetHostResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ForgetHostResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForgetHostResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ForgetHostResponseMultiError, or nil if none found.
+func (m *ForgetHostResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForgetHostResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ForgetHostResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForgetHostResponseMultiError is an error wrapping multiple validation errors
+// returned by ForgetHostResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ForgetHostResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForgetHostResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForgetHostResponseMultiError) AllErrors() []error { return m }
+
+// ForgetHostResponseValidationError is the validation error returned by
+// ForgetHostResponse.Validate if the designated constraints aren't met.
+type ForgetHostResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForgetHostResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForgetHostResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForgetHostResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForgetHostResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForgetHostResponseValidationError) ErrorName() string {
+	return "ForgetHostResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForgetHostResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForgetHostResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForgetHostResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForgetHostResponseValidationError{}
+
+// Validate checks the field values on GetTimeoutSettingsRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *GetTimeoutSettingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTimeoutSettingsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTimeoutSettingsRequestMultiError, or nil if none found.
+func (m *GetTimeoutSettingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTimeoutSettingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetTimeoutSettingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTimeoutSettingsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTimeoutSettingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTimeoutSettingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTimeoutSettingsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTimeoutSettingsRequestMultiError) AllErrors() []error { return m }
+
+// GetTimeoutSettingsRequestValidationError is the validation error returned by
+// GetTimeoutSettingsRequest.Validate if the designated constraints aren't met.
+type GetTimeoutSettingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTimeoutSettingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTimeoutSettingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTimeoutSettingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTimeoutSettingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTimeoutSettingsRequestValidationError) ErrorName() string {
+	return "GetTimeoutSettingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTimeoutSettingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTimeoutSettingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTimeoutSettingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTimeoutSettingsRequestValidationError{}
+
+// Validate checks the field values on TimeoutSettings with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TimeoutSettings) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeoutSettings with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// TimeoutSettingsMultiError, or nil if none found.
+func (m *TimeoutSettings) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeoutSettings) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetBaseTimeoutSeconds(); val < 1 || val > 86400 {
+		err := TimeoutSettingsValidationError{
+			field:  "BaseTimeoutSeconds",
+			reason: "value must be inside range [1, 86400]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetTimeoutTimes(); val < 1 || val > 1000 {
+		err := TimeoutSettingsValidationError{
+			field:  "TimeoutTimes",
+			reason: "value must be inside range [1, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DynamicTimeout
+
+	if len(errors) > 0 {
+		return TimeoutSettingsMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeoutSettingsMultiError is an error wrapping multiple validation errors
+// returned by TimeoutSettings.ValidateAll() if the designated constraints
+// aren't met.
+type TimeoutSettingsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeoutSettingsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeoutSettingsMultiError) AllErrors() []error { return m }
+
+// TimeoutSettingsValidationError is the validation error returned by
+// TimeoutSettings.Validate if the designated constraints aren't met.
+type TimeoutSettingsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeoutSettingsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeoutSettingsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeoutSettingsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeoutSettingsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeoutSettingsValidationError) ErrorName() string { return "TimeoutSettingsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TimeoutSettingsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeoutSettings.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeoutSettingsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeoutSettingsValidationError{}
+
+// Validate checks the field values on FindHostsByPCIDeviceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *FindHostsByPCIDeviceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FindHostsByPCIDeviceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FindHostsByPCIDeviceRequestMultiError, or nil if none found.
+func (m *FindHostsByPCIDeviceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FindHostsByPCIDeviceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_FindHostsByPCIDeviceRequest_VendorId_Pattern.MatchString(m.GetVendorId()) {
+		err := FindHostsByPCIDeviceRequestValidationError{
+			field:  "VendorId",
+			reason: "value does not match regex pattern \"^(0x)?[0-9a-fA-F]{4}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_FindHostsByPCIDeviceRequest_DeviceId_Pattern.MatchString(m.GetDeviceId()) {
+		err := FindHostsByPCIDeviceRequestValidationError{
+			field:  "DeviceId",
+			reason: "value does not match regex pattern \"^$|^(0x)?[0-9a-fA-F]{4}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FindHostsByPCIDeviceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FindHostsByPCIDeviceRequestMultiError is an error wrapping multiple
+// validation errors returned by FindHostsByPCIDeviceRequest.ValidateAll() if
+// the designated constraints aren't met.
+type FindHostsByPCIDeviceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FindHostsByPCIDeviceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FindHostsByPCIDeviceRequestMultiError) AllErrors() []error { return m }
+
+// FindHostsByPCIDeviceRequestValidationError is the validation error returned
+// by FindHostsByPCIDeviceRequest.Validate if the designated constraints aren't
+// met.
+type FindHostsByPCIDeviceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindHostsByPCIDeviceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindHostsByPCIDeviceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindHostsByPCIDeviceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindHostsByPCIDeviceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindHostsByPCIDeviceRequestValidationError) ErrorName() string {
+	return "FindHostsByPCIDeviceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindHostsByPCIDeviceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindHostsByPCIDeviceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindHostsByPCIDeviceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindHostsByPCIDeviceRequestValidationError{}
+
+var _FindHostsByPCIDeviceRequest_VendorId_Pattern = regexp.MustCompile("^(0x)?[0-9a-fA-F]{4}$")
+
+var _FindHostsByPCIDeviceRequest_DeviceId_Pattern = regexp.MustCompile("^$|^(0x)?[0-9a-fA-F]{4}$")
+
+// Validate checks the field values on PCIDevice with the rules defined in the
+// proto definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *PCIDevice) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PCIDevice with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in PCIDeviceMultiError, or nil if none
+// found.
+func (m *PCIDevice) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PCIDevice) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Slot
+
+	// no validation rules for DevClass
+
+	// no validation rules for VendorId
+
+	// no validation rules for DeviceId
+
+	// no validation rules for Driver
+
+	// no validation rules for NumaNode
+
+	if len(errors) > 0 {
+		return PCIDeviceMultiError(errors)
+	}
+
+	return nil
+}
+
+// PCIDeviceMultiError is an error wrapping multiple validation errors returned
+// by PCIDevice.ValidateAll() if the designated constraints aren't met.
+type PCIDeviceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PCIDeviceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PCIDeviceMultiError) AllErrors() []error { return m }
+
+// PCIDeviceValidationError is the validation error returned by
+// PCIDevice.Validate if the designated constraints aren't met.
+type PCIDeviceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PCIDeviceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PCIDeviceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PCIDeviceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PCIDeviceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PCIDeviceValidationError) ErrorName() string { return "PCIDeviceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PCIDeviceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPCIDevice.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PCIDeviceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PCIDeviceValidationError{}
+
+// Validate checks the field values on HostPCIDevices with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HostPCIDevices) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HostPCIDevices with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HostPCIDevicesMultiError, or
+// nil if none found.
+func (m *HostPCIDevices) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HostPCIDevices) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResourceId
+
+	for idx, item := range m.GetDevices() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HostPCIDevicesValidationError{
+						field:  fmt.Sprintf("Devices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HostPCIDevicesValidationError{
+						field:  fmt.Sprintf("Devices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HostPCIDevicesValidationError{
+					field:  fmt.Sprintf("Devices[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return HostPCIDevicesMultiError(errors)
+	}
+
+	return nil
+}
+
+// HostPCIDevicesMultiError is an error wrapping multiple validation errors
+// returned by HostPCIDevices.ValidateAll() if the designated constraints aren't
+// met.
+type HostPCIDevicesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HostPCIDevicesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HostPCIDevicesMultiError) AllErrors() []error { return m }
+
+// HostPCIDevicesValidationError is the validation error returned by
+// HostPCIDevices.Validate if the designated constraints aren't met.
+type HostPCIDevicesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HostPCIDevicesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HostPCIDevicesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HostPCIDevicesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HostPCIDevicesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HostPCIDevicesValidationError) ErrorName() string { return "HostPCIDevicesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HostPCIDevicesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHostPCIDevices.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HostPCIDevicesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HostPCIDevicesValidationError{}
+
+// Validate checks the field values on FindHostsByPCIDeviceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *FindHostsByPCIDeviceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FindHostsByPCIDeviceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FindHostsByPCIDeviceResponseMultiError, or nil if none found.
+func (m *FindHostsByPCIDeviceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FindHostsByPCIDeviceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHosts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FindHostsByPCIDeviceResponseValidationError{
+						field:  fmt.Sprintf("Hosts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FindHostsByPCIDeviceResponseValidationError{
+						field:  fmt.Sprintf("Hosts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FindHostsByPCIDeviceResponseValidationError{
+					field:  fmt.Sprintf("Hosts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FindHostsByPCIDeviceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FindHostsByPCIDeviceResponseMultiError is an error wrapping multiple
+// validation errors returned by FindHostsByPCIDeviceResponse.ValidateAll() if
+// the designated constraints aren't met.
+type FindHostsByPCIDeviceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FindHostsByPCIDeviceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FindHostsByPCIDeviceResponseMultiError) AllErrors() []error { return m }
+
+// FindHostsByPCIDeviceResponseValidationError is the validation error returned
+// by FindHostsByPCIDeviceResponse.Validate if the designated constraints aren't
+// met.
+type FindHostsByPCIDeviceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindHostsByPCIDeviceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindHostsByPCIDeviceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindHostsByPCIDeviceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindHostsByPCIDeviceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindHostsByPCIDeviceResponseValidationError) ErrorName() string {
+	return "FindHostsByPCIDeviceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindHostsByPCIDeviceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindHostsByPCIDeviceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindHostsByPCIDeviceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindHostsByPCIDeviceResponseValidationError{}
+
+// Validate checks the field values on GetHostStateRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *GetHostStateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetHostStateRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// GetHostStateRequestMultiError, or nil if none found.
+func (m *GetHostStateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetHostStateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetHostStateRequest_ResourceId_Pattern.MatchString(m.GetResourceId()) {
+		err := GetHostStateRequestValidationError{
+			field:  "ResourceId",
+			reason: "value does not match regex pattern \"^host-[0-9a-f]{8}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetHostStateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetHostStateRequestMultiError is an error wrapping multiple validation errors
+// returned by GetHostStateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetHostStateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetHostStateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetHostStateRequestMultiError) AllErrors() []error { return m }
+
+// GetHostStateRequestValidationError is the validation error returned by
+// GetHostStateRequest.Validate if the designated constraints aren't met.
+type GetHostStateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetHostStateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetHostStateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetHostStateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetHostStateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetHostStateRequestValidationError) ErrorName() string {
+	return "GetHostStateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetHostStateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetHostStateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetHostStateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetHostStateRequestValidationError{}
+
+var _GetHostStateRequest_ResourceId_Pattern = regexp.MustCompile("^host-[0-9a-f]{8}$")
+
+// Validate checks the field values on HostState with the rules defined in the
+// proto definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *HostState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HostState with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in HostStateMultiError, or nil if none
+// found.
+func (m *HostState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HostState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResourceId
+
+	// no validation rules for TpmEkHash
+
+	// no validation rules for Quarantined
+
+	// no validation rules for IdentityMismatch
+
+	// no validation rules for BootId
+
+	// no validation rules for BootTimeMs
+
+	// no validation rules for RebootCount
+
+	// no validation rules for UnexpectedRebootCount
+
+	// no validation rules for LastReboot
+
+	// no validation rules for ClockSkewSeconds
+
+	// no validation rules for ClockSkewMeasured
+
+	if len(errors) > 0 {
+		return HostStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// HostStateMultiError is an error wrapping multiple validation errors returned
+// by HostState.ValidateAll() if the designated constraints aren't met.
+type HostStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HostStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HostStateMultiError) AllErrors() []error { return m }
+
+// HostStateValidationError is the validation error returned by
+// HostState.Validate if the designated constraints aren't met.
+type HostStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HostStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HostStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HostStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HostStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HostStateValidationError) ErrorName() string { return "HostStateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HostStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHostState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HostStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HostStateValidationError{}
+
+// Validate checks the field values on ReleaseHostRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseHostRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseHostRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ReleaseHostRequestMultiError, or nil if none found.
+func (m *ReleaseHostRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseHostRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ReleaseHostRequest_ResourceId_Pattern.MatchString(m.GetResourceId()) {
+		err := ReleaseHostRequestValidationError{
+			field:  "ResourceId",
+			reason: "value does not match regex pattern \"^host-[0-9a-f]{8}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReleaseHostRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseHostRequestMultiError is an error wrapping multiple validation errors
+// returned by ReleaseHostRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReleaseHostRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseHostRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseHostRequestMultiError) AllErrors() []error { return m }
+
+// ReleaseHostRequestValidationError is the validation error returned by
+// ReleaseHostRequest.Validate if the designated constraints aren't met.
+type ReleaseHostRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseHostRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseHostRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseHostRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseHostRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseHostRequestValidationError) ErrorName() string {
+	return "ReleaseHostRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseHostRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseHostRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseHostRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseHostRequestValidationError{}
+
+var _ReleaseHostRequest_ResourceId_Pattern = regexp.MustCompile("^host-[0-9a-f]{8}$")
+
+// Validate checks the field values on ReleaseHostResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseHostResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseHostResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ReleaseHostResponseMultiError, or nil if none found.
+func (m *ReleaseHostResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseHostResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReleaseHostResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseHostResponseMultiError is an error wrapping multiple validation errors
+// returned by ReleaseHostResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ReleaseHostResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseHostResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseHostResponseMultiError) AllErrors() []error { return m }
+
+// ReleaseHostResponseValidationError is the validation error returned by
+// ReleaseHostResponse.Validate if the designated constraints aren't met.
+type ReleaseHostResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseHostResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseHostResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseHostResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseHostResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseHostResponseValidationError) ErrorName() string {
+	return "ReleaseHostResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseHostResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseHostResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseHostResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseHostResponseValidationError{}
@@ -0,0 +1,379 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: hostmgr/proto/hostmgr_admin.proto
+
+package hostmgr_southbound
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// HostmgrAdminClient is the client API for HostmgrAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HostmgrAdminClient interface {
+	// Lists the hosts whose heartbeat is tracked, with their last heartbeat and remaining timeout.
+	ListTrackedHosts(ctx context.Context, in *ListTrackedHostsRequest, opts ...grpc.CallOption) (*ListTrackedHostsResponse, error)
+	// Expires the heartbeat of a host now, the host is reported as "No Connection".
+	ExpireHost(ctx context.Context, in *ExpireHostRequest, opts ...grpc.CallOption) (*ExpireHostResponse, error)
+	// Stops tracking the heartbeat of a host, until its next heartbeat.
+	ForgetHost(ctx context.Context, in *ForgetHostRequest, opts ...grpc.CallOption) (*ForgetHostResponse, error)
+	// Returns the timeout settings of the heartbeats.
+	GetTimeoutSettings(ctx context.Context, in *GetTimeoutSettingsRequest, opts ...grpc.CallOption) (*TimeoutSettings, error)
+	// Changes the timeout settings of the heartbeats, of all tenants, until the Host Manager restarts.
+	// Only the operators of the platform can change them, with a role that is not scoped to a project.
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
+	UpdateTimeoutSettings(ctx context.Context, in *TimeoutSettings, opts ...grpc.CallOption) (*TimeoutSettings, error)
+	// Finds the hosts having a PCI device with the given vendor ID and, if set, device ID, e.g. a given accelerator.
+	FindHostsByPCIDevice(ctx context.Context, in *FindHostsByPCIDeviceRequest, opts ...grpc.CallOption) (*FindHostsByPCIDeviceResponse, error)
+	// Returns the state tracked for a host from the reports of its agent, such as its quarantine.
+	GetHostState(ctx context.Context, in *GetHostStateRequest, opts ...grpc.CallOption) (*HostState, error)
+	// Releases a host quarantined after reporting another identity than its pinned one, once its identity has been
+	// checked. The pinned TPM endorsement key hash is dropped too, the one reported next is pinned.
+	ReleaseHost(ctx context.Context, in *ReleaseHostRequest, opts ...grpc.CallOption) (*ReleaseHostResponse, error)
+}
+
+type hostmgrAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHostmgrAdminClient(cc grpc.ClientConnInterface) HostmgrAdminClient {
+	return &hostmgrAdminClient{cc}
+}
+
+func (c *hostmgrAdminClient) ListTrackedHosts(ctx context.Context, in *ListTrackedHostsRequest, opts ...grpc.CallOption) (*ListTrackedHostsResponse, error) {
+	out := new(ListTrackedHostsResponse)
+	err := c.cc.Invoke(ctx, "/hostmgr_southbound_proto.HostmgrAdmin/ListTrackedHosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hostmgrAdminClient) ExpireHost(ctx context.Context, in *ExpireHostRequest, opts ...grpc.CallOption) (*ExpireHostResponse, error) {
+	out := new(ExpireHostResponse)
+	err := c.cc.Invoke(ctx, "/hostmgr_southbound_proto.HostmgrAdmin/ExpireHost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hostmgrAdminClient) ForgetHost(ctx context.Context, in *ForgetHostRequest, opts ...grpc.CallOption) (*ForgetHostResponse, error) {
+	out := new(ForgetHostResponse)
+	err := c.cc.Invoke(ctx, "/hostmgr_southbound_proto.HostmgrAdmin/ForgetHost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hostmgrAdminClient) GetTimeoutSettings(ctx context.Context, in *GetTimeoutSettingsRequest, opts ...grpc.CallOption) (*TimeoutSettings, error) {
+	out := new(TimeoutSettings)
+	err := c.cc.Invoke(ctx, "/hostmgr_southbound_proto.HostmgrAdmin/GetTimeoutSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hostmgrAdminClient) UpdateTimeoutSettings(ctx context.Context, in *TimeoutSettings, opts ...grpc.CallOption) (*TimeoutSettings, error) {
+	out := new(TimeoutSettings)
+	err := c.cc.Invoke(ctx, "/hostmgr_southbound_proto.HostmgrAdmin/UpdateTimeoutSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hostmgrAdminClient) FindHostsByPCIDevice(ctx context.Context, in *FindHostsByPCIDeviceRequest, opts ...grpc.CallOption) (*FindHostsByPCIDeviceResponse, error) {
+	out := new(FindHostsByPCIDeviceResponse)
+	err := c.cc.Invoke(ctx, "/hostmgr_southbound_proto.HostmgrAdmin/FindHostsByPCIDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hostmgrAdminClient) GetHostState(ctx context.Context, in *GetHostStateRequest, opts ...grpc.CallOption) (*HostState, error) {
+	out := new(HostState)
+	err := c.cc.Invoke(ctx, "/hostmgr_southbound_proto.HostmgrAdmin/GetHostState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hostmgrAdminClient) ReleaseHost(ctx context.Context, in *ReleaseHostRequest, opts ...grpc.CallOption) (*ReleaseHostResponse, error) {
+	out := new(ReleaseHostResponse)
+	err := c.cc.Invoke(ctx, "/hostmgr_southbound_proto.HostmgrAdmin/ReleaseHost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HostmgrAdminServer is the server API for HostmgrAdmin service.
+// All implementations should embed UnimplementedHostmgrAdminServer
+// for forward compatibility
+type HostmgrAdminServer interface {
+	// Lists the hosts whose heartbeat is tracked, with their last heartbeat and remaining timeout.
+	ListTrackedHosts(context.Context, *ListTrackedHostsRequest) (*ListTrackedHostsResponse, error)
+	// Expires the heartbeat of a host now, the host is reported as "No Connection".
+	ExpireHost(context.Context, *ExpireHostRequest) (*ExpireHostResponse, error)
+	// Stops tracking the heartbeat of a host, until its next heartbeat.
+	ForgetHost(context.Context, *ForgetHostRequest) (*ForgetHostResponse, error)
+	// Returns the timeout settings of the heartbeats.
+	GetTimeoutSettings(context.Context, *GetTimeoutSettingsRequest) (*TimeoutSettings, error)
+	// Changes the timeout settings of the heartbeats, of all tenants, until the Host Manager restarts.
+	// Only the operators of the platform can change them, with a role that is not scoped to a project.
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
+	UpdateTimeoutSettings(context.Context, *TimeoutSettings) (*TimeoutSettings, error)
+	// Finds the hosts having a PCI device with the given vendor ID and, if set, device ID, e.g. a given accelerator.
+	FindHostsByPCIDevice(context.Context, *FindHostsByPCIDeviceRequest) (*FindHostsByPCIDeviceResponse, error)
+	// Returns the state tracked for a host from the reports of its agent, such as its quarantine.
+	GetHostState(context.Context, *GetHostStateRequest) (*HostState, error)
+	// Releases a host quarantined after reporting another identity than its pinned one, once its identity has been
+	// checked. The pinned TPM endorsement key hash is dropped too, the one reported next is pinned.
+	ReleaseHost(context.Context, *ReleaseHostRequest) (*ReleaseHostResponse, error)
+}
+
+// UnimplementedHostmgrAdminServer should be embedded to have forward compatible implementations.
+type UnimplementedHostmgrAdminServer struct {
+}
+
+func (UnimplementedHostmgrAdminServer) ListTrackedHosts(context.Context, *ListTrackedHostsRequest) (*ListTrackedHostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrackedHosts not implemented")
+}
+func (UnimplementedHostmgrAdminServer) ExpireHost(context.Context, *ExpireHostRequest) (*ExpireHostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpireHost not implemented")
+}
+func (UnimplementedHostmgrAdminServer) ForgetHost(context.Context, *ForgetHostRequest) (*ForgetHostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForgetHost not implemented")
+}
+func (UnimplementedHostmgrAdminServer) GetTimeoutSettings(context.Context, *GetTimeoutSettingsRequest) (*TimeoutSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimeoutSettings not implemented")
+}
+func (UnimplementedHostmgrAdminServer) UpdateTimeoutSettings(context.Context, *TimeoutSettings) (*TimeoutSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTimeoutSettings not implemented")
+}
+func (UnimplementedHostmgrAdminServer) FindHostsByPCIDevice(context.Context, *FindHostsByPCIDeviceRequest) (*FindHostsByPCIDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindHostsByPCIDevice not implemented")
+}
+func (UnimplementedHostmgrAdminServer) GetHostState(context.Context, *GetHostStateRequest) (*HostState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHostState not implemented")
+}
+func (UnimplementedHostmgrAdminServer) ReleaseHost(context.Context, *ReleaseHostRequest) (*ReleaseHostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseHost not implemented")
+}
+
+// UnsafeHostmgrAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HostmgrAdminServer will
+// result in compilation errors.
+type UnsafeHostmgrAdminServer interface {
+	mustEmbedUnimplementedHostmgrAdminServer()
+}
+
+func RegisterHostmgrAdminServer(s grpc.ServiceRegistrar, srv HostmgrAdminServer) {
+	s.RegisterService(&HostmgrAdmin_ServiceDesc, srv)
+}
+
+func _HostmgrAdmin_ListTrackedHosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrackedHostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostmgrAdminServer).ListTrackedHosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hostmgr_southbound_proto.HostmgrAdmin/ListTrackedHosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostmgrAdminServer).ListTrackedHosts(ctx, req.(*ListTrackedHostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HostmgrAdmin_ExpireHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireHostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostmgrAdminServer).ExpireHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hostmgr_southbound_proto.HostmgrAdmin/ExpireHost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostmgrAdminServer).ExpireHost(ctx, req.(*ExpireHostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HostmgrAdmin_ForgetHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForgetHostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostmgrAdminServer).ForgetHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hostmgr_southbound_proto.HostmgrAdmin/ForgetHost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostmgrAdminServer).ForgetHost(ctx, req.(*ForgetHostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HostmgrAdmin_GetTimeoutSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTimeoutSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostmgrAdminServer).GetTimeoutSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hostmgr_southbound_proto.HostmgrAdmin/GetTimeoutSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostmgrAdminServer).GetTimeoutSettings(ctx, req.(*GetTimeoutSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HostmgrAdmin_UpdateTimeoutSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeoutSettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostmgrAdminServer).UpdateTimeoutSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hostmgr_southbound_proto.HostmgrAdmin/UpdateTimeoutSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostmgrAdminServer).UpdateTimeoutSettings(ctx, req.(*TimeoutSettings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HostmgrAdmin_FindHostsByPCIDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindHostsByPCIDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostmgrAdminServer).FindHostsByPCIDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hostmgr_southbound_proto.HostmgrAdmin/FindHostsByPCIDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostmgrAdminServer).FindHostsByPCIDevice(ctx, req.(*FindHostsByPCIDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HostmgrAdmin_GetHostState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHostStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostmgrAdminServer).GetHostState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hostmgr_southbound_proto.HostmgrAdmin/GetHostState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostmgrAdminServer).GetHostState(ctx, req.(*GetHostStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HostmgrAdmin_ReleaseHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseHostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostmgrAdminServer).ReleaseHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hostmgr_southbound_proto.HostmgrAdmin/ReleaseHost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostmgrAdminServer).ReleaseHost(ctx, req.(*ReleaseHostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HostmgrAdmin_ServiceDesc is the grpc.ServiceDesc for HostmgrAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HostmgrAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hostmgr_southbound_proto.HostmgrAdmin",
+	HandlerType: (*HostmgrAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTrackedHosts",
+			Handler:    _HostmgrAdmin_ListTrackedHosts_Handler,
+		},
+		{
+			MethodName: "ExpireHost",
+			Handler:    _HostmgrAdmin_ExpireHost_Handler,
+		},
+		{
+			MethodName: "ForgetHost",
+			Handler:    _HostmgrAdmin_ForgetHost_Handler,
+		},
+		{
+			MethodName: "GetTimeoutSettings",
+			Handler:    _HostmgrAdmin_GetTimeoutSettings_Handler,
+		},
+		{
+			MethodName: "UpdateTimeoutSettings",
+			Handler:    _HostmgrAdmin_UpdateTimeoutSettings_Handler,
+		},
+		{
+			MethodName: "FindHostsByPCIDevice",
+			Handler:    _HostmgrAdmin_FindHostsByPCIDevice_Handler,
+		},
+		{
+			MethodName: "GetHostState",
+			Handler:    _HostmgrAdmin_GetHostState_Handler,
+		},
+		{
+			MethodName: "ReleaseHost",
+			Handler:    _HostmgrAdmin_ReleaseHost_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hostmgr/proto/hostmgr_admin.proto",
+}
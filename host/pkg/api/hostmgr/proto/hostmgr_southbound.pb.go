@@ -286,11 +286,12 @@ func (HostStatus_HostStatus) EnumDescriptor() ([]byte, []int) {
 type HostStatusResp_HostAction int32
 
 const (
-	HostStatusResp_NONE     HostStatusResp_HostAction = 0
-	HostStatusResp_SHUTDOWN HostStatusResp_HostAction = 1
-	HostStatusResp_RESTART  HostStatusResp_HostAction = 2
-	HostStatusResp_UPDATING HostStatusResp_HostAction = 3
-	HostStatusResp_RUNNING  HostStatusResp_HostAction = 4
+	HostStatusResp_NONE       HostStatusResp_HostAction = 0
+	HostStatusResp_SHUTDOWN   HostStatusResp_HostAction = 1
+	HostStatusResp_RESTART    HostStatusResp_HostAction = 2
+	HostStatusResp_UPDATING   HostStatusResp_HostAction = 3
+	HostStatusResp_RUNNING    HostStatusResp_HostAction = 4
+	HostStatusResp_INVALIDATE HostStatusResp_HostAction = 5
 )
 
 // Enum value maps for HostStatusResp_HostAction.
@@ -301,13 +302,15 @@ var (
 		2: "RESTART",
 		3: "UPDATING",
 		4: "RUNNING",
+		5: "INVALIDATE",
 	}
 	HostStatusResp_HostAction_value = map[string]int32{
-		"NONE":     0,
-		"SHUTDOWN": 1,
-		"RESTART":  2,
-		"UPDATING": 3,
-		"RUNNING":  4,
+		"NONE":       0,
+		"SHUTDOWN":   1,
+		"RESTART":    2,
+		"UPDATING":   3,
+		"RUNNING":    4,
+		"INVALIDATE": 5,
 	}
 )
 
@@ -396,7 +399,7 @@ func (x BmInfo_BmType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BmInfo_BmType.Descriptor instead.
 func (BmInfo_BmType) EnumDescriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{24, 0}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{26, 0}
 }
 
 type HostStatus struct {
@@ -407,6 +410,12 @@ type HostStatus struct {
 	HostStatus          HostStatus_HostStatus `protobuf:"varint,1,opt,name=host_status,json=hostStatus,proto3,enum=hostmgr_southbound_proto.HostStatus_HostStatus" json:"host_status,omitempty"`
 	Details             string                `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
 	HumanReadableStatus string                `protobuf:"bytes,3,opt,name=human_readable_status,json=humanReadableStatus,proto3" json:"human_readable_status,omitempty"`
+	// Machine-readable code of the error reported by the agent, e.g. "DISK_FULL". Empty if there is no error.
+	ErrorCode string `protobuf:"bytes,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// Kernel boot ID of the host (/proc/sys/kernel/random/boot_id), changing at each boot. Empty if not provided.
+	BootId string `protobuf:"bytes,5,opt,name=boot_id,json=bootId,proto3" json:"boot_id,omitempty"`
+	// Time elapsed since the host booted, in seconds. 0 if not provided.
+	UptimeSeconds uint64 `protobuf:"varint,6,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
 }
 
 func (x *HostStatus) Reset() {
@@ -462,6 +471,27 @@ func (x *HostStatus) GetHumanReadableStatus() string {
 	return ""
 }
 
+func (x *HostStatus) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *HostStatus) GetBootId() string {
+	if x != nil {
+		return x.BootId
+	}
+	return ""
+}
+
+func (x *HostStatus) GetUptimeSeconds() uint64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
 type HostStatusResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -582,6 +612,7 @@ type SystemInfo struct {
 	BmCtlInfo *BmInfo      `protobuf:"bytes,3,opt,name=bm_ctl_info,json=bmCtlInfo,proto3" json:"bm_ctl_info,omitempty"`
 	BiosInfo  *BiosInfo    `protobuf:"bytes,4,opt,name=bios_info,json=biosInfo,proto3" json:"bios_info,omitempty"`
 	KcInfo    *ClusterInfo `protobuf:"bytes,5,opt,name=kc_info,json=kcInfo,proto3" json:"kc_info,omitempty"`
+	AgentInfo *AgentInfo   `protobuf:"bytes,6,opt,name=agent_info,json=agentInfo,proto3" json:"agent_info,omitempty"` // the agents running on the node, absent with agents not reporting it
 }
 
 func (x *SystemInfo) Reset() {
@@ -651,6 +682,126 @@ func (x *SystemInfo) GetKcInfo() *ClusterInfo {
 	return nil
 }
 
+func (x *SystemInfo) GetAgentInfo() *AgentInfo {
+	if x != nil {
+		return x.AgentInfo
+	}
+	return nil
+}
+
+type AgentInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Agents []*Agent `protobuf:"bytes,1,rep,name=agents,proto3" json:"agents,omitempty"` // a list of the agents running on the node
+}
+
+func (x *AgentInfo) Reset() {
+	*x = AgentInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentInfo) ProtoMessage() {}
+
+func (x *AgentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentInfo.ProtoReflect.Descriptor instead.
+func (*AgentInfo) Descriptor() ([]byte, []int) {
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AgentInfo) GetAgents() []*Agent {
+	if x != nil {
+		return x.Agents
+	}
+	return nil
+}
+
+type Agent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name of the agent (e.g., node-agent or platform-update-agent)
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// semantic version of the agent (e.g., 1.2.3 or 1.3.0-dev)
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// a list of the feature flags supported by the agent (e.g., gpu-info or status-sequence)
+	Features []string `protobuf:"bytes,3,rep,name=features,proto3" json:"features,omitempty"`
+}
+
+func (x *Agent) Reset() {
+	*x = Agent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Agent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Agent) ProtoMessage() {}
+
+func (x *Agent) ProtoReflect() protoreflect.Message {
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Agent.ProtoReflect.Descriptor instead.
+func (*Agent) Descriptor() ([]byte, []int) {
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Agent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Agent) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Agent) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
 type ClusterInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -662,7 +813,7 @@ type ClusterInfo struct {
 func (x *ClusterInfo) Reset() {
 	*x = ClusterInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[4]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -675,7 +826,7 @@ func (x *ClusterInfo) String() string {
 func (*ClusterInfo) ProtoMessage() {}
 
 func (x *ClusterInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[4]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -688,7 +839,7 @@ func (x *ClusterInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterInfo.ProtoReflect.Descriptor instead.
 func (*ClusterInfo) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{4}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ClusterInfo) GetKubeconfig() string {
@@ -711,7 +862,7 @@ type BiosInfo struct {
 func (x *BiosInfo) Reset() {
 	*x = BiosInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[5]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -724,7 +875,7 @@ func (x *BiosInfo) String() string {
 func (*BiosInfo) ProtoMessage() {}
 
 func (x *BiosInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[5]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -737,7 +888,7 @@ func (x *BiosInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BiosInfo.ProtoReflect.Descriptor instead.
 func (*BiosInfo) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{5}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *BiosInfo) GetVersion() string {
@@ -773,7 +924,7 @@ type OsInfo struct {
 func (x *OsInfo) Reset() {
 	*x = OsInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[6]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -786,7 +937,7 @@ func (x *OsInfo) String() string {
 func (*OsInfo) ProtoMessage() {}
 
 func (x *OsInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[6]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -799,7 +950,7 @@ func (x *OsInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OsInfo.ProtoReflect.Descriptor instead.
 func (*OsInfo) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{6}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *OsInfo) GetKernel() *OsKernel {
@@ -828,7 +979,7 @@ type Config struct {
 func (x *Config) Reset() {
 	*x = Config{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[7]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -841,7 +992,7 @@ func (x *Config) String() string {
 func (*Config) ProtoMessage() {}
 
 func (x *Config) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[7]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -854,7 +1005,7 @@ func (x *Config) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Config.ProtoReflect.Descriptor instead.
 func (*Config) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{7}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Config) GetKey() string {
@@ -883,7 +1034,7 @@ type OsKernel struct {
 func (x *OsKernel) Reset() {
 	*x = OsKernel{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[8]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -896,7 +1047,7 @@ func (x *OsKernel) String() string {
 func (*OsKernel) ProtoMessage() {}
 
 func (x *OsKernel) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[8]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -909,7 +1060,7 @@ func (x *OsKernel) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OsKernel.ProtoReflect.Descriptor instead.
 func (*OsKernel) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{8}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *OsKernel) GetVersion() string {
@@ -939,7 +1090,7 @@ type OsRelease struct {
 func (x *OsRelease) Reset() {
 	*x = OsRelease{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[9]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -952,7 +1103,7 @@ func (x *OsRelease) String() string {
 func (*OsRelease) ProtoMessage() {}
 
 func (x *OsRelease) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[9]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -965,7 +1116,7 @@ func (x *OsRelease) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OsRelease.ProtoReflect.Descriptor instead.
 func (*OsRelease) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{9}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *OsRelease) GetId() string {
@@ -1001,7 +1152,7 @@ type Storage struct {
 func (x *Storage) Reset() {
 	*x = Storage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[10]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1014,7 +1165,7 @@ func (x *Storage) String() string {
 func (*Storage) ProtoMessage() {}
 
 func (x *Storage) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[10]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1027,7 +1178,7 @@ func (x *Storage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Storage.ProtoReflect.Descriptor instead.
 func (*Storage) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{10}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Storage) GetDisk() []*SystemDisk {
@@ -1060,12 +1211,14 @@ type HWInfo struct {
 	Pci           []*SystemPCI     `protobuf:"bytes,7,rep,name=pci,proto3" json:"pci,omitempty"`
 	Usb           []*SystemUSB     `protobuf:"bytes,8,rep,name=usb,proto3" json:"usb,omitempty"`
 	Gpu           []*SystemGPU     `protobuf:"bytes,10,rep,name=gpu,proto3" json:"gpu,omitempty"`
+	// SHA-256 of the TPM endorsement key of the host, hex encoded, if the host has a TPM
+	TpmEkHash string `protobuf:"bytes,11,opt,name=tpm_ek_hash,json=tpmEkHash,proto3" json:"tpm_ek_hash,omitempty"`
 }
 
 func (x *HWInfo) Reset() {
 	*x = HWInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[11]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1078,7 +1231,7 @@ func (x *HWInfo) String() string {
 func (*HWInfo) ProtoMessage() {}
 
 func (x *HWInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[11]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1091,7 +1244,7 @@ func (x *HWInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HWInfo.ProtoReflect.Descriptor instead.
 func (*HWInfo) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{11}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *HWInfo) GetSerialNum() string {
@@ -1165,6 +1318,13 @@ func (x *HWInfo) GetGpu() []*SystemGPU {
 	return nil
 }
 
+func (x *HWInfo) GetTpmEkHash() string {
+	if x != nil {
+		return x.TpmEkHash
+	}
+	return ""
+}
+
 type SystemCPU struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1183,7 +1343,7 @@ type SystemCPU struct {
 func (x *SystemCPU) Reset() {
 	*x = SystemCPU{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[12]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1196,7 +1356,7 @@ func (x *SystemCPU) String() string {
 func (*SystemCPU) ProtoMessage() {}
 
 func (x *SystemCPU) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[12]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1209,7 +1369,7 @@ func (x *SystemCPU) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemCPU.ProtoReflect.Descriptor instead.
 func (*SystemCPU) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{12}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *SystemCPU) GetArch() string {
@@ -1279,7 +1439,7 @@ type SystemMemory struct {
 func (x *SystemMemory) Reset() {
 	*x = SystemMemory{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[13]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1292,7 +1452,7 @@ func (x *SystemMemory) String() string {
 func (*SystemMemory) ProtoMessage() {}
 
 func (x *SystemMemory) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[13]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1305,7 +1465,7 @@ func (x *SystemMemory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemMemory.ProtoReflect.Descriptor instead.
 func (*SystemMemory) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{13}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SystemMemory) GetSize() uint64 {
@@ -1331,7 +1491,7 @@ type SystemDisk struct {
 func (x *SystemDisk) Reset() {
 	*x = SystemDisk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[14]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1344,7 +1504,7 @@ func (x *SystemDisk) String() string {
 func (*SystemDisk) ProtoMessage() {}
 
 func (x *SystemDisk) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[14]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1357,7 +1517,7 @@ func (x *SystemDisk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemDisk.ProtoReflect.Descriptor instead.
 func (*SystemDisk) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{14}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SystemDisk) GetSerialNumber() string {
@@ -1418,7 +1578,7 @@ type SystemGPU struct {
 func (x *SystemGPU) Reset() {
 	*x = SystemGPU{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[15]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1431,7 +1591,7 @@ func (x *SystemGPU) String() string {
 func (*SystemGPU) ProtoMessage() {}
 
 func (x *SystemGPU) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[15]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1444,7 +1604,7 @@ func (x *SystemGPU) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemGPU.ProtoReflect.Descriptor instead.
 func (*SystemGPU) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{15}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SystemGPU) GetPciId() string {
@@ -1519,7 +1679,7 @@ type SystemNetwork struct {
 func (x *SystemNetwork) Reset() {
 	*x = SystemNetwork{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[16]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1532,7 +1692,7 @@ func (x *SystemNetwork) String() string {
 func (*SystemNetwork) ProtoMessage() {}
 
 func (x *SystemNetwork) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[16]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1545,7 +1705,7 @@ func (x *SystemNetwork) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemNetwork.ProtoReflect.Descriptor instead.
 func (*SystemNetwork) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{16}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SystemNetwork) GetName() string {
@@ -1699,7 +1859,7 @@ type CPUTopology struct {
 func (x *CPUTopology) Reset() {
 	*x = CPUTopology{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[17]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1712,7 +1872,7 @@ func (x *CPUTopology) String() string {
 func (*CPUTopology) ProtoMessage() {}
 
 func (x *CPUTopology) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[17]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1725,7 +1885,7 @@ func (x *CPUTopology) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CPUTopology.ProtoReflect.Descriptor instead.
 func (*CPUTopology) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{17}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CPUTopology) GetSockets() []*Socket {
@@ -1747,7 +1907,7 @@ type Socket struct {
 func (x *Socket) Reset() {
 	*x = Socket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[18]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1760,7 +1920,7 @@ func (x *Socket) String() string {
 func (*Socket) ProtoMessage() {}
 
 func (x *Socket) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[18]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1773,7 +1933,7 @@ func (x *Socket) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Socket.ProtoReflect.Descriptor instead.
 func (*Socket) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{18}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Socket) GetSocketId() uint32 {
@@ -1802,7 +1962,7 @@ type CoreGroup struct {
 func (x *CoreGroup) Reset() {
 	*x = CoreGroup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[19]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1815,7 +1975,7 @@ func (x *CoreGroup) String() string {
 func (*CoreGroup) ProtoMessage() {}
 
 func (x *CoreGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[19]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1828,7 +1988,7 @@ func (x *CoreGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CoreGroup.ProtoReflect.Descriptor instead.
 func (*CoreGroup) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{19}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *CoreGroup) GetCoreType() string {
@@ -1858,7 +2018,7 @@ type IPAddress struct {
 func (x *IPAddress) Reset() {
 	*x = IPAddress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[20]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1871,7 +2031,7 @@ func (x *IPAddress) String() string {
 func (*IPAddress) ProtoMessage() {}
 
 func (x *IPAddress) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[20]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1884,7 +2044,7 @@ func (x *IPAddress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IPAddress.ProtoReflect.Descriptor instead.
 func (*IPAddress) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{20}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *IPAddress) GetIpAddress() string {
@@ -1914,12 +2074,17 @@ type SystemPCI struct {
 	unknownFields protoimpl.UnknownFields
 
 	DevClass string `protobuf:"bytes,1,opt,name=dev_class,json=devClass,proto3" json:"dev_class,omitempty"`
+	VendorId string `protobuf:"bytes,2,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`  // PCI vendor ID, such as "8086"
+	DeviceId string `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`  // PCI device ID, such as "56a0"
+	Slot     string `protobuf:"bytes,4,opt,name=slot,proto3" json:"slot,omitempty"`                          // PCI address of the device, such as "0000:03:00.0"
+	Driver   string `protobuf:"bytes,5,opt,name=driver,proto3" json:"driver,omitempty"`                      // kernel driver bound to the device, if any
+	NumaNode int32  `protobuf:"varint,6,opt,name=numa_node,json=numaNode,proto3" json:"numa_node,omitempty"` // NUMA node of the device, -1 if the platform has none
 }
 
 func (x *SystemPCI) Reset() {
 	*x = SystemPCI{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[21]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1932,7 +2097,7 @@ func (x *SystemPCI) String() string {
 func (*SystemPCI) ProtoMessage() {}
 
 func (x *SystemPCI) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[21]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1945,7 +2110,7 @@ func (x *SystemPCI) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemPCI.ProtoReflect.Descriptor instead.
 func (*SystemPCI) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{21}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *SystemPCI) GetDevClass() string {
@@ -1955,6 +2120,41 @@ func (x *SystemPCI) GetDevClass() string {
 	return ""
 }
 
+func (x *SystemPCI) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *SystemPCI) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *SystemPCI) GetSlot() string {
+	if x != nil {
+		return x.Slot
+	}
+	return ""
+}
+
+func (x *SystemPCI) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *SystemPCI) GetNumaNode() int32 {
+	if x != nil {
+		return x.NumaNode
+	}
+	return 0
+}
+
 type Interfaces struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1966,7 +2166,7 @@ type Interfaces struct {
 func (x *Interfaces) Reset() {
 	*x = Interfaces{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[22]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1979,7 +2179,7 @@ func (x *Interfaces) String() string {
 func (*Interfaces) ProtoMessage() {}
 
 func (x *Interfaces) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[22]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1992,7 +2192,7 @@ func (x *Interfaces) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Interfaces.ProtoReflect.Descriptor instead.
 func (*Interfaces) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{22}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *Interfaces) GetClass() string {
@@ -2020,7 +2220,7 @@ type SystemUSB struct {
 func (x *SystemUSB) Reset() {
 	*x = SystemUSB{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[23]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2033,7 +2233,7 @@ func (x *SystemUSB) String() string {
 func (*SystemUSB) ProtoMessage() {}
 
 func (x *SystemUSB) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[23]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2046,7 +2246,7 @@ func (x *SystemUSB) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemUSB.ProtoReflect.Descriptor instead.
 func (*SystemUSB) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{23}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *SystemUSB) GetClass() string {
@@ -2117,7 +2317,7 @@ type BmInfo struct {
 func (x *BmInfo) Reset() {
 	*x = BmInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[24]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2130,7 +2330,7 @@ func (x *BmInfo) String() string {
 func (*BmInfo) ProtoMessage() {}
 
 func (x *BmInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[24]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2143,7 +2343,7 @@ func (x *BmInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BmInfo.ProtoReflect.Descriptor instead.
 func (*BmInfo) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{24}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *BmInfo) GetBmType() BmInfo_BmType {
@@ -2173,7 +2373,7 @@ type BmcInfo struct {
 func (x *BmcInfo) Reset() {
 	*x = BmcInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[25]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2186,7 +2386,7 @@ func (x *BmcInfo) String() string {
 func (*BmcInfo) ProtoMessage() {}
 
 func (x *BmcInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[25]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2199,7 +2399,7 @@ func (x *BmcInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BmcInfo.ProtoReflect.Descriptor instead.
 func (*BmcInfo) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{25}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *BmcInfo) GetBmIp() string {
@@ -2231,12 +2431,20 @@ type UpdateHostStatusByHostGuidRequest struct {
 
 	HostGuid   string      `protobuf:"bytes,1,opt,name=host_guid,json=hostGuid,proto3" json:"host_guid,omitempty"`
 	HostStatus *HostStatus `protobuf:"bytes,2,opt,name=host_status,json=hostStatus,proto3" json:"host_status,omitempty"`
+	// Sequence number of the update, increasing with each update sent by the agent, across its restarts.
+	// 0 if not provided. The sequence is shared with UpdateInstanceStateStatusByHostGUID, which updates the Host status too.
+	SequenceNumber uint64 `protobuf:"varint,3,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	// UTC Unix time in nanoseconds when the agent produced the update. 0 if not provided.
+	// The updates are ordered by sequence number, the agent timestamps are only compared when the sequence numbers
+	// are equal or not provided. Stale or replayed updates are ignored.
+	// It is the authoritative time of the agent, the clock skew of the host is measured on it too.
+	AgentTimestampNs uint64 `protobuf:"varint,4,opt,name=agent_timestamp_ns,json=agentTimestampNs,proto3" json:"agent_timestamp_ns,omitempty"`
 }
 
 func (x *UpdateHostStatusByHostGuidRequest) Reset() {
 	*x = UpdateHostStatusByHostGuidRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[26]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2249,7 +2457,7 @@ func (x *UpdateHostStatusByHostGuidRequest) String() string {
 func (*UpdateHostStatusByHostGuidRequest) ProtoMessage() {}
 
 func (x *UpdateHostStatusByHostGuidRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[26]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2262,7 +2470,7 @@ func (x *UpdateHostStatusByHostGuidRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateHostStatusByHostGuidRequest.ProtoReflect.Descriptor instead.
 func (*UpdateHostStatusByHostGuidRequest) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{26}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *UpdateHostStatusByHostGuidRequest) GetHostGuid() string {
@@ -2279,6 +2487,97 @@ func (x *UpdateHostStatusByHostGuidRequest) GetHostStatus() *HostStatus {
 	return nil
 }
 
+func (x *UpdateHostStatusByHostGuidRequest) GetSequenceNumber() uint64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *UpdateHostStatusByHostGuidRequest) GetAgentTimestampNs() uint64 {
+	if x != nil {
+		return x.AgentTimestampNs
+	}
+	return 0
+}
+
+// HostSessionRequest is a single message sent by the agent on the HostSession stream.
+// A message without host_status is a plain heartbeat.
+type HostSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HostGuid   string      `protobuf:"bytes,1,opt,name=host_guid,json=hostGuid,proto3" json:"host_guid,omitempty"`
+	HostStatus *HostStatus `protobuf:"bytes,2,opt,name=host_status,json=hostStatus,proto3" json:"host_status,omitempty"`
+	// UTC Unix time in nanoseconds when the agent sent the message. 0 if not provided.
+	// The statuses are ordered as the ones of UpdateHostStatusByHostGuid, the clock skew of the host is measured on it.
+	AgentTimestampNs uint64 `protobuf:"varint,3,opt,name=agent_timestamp_ns,json=agentTimestampNs,proto3" json:"agent_timestamp_ns,omitempty"`
+	// Sequence number of the status, in the sequence of UpdateHostStatusByHostGuid. 0 if not provided.
+	// Stale or replayed statuses are ignored, whichever way the agent sends them.
+	SequenceNumber uint64 `protobuf:"varint,4,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+}
+
+func (x *HostSessionRequest) Reset() {
+	*x = HostSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostSessionRequest) ProtoMessage() {}
+
+func (x *HostSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostSessionRequest.ProtoReflect.Descriptor instead.
+func (*HostSessionRequest) Descriptor() ([]byte, []int) {
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HostSessionRequest) GetHostGuid() string {
+	if x != nil {
+		return x.HostGuid
+	}
+	return ""
+}
+
+func (x *HostSessionRequest) GetHostStatus() *HostStatus {
+	if x != nil {
+		return x.HostStatus
+	}
+	return nil
+}
+
+func (x *HostSessionRequest) GetAgentTimestampNs() uint64 {
+	if x != nil {
+		return x.AgentTimestampNs
+	}
+	return 0
+}
+
+func (x *HostSessionRequest) GetSequenceNumber() uint64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
 type UpdateHostSystemInfoByGUIDRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2291,7 +2590,7 @@ type UpdateHostSystemInfoByGUIDRequest struct {
 func (x *UpdateHostSystemInfoByGUIDRequest) Reset() {
 	*x = UpdateHostSystemInfoByGUIDRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[27]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2304,7 +2603,7 @@ func (x *UpdateHostSystemInfoByGUIDRequest) String() string {
 func (*UpdateHostSystemInfoByGUIDRequest) ProtoMessage() {}
 
 func (x *UpdateHostSystemInfoByGUIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[27]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2317,7 +2616,7 @@ func (x *UpdateHostSystemInfoByGUIDRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateHostSystemInfoByGUIDRequest.ProtoReflect.Descriptor instead.
 func (*UpdateHostSystemInfoByGUIDRequest) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{27}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *UpdateHostSystemInfoByGUIDRequest) GetHostGuid() string {
@@ -2343,7 +2642,7 @@ type UpdateHostSystemInfoByGUIDResponse struct {
 func (x *UpdateHostSystemInfoByGUIDResponse) Reset() {
 	*x = UpdateHostSystemInfoByGUIDResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[28]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2356,7 +2655,7 @@ func (x *UpdateHostSystemInfoByGUIDResponse) String() string {
 func (*UpdateHostSystemInfoByGUIDResponse) ProtoMessage() {}
 
 func (x *UpdateHostSystemInfoByGUIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[28]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2369,7 +2668,7 @@ func (x *UpdateHostSystemInfoByGUIDResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateHostSystemInfoByGUIDResponse.ProtoReflect.Descriptor instead.
 func (*UpdateHostSystemInfoByGUIDResponse) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{28}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{31}
 }
 
 type UpdateInstanceStateStatusByHostGUIDRequest struct {
@@ -2381,12 +2680,15 @@ type UpdateInstanceStateStatusByHostGUIDRequest struct {
 	InstanceStatus       InstanceStatus `protobuf:"varint,2,opt,name=instance_status,json=instanceStatus,proto3,enum=hostmgr_southbound_proto.InstanceStatus" json:"instance_status,omitempty"` // Instance's Status
 	InstanceState        InstanceState  `protobuf:"varint,3,opt,name=instance_state,json=instanceState,proto3,enum=hostmgr_southbound_proto.InstanceState" json:"instance_state,omitempty"`     // Instance's last State as seen by the PS/ENA
 	ProviderStatusDetail string         `protobuf:"bytes,4,opt,name=provider_status_detail,json=providerStatusDetail,proto3" json:"provider_status_detail,omitempty"`                           // Details of the current status of the Instance
+	SequenceNumber       uint64         `protobuf:"varint,5,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`                                              // Sequence number of the update, increasing with each update sent by the agent
+	// UTC Unix time in nanoseconds when the agent produced the update, the clock skew of the host is measured on it
+	AgentTimestampNs uint64 `protobuf:"varint,6,opt,name=agent_timestamp_ns,json=agentTimestampNs,proto3" json:"agent_timestamp_ns,omitempty"`
 }
 
 func (x *UpdateInstanceStateStatusByHostGUIDRequest) Reset() {
 	*x = UpdateInstanceStateStatusByHostGUIDRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[29]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2399,7 +2701,7 @@ func (x *UpdateInstanceStateStatusByHostGUIDRequest) String() string {
 func (*UpdateInstanceStateStatusByHostGUIDRequest) ProtoMessage() {}
 
 func (x *UpdateInstanceStateStatusByHostGUIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[29]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2412,7 +2714,7 @@ func (x *UpdateInstanceStateStatusByHostGUIDRequest) ProtoReflect() protoreflect
 
 // Deprecated: Use UpdateInstanceStateStatusByHostGUIDRequest.ProtoReflect.Descriptor instead.
 func (*UpdateInstanceStateStatusByHostGUIDRequest) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{29}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *UpdateInstanceStateStatusByHostGUIDRequest) GetHostGuid() string {
@@ -2443,6 +2745,20 @@ func (x *UpdateInstanceStateStatusByHostGUIDRequest) GetProviderStatusDetail() s
 	return ""
 }
 
+func (x *UpdateInstanceStateStatusByHostGUIDRequest) GetSequenceNumber() uint64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *UpdateInstanceStateStatusByHostGUIDRequest) GetAgentTimestampNs() uint64 {
+	if x != nil {
+		return x.AgentTimestampNs
+	}
+	return 0
+}
+
 type UpdateInstanceStateStatusByHostGUIDResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2452,7 +2768,7 @@ type UpdateInstanceStateStatusByHostGUIDResponse struct {
 func (x *UpdateInstanceStateStatusByHostGUIDResponse) Reset() {
 	*x = UpdateInstanceStateStatusByHostGUIDResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[30]
+		mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2465,7 +2781,7 @@ func (x *UpdateInstanceStateStatusByHostGUIDResponse) String() string {
 func (*UpdateInstanceStateStatusByHostGUIDResponse) ProtoMessage() {}
 
 func (x *UpdateInstanceStateStatusByHostGUIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[30]
+	mi := &file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2478,7 +2794,7 @@ func (x *UpdateInstanceStateStatusByHostGUIDResponse) ProtoReflect() protoreflec
 
 // Deprecated: Use UpdateInstanceStateStatusByHostGUIDResponse.ProtoReflect.Descriptor instead.
 func (*UpdateInstanceStateStatusByHostGUIDResponse) Descriptor() ([]byte, []int) {
-	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{30}
+	return file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP(), []int{33}
 }
 
 var File_hostmgr_proto_hostmgr_southbound_proto protoreflect.FileDescriptor
@@ -2489,7 +2805,7 @@ var file_hostmgr_proto_hostmgr_southbound_proto_rawDesc = []byte{
 	0x6e, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67,
 	0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xec, 0x02, 0x0a, 0x0a,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x99, 0x04, 0x0a, 0x0a,
 	0x48, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x51, 0x0a, 0x0b, 0x68, 0x6f,
 	0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
 	0x30, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62,
@@ -2500,441 +2816,523 @@ var file_hostmgr_proto_hostmgr_southbound_proto_rawDesc = []byte{
 	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x68, 0x75, 0x6d, 0x61, 0x6e,
 	0x5f, 0x72, 0x65, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x68, 0x75, 0x6d, 0x61, 0x6e, 0x52, 0x65, 0x61,
-	0x64, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x0b,
-	0x48, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0f, 0x0a, 0x0b, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b,
-	0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a,
-	0x07, 0x42, 0x4f, 0x4f, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x4f,
-	0x4f, 0x54, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52,
-	0x4f, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b,
-	0x50, 0x52, 0x4f, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x05, 0x12, 0x13, 0x0a,
-	0x0f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
-	0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x07, 0x12,
-	0x0c, 0x0a, 0x08, 0x55, 0x50, 0x44, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x08, 0x12, 0x10, 0x0a,
-	0x0c, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x09, 0x12,
-	0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x0a, 0x22, 0xd0, 0x01, 0x0a, 0x0e, 0x48,
-	0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x55, 0x0a,
-	0x0b, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x34, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75,
-	0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x6f,
-	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x48, 0x6f, 0x73,
-	0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x4d,
-	0x0a, 0x0b, 0x48, 0x6f, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a,
-	0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x48, 0x55, 0x54, 0x44,
-	0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52, 0x54,
-	0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x50, 0x44, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x03,
-	0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x22, 0x32, 0x0a,
-	0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0xc5, 0x02, 0x0a, 0x0a, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x39, 0x0a, 0x07, 0x68, 0x77, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74,
-	0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x57, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x06, 0x68, 0x77, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x39, 0x0a, 0x07, 0x6f,
-	0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68,
-	0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06,
-	0x6f, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x40, 0x0a, 0x0b, 0x62, 0x6d, 0x5f, 0x63, 0x74, 0x6c,
-	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x6f,
-	0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x62,
-	0x6d, 0x43, 0x74, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3f, 0x0a, 0x09, 0x62, 0x69, 0x6f, 0x73,
-	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x6f,
-	0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x69, 0x6f, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x08, 0x62, 0x69, 0x6f, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3e, 0x0a, 0x07, 0x6b, 0x63, 0x5f,
-	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x68, 0x6f, 0x73,
-	0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x06, 0x6b, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x2d, 0x0a, 0x0b, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x6b, 0x75, 0x62, 0x65,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x75,
-	0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xc3, 0x02, 0x0a, 0x08, 0x42, 0x69, 0x6f,
-	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x25, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06, 0x18, 0x80, 0x01,
-	0xd0, 0x01, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0xeb, 0x01, 0x0a,
-	0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0xc7, 0x01, 0xfa, 0x42, 0xc3, 0x01, 0x72, 0xc0, 0x01, 0x18, 0x80, 0x01,
-	0x32, 0xb7, 0x01, 0x5e, 0x28, 0x28, 0x5c, 0x64, 0x7b, 0x34, 0x7d, 0x29, 0x2f, 0x28, 0x30, 0x5b,
-	0x31, 0x2d, 0x39, 0x5d, 0x7c, 0x31, 0x5b, 0x30, 0x2d, 0x32, 0x5d, 0x29, 0x2f, 0x28, 0x30, 0x5b,
-	0x31, 0x2d, 0x39, 0x5d, 0x7c, 0x5b, 0x31, 0x32, 0x5d, 0x5c, 0x64, 0x7c, 0x33, 0x5b, 0x30, 0x31,
-	0x5d, 0x29, 0x7c, 0x28, 0x30, 0x5b, 0x31, 0x2d, 0x39, 0x5d, 0x7c, 0x31, 0x5b, 0x30, 0x2d, 0x32,
-	0x5d, 0x29, 0x2f, 0x28, 0x30, 0x5b, 0x31, 0x2d, 0x39, 0x5d, 0x7c, 0x5b, 0x31, 0x32, 0x5d, 0x5c,
-	0x64, 0x7c, 0x33, 0x5b, 0x30, 0x31, 0x5d, 0x29, 0x2f, 0x5c, 0x64, 0x7b, 0x34, 0x7d, 0x7c, 0x28,
-	0x5c, 0x64, 0x7b, 0x34, 0x7d, 0x29, 0x2d, 0x28, 0x30, 0x5b, 0x31, 0x2d, 0x39, 0x5d, 0x7c, 0x31,
-	0x5b, 0x30, 0x2d, 0x32, 0x5d, 0x29, 0x2d, 0x28, 0x30, 0x5b, 0x31, 0x2d, 0x39, 0x5d, 0x7c, 0x5b,
-	0x31, 0x32, 0x5d, 0x5c, 0x64, 0x7c, 0x33, 0x5b, 0x30, 0x31, 0x5d, 0x29, 0x7c, 0x28, 0x30, 0x5b,
-	0x31, 0x2d, 0x39, 0x5d, 0x7c, 0x31, 0x5b, 0x30, 0x2d, 0x32, 0x5d, 0x29, 0x2d, 0x28, 0x30, 0x5b,
-	0x31, 0x2d, 0x39, 0x5d, 0x7c, 0x5b, 0x31, 0x32, 0x5d, 0x5c, 0x64, 0x7c, 0x33, 0x5b, 0x30, 0x31,
-	0x5d, 0x29, 0x2d, 0x5c, 0x64, 0x7b, 0x34, 0x7d, 0x29, 0x24, 0xd0, 0x01, 0x01, 0x52, 0x0b, 0x72,
-	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x76, 0x65,
-	0x6e, 0x64, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72,
-	0x05, 0x18, 0x28, 0xd0, 0x01, 0x01, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x22, 0x83,
-	0x01, 0x0a, 0x06, 0x4f, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3a, 0x0a, 0x06, 0x6b, 0x65, 0x72,
-	0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x6f, 0x73, 0x74,
-	0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x73, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x52, 0x06, 0x6b,
-	0x65, 0x72, 0x6e, 0x65, 0x6c, 0x12, 0x3d, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72,
-	0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x4f, 0x73, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x07, 0x72, 0x65, 0x6c,
-	0x65, 0x61, 0x73, 0x65, 0x22, 0x30, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x68, 0x0a, 0x08, 0x4f, 0x73, 0x4b, 0x65, 0x72, 0x6e,
-	0x65, 0x6c, 0x12, 0x22, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72,
-	0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x22, 0x89, 0x01, 0x0a, 0x09, 0x4f, 0x73, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72,
-	0x03, 0x18, 0x80, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03,
-	0x18, 0x80, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x64, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x0a, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1d, 0xfa, 0x42, 0x1a, 0x72, 0x18, 0x18, 0x40, 0x32, 0x11, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61,
+	0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2e, 0x2d, 0x5d, 0x2b, 0x24, 0xd0, 0x01, 0x01, 0x52, 0x09,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x62, 0x6f, 0x6f,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72,
+	0x06, 0xd0, 0x01, 0x01, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x12,
+	0x32, 0x0a, 0x0e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x32, 0x06, 0x18, 0x80,
+	0xbc, 0xe0, 0xdf, 0x0b, 0x52, 0x0d, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x0b, 0x48, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x4f, 0x4f, 0x54, 0x49, 0x4e, 0x47,
+	0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x4f, 0x4f, 0x54, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x49,
+	0x4e, 0x47, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x53, 0x49, 0x4f,
+	0x4e, 0x45, 0x44, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x53, 0x49,
+	0x4f, 0x4e, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55,
+	0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x07, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x50, 0x44, 0x41, 0x54,
+	0x49, 0x4e, 0x47, 0x10, 0x08, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x09, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x10, 0x0a, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x52, 0x0d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6e, 0x73, 0x22, 0xe0, 0x01, 0x0a, 0x0e, 0x48, 0x6f, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x0b, 0x68, 0x6f,
+	0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x34, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x5d, 0x0a, 0x0b, 0x48,
+	0x6f, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f,
+	0x4e, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x48, 0x55, 0x54, 0x44, 0x4f, 0x57, 0x4e,
+	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x02, 0x12,
+	0x0c, 0x0a, 0x08, 0x55, 0x50, 0x44, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x0b, 0x0a,
+	0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x10, 0x05, 0x22, 0x32, 0x0a, 0x08, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x89,
+	0x03, 0x0a, 0x0a, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x39, 0x0a,
+	0x07, 0x68, 0x77, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
 	0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f,
-	0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x5f, 0x0a, 0x07,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f,
-	0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x04, 0x64, 0x69, 0x73,
-	0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0xca, 0x04,
-	0x0a, 0x06, 0x48, 0x57, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42,
-	0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75,
-	0x6d, 0x12, 0x2b, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80,
-	0x01, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35,
-	0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x6f,
-	0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x43, 0x50, 0x55,
-	0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x4e, 0x0a, 0x0e, 0x67, 0x70, 0x75, 0x5f, 0x64, 0x65, 0x70,
-	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x57, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x06, 0x68, 0x77, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x39, 0x0a, 0x07, 0x6f, 0x73, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x6f, 0x73, 0x74,
+	0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x6f, 0x73, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x40, 0x0a, 0x0b, 0x62, 0x6d, 0x5f, 0x63, 0x74, 0x6c, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d,
+	0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x62, 0x6d, 0x43, 0x74,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3f, 0x0a, 0x09, 0x62, 0x69, 0x6f, 0x73, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d,
+	0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x69, 0x6f, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x62, 0x69,
+	0x6f, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3e, 0x0a, 0x07, 0x6b, 0x63, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67,
+	0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06,
+	0x6b, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x42, 0x0a, 0x0a, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x6f, 0x73,
+	0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x09, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x4e, 0x0a, 0x09, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x41, 0x0a, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67,
+	0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02,
+	0x10, 0x20, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x05, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1f, 0xfa, 0x42, 0x1c, 0x72, 0x1a, 0x18, 0x40, 0x32, 0x16, 0x5e, 0x5b, 0x61,
+	0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2e, 0x5f, 0x2d,
+	0x5d, 0x2a, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4c, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0xfa, 0x42, 0x2f, 0x72,
+	0x2d, 0x18, 0x40, 0x32, 0x29, 0x5e, 0x76, 0x3f, 0x5c, 0x64, 0x2b, 0x28, 0x5c, 0x2e, 0x5c, 0x64,
+	0x2b, 0x29, 0x7b, 0x30, 0x2c, 0x32, 0x7d, 0x28, 0x5b, 0x2d, 0x2b, 0x5d, 0x5b, 0x30, 0x2d, 0x39,
+	0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x2e, 0x2b, 0x2d, 0x5d, 0x2a, 0x29, 0x3f, 0x24, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x28, 0xfa, 0x42, 0x25, 0x92, 0x01,
+	0x22, 0x10, 0x40, 0x18, 0x01, 0x22, 0x1c, 0x72, 0x1a, 0x18, 0x40, 0x32, 0x16, 0x5e, 0x5b, 0x61,
+	0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2e, 0x5f, 0x2d,
+	0x5d, 0x2a, 0x24, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x2d, 0x0a,
+	0x0b, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xc3, 0x02, 0x0a,
+	0x08, 0x42, 0x69, 0x6f, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x25, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72,
+	0x06, 0x18, 0x80, 0x01, 0xd0, 0x01, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0xeb, 0x01, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0xc7, 0x01, 0xfa, 0x42, 0xc3, 0x01, 0x72, 0xc0,
+	0x01, 0x18, 0x80, 0x01, 0x32, 0xb7, 0x01, 0x5e, 0x28, 0x28, 0x5c, 0x64, 0x7b, 0x34, 0x7d, 0x29,
+	0x2f, 0x28, 0x30, 0x5b, 0x31, 0x2d, 0x39, 0x5d, 0x7c, 0x31, 0x5b, 0x30, 0x2d, 0x32, 0x5d, 0x29,
+	0x2f, 0x28, 0x30, 0x5b, 0x31, 0x2d, 0x39, 0x5d, 0x7c, 0x5b, 0x31, 0x32, 0x5d, 0x5c, 0x64, 0x7c,
+	0x33, 0x5b, 0x30, 0x31, 0x5d, 0x29, 0x7c, 0x28, 0x30, 0x5b, 0x31, 0x2d, 0x39, 0x5d, 0x7c, 0x31,
+	0x5b, 0x30, 0x2d, 0x32, 0x5d, 0x29, 0x2f, 0x28, 0x30, 0x5b, 0x31, 0x2d, 0x39, 0x5d, 0x7c, 0x5b,
+	0x31, 0x32, 0x5d, 0x5c, 0x64, 0x7c, 0x33, 0x5b, 0x30, 0x31, 0x5d, 0x29, 0x2f, 0x5c, 0x64, 0x7b,
+	0x34, 0x7d, 0x7c, 0x28, 0x5c, 0x64, 0x7b, 0x34, 0x7d, 0x29, 0x2d, 0x28, 0x30, 0x5b, 0x31, 0x2d,
+	0x39, 0x5d, 0x7c, 0x31, 0x5b, 0x30, 0x2d, 0x32, 0x5d, 0x29, 0x2d, 0x28, 0x30, 0x5b, 0x31, 0x2d,
+	0x39, 0x5d, 0x7c, 0x5b, 0x31, 0x32, 0x5d, 0x5c, 0x64, 0x7c, 0x33, 0x5b, 0x30, 0x31, 0x5d, 0x29,
+	0x7c, 0x28, 0x30, 0x5b, 0x31, 0x2d, 0x39, 0x5d, 0x7c, 0x31, 0x5b, 0x30, 0x2d, 0x32, 0x5d, 0x29,
+	0x2d, 0x28, 0x30, 0x5b, 0x31, 0x2d, 0x39, 0x5d, 0x7c, 0x5b, 0x31, 0x32, 0x5d, 0x5c, 0x64, 0x7c,
+	0x33, 0x5b, 0x30, 0x31, 0x5d, 0x29, 0x2d, 0x5c, 0x64, 0x7b, 0x34, 0x7d, 0x29, 0x24, 0xd0, 0x01,
+	0x01, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x22,
+	0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
+	0xfa, 0x42, 0x07, 0x72, 0x05, 0x18, 0x28, 0xd0, 0x01, 0x01, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64,
+	0x6f, 0x72, 0x22, 0x83, 0x01, 0x0a, 0x06, 0x4f, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3a, 0x0a,
+	0x06, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
 	0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75,
-	0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47,
-	0x50, 0x55, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x67, 0x70, 0x75, 0x44, 0x65, 0x70, 0x72, 0x65,
-	0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f,
-	0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x06, 0x6d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72,
-	0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f,
-	0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x35, 0x0a, 0x03, 0x70, 0x63, 0x69, 0x18, 0x07, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75,
-	0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x50, 0x43, 0x49, 0x52, 0x03, 0x70, 0x63, 0x69, 0x12, 0x35, 0x0a, 0x03,
-	0x75, 0x73, 0x62, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x6f, 0x73, 0x74,
+	0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x73, 0x4b, 0x65, 0x72, 0x6e, 0x65,
+	0x6c, 0x52, 0x06, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x12, 0x3d, 0x0a, 0x07, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x6f, 0x73,
+	0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x73, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52,
+	0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x22, 0x30, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x68, 0x0a, 0x08, 0x4f, 0x73,
+	0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x12, 0x22, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80,
+	0x01, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x6f, 0x73,
+	0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x22, 0x89, 0x01, 0x0a, 0x09, 0x4f, 0x73, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x3e, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75,
+	0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x5f, 0x0a, 0x07, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x64,
+	0x69, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x68, 0x6f, 0x73, 0x74,
 	0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x55, 0x53, 0x42, 0x52, 0x03,
-	0x75, 0x73, 0x62, 0x12, 0x35, 0x0a, 0x03, 0x67, 0x70, 0x75, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x23, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68,
-	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x47, 0x50, 0x55, 0x52, 0x03, 0x67, 0x70, 0x75, 0x22, 0xb6, 0x02, 0x0a, 0x09, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x43, 0x50, 0x55, 0x12, 0x1c, 0x0a, 0x04, 0x61, 0x72, 0x63, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01,
-	0x52, 0x04, 0x61, 0x72, 0x63, 0x68, 0x12, 0x20, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01,
-	0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80,
-	0x01, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x21, 0x0a, 0x07, 0x73, 0x6f, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02,
-	0x20, 0x00, 0x52, 0x07, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x05, 0x63,
-	0x6f, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a,
-	0x02, 0x20, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x07, 0x74, 0x68,
-	0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x2a, 0x02, 0x20, 0x00, 0x52, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x1a, 0x0a,
-	0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x0c, 0x63, 0x70, 0x75,
-	0x5f, 0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x25, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62,
-	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x50, 0x55, 0x54, 0x6f,
-	0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x0b, 0x63, 0x70, 0x75, 0x54, 0x6f, 0x70, 0x6f, 0x6c,
-	0x6f, 0x67, 0x79, 0x22, 0x2b, 0x0a, 0x0c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
-	0x22, 0xcf, 0x01, 0x0a, 0x0a, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x69, 0x73, 0x6b, 0x12,
-	0x2d, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01,
-	0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42,
-	0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
-	0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
-	0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72,
-	0x12, 0x1e, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
-	0x73, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x77, 0x77, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x04, 0x77, 0x77,
-	0x69, 0x64, 0x22, 0xe2, 0x01, 0x0a, 0x09, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x50, 0x55,
-	0x12, 0x24, 0x0a, 0x06, 0x70, 0x63, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0d, 0xfa, 0x42, 0x0a, 0x72, 0x08, 0x10, 0x01, 0x18, 0x80, 0x01, 0xd0, 0x01, 0x01, 0x52,
-	0x05, 0x70, 0x63, 0x69, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80,
-	0x01, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x06, 0x76, 0x65,
-	0x6e, 0x64, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72,
-	0x03, 0x18, 0x80, 0x01, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xfa, 0x42, 0x0a, 0x72,
-	0x08, 0x10, 0x01, 0x18, 0x80, 0x01, 0xd0, 0x01, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x2a, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66,
-	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66,
-	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x96, 0x06, 0x0a, 0x0d, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01,
-	0x18, 0x80, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x70, 0x63, 0x69,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03,
-	0x18, 0x80, 0x01, 0x52, 0x05, 0x70, 0x63, 0x69, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x03, 0x6d, 0x61,
-	0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80,
-	0x01, 0x52, 0x03, 0x6d, 0x61, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x6b,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x0e, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x0d, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x75, 0x70, 0x6c, 0x65, 0x78, 0x12, 0x2e, 0x0a, 0x13, 0x73,
-	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6d, 0x6f,
-	0x64, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
-	0x74, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x61,
-	0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f,
-	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x61, 0x64, 0x76, 0x65,
-	0x72, 0x74, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x73,
-	0x72, 0x69, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0c, 0x73, 0x72, 0x69, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
-	0x20, 0x0a, 0x0b, 0x73, 0x72, 0x69, 0x6f, 0x76, 0x6e, 0x75, 0x6d, 0x76, 0x66, 0x73, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x72, 0x69, 0x6f, 0x76, 0x6e, 0x75, 0x6d, 0x76, 0x66,
-	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x72, 0x69, 0x6f, 0x76, 0x5f, 0x76, 0x66, 0x73, 0x5f, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x72, 0x69, 0x6f,
-	0x76, 0x56, 0x66, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x09, 0x70, 0x65, 0x65,
-	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42,
-	0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x33, 0x0a, 0x10, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72,
-	0x03, 0x18, 0x80, 0x01, 0x52, 0x0f, 0x70, 0x65, 0x65, 0x72, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6d, 0x61,
-	0x63, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80,
-	0x01, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x4d, 0x61, 0x63, 0x12, 0x2a, 0x0a, 0x0c, 0x70, 0x65,
-	0x65, 0x72, 0x5f, 0x6d, 0x67, 0x6d, 0x74, 0x5f, 0x69, 0x70, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x65, 0x72,
-	0x4d, 0x67, 0x6d, 0x74, 0x49, 0x70, 0x12, 0x25, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x70,
-	0x6f, 0x72, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03,
-	0x18, 0x80, 0x01, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x46, 0x0a,
-	0x0c, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x11, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f,
-	0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49,
-	0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x12, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6d, 0x63, 0x5f, 0x6e,
-	0x65, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x62, 0x6d, 0x63, 0x4e, 0x65, 0x74,
-	0x22, 0x53, 0x0a, 0x0b, 0x43, 0x50, 0x55, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12,
-	0x44, 0x0a, 0x07, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x20, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68,
-	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x6f, 0x63, 0x6b,
-	0x65, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x07, 0x73, 0x6f,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x75, 0x0a, 0x06, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x08, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x0b,
-	0x63, 0x6f, 0x72, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x69, 0x73, 0x6b, 0x52,
+	0x04, 0x64, 0x69, 0x73, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x22, 0x87, 0x05, 0x0a, 0x06, 0x48, 0x57, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x0a, 0x0a,
+	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x12, 0x2b, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x35, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x43, 0x50, 0x55, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x4e, 0x0a, 0x0e, 0x67, 0x70, 0x75,
+	0x5f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x23, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74,
-	0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x72,
-	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01,
-	0x52, 0x0a, 0x63, 0x6f, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x51, 0x0a, 0x09,
-	0x43, 0x6f, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x72,
-	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f,
-	0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x09, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x6c,
-	0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x92, 0x01,
-	0x04, 0x08, 0x01, 0x18, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22,
-	0xb6, 0x01, 0x0a, 0x09, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a,
-	0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x70, 0x01, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3a, 0x0a, 0x13, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x62, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x10, 0x80, 0x01, 0x28, 0x01, 0x52, 0x11,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x42, 0x69, 0x74,
-	0x73, 0x12, 0x45, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72,
+	0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x47, 0x50, 0x55, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x67, 0x70, 0x75, 0x44,
+	0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x68, 0x6f, 0x73, 0x74,
+	0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x6f, 0x73,
+	0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x07, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67,
+	0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x35, 0x0a, 0x03, 0x70, 0x63, 0x69,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72,
 	0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x32, 0x0a, 0x09, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x50, 0x43, 0x49, 0x12, 0x25, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x5f, 0x63, 0x6c, 0x61,
+	0x6f, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x43, 0x49, 0x52, 0x03, 0x70, 0x63, 0x69,
+	0x12, 0x35, 0x0a, 0x03, 0x75, 0x73, 0x62, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x55,
+	0x53, 0x42, 0x52, 0x03, 0x75, 0x73, 0x62, 0x12, 0x35, 0x0a, 0x03, 0x67, 0x70, 0x75, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73,
+	0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x50, 0x55, 0x52, 0x03, 0x67, 0x70, 0x75, 0x12, 0x3b,
+	0x0a, 0x0b, 0x74, 0x70, 0x6d, 0x5f, 0x65, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1b, 0xfa, 0x42, 0x18, 0x72, 0x16, 0x32, 0x11, 0x5e, 0x5b, 0x30, 0x2d,
+	0x39, 0x61, 0x2d, 0x66, 0x41, 0x2d, 0x46, 0x5d, 0x7b, 0x36, 0x34, 0x7d, 0x24, 0xd0, 0x01, 0x01,
+	0x52, 0x09, 0x74, 0x70, 0x6d, 0x45, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0xb6, 0x02, 0x0a, 0x09,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x43, 0x50, 0x55, 0x12, 0x1c, 0x0a, 0x04, 0x61, 0x72, 0x63,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80,
+	0x01, 0x52, 0x04, 0x61, 0x72, 0x63, 0x68, 0x12, 0x20, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80,
+	0x01, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x05, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18,
+	0x80, 0x01, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x21, 0x0a, 0x07, 0x73, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a,
+	0x02, 0x20, 0x00, 0x52, 0x07, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x05,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x2a, 0x02, 0x20, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x07, 0x74,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x0c, 0x63, 0x70,
+	0x75, 0x5f, 0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x50, 0x55, 0x54,
+	0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x0b, 0x63, 0x70, 0x75, 0x54, 0x6f, 0x70, 0x6f,
+	0x6c, 0x6f, 0x67, 0x79, 0x22, 0x2b, 0x0a, 0x0c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x22, 0xcf, 0x01, 0x0a, 0x0a, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x69, 0x73, 0x6b,
+	0x12, 0x2d, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80,
+	0x01, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa,
+	0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f,
+	0x72, 0x12, 0x1e, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x77, 0x77, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x04, 0x77,
+	0x77, 0x69, 0x64, 0x22, 0xe2, 0x01, 0x0a, 0x09, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x50,
+	0x55, 0x12, 0x24, 0x0a, 0x06, 0x70, 0x63, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0d, 0xfa, 0x42, 0x0a, 0x72, 0x08, 0x10, 0x01, 0x18, 0x80, 0x01, 0xd0, 0x01, 0x01,
+	0x52, 0x05, 0x70, 0x63, 0x69, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18,
+	0x80, 0x01, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x06, 0x76,
+	0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x21, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xfa, 0x42, 0x0a,
+	0x72, 0x08, 0x10, 0x01, 0x18, 0x80, 0x01, 0xd0, 0x01, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x2a, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x96, 0x06, 0x0a, 0x0d, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10,
+	0x01, 0x18, 0x80, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x70, 0x63,
+	0x69, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72,
+	0x03, 0x18, 0x80, 0x01, 0x52, 0x05, 0x70, 0x63, 0x69, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x03, 0x6d,
+	0x61, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18,
+	0x80, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c, 0x69, 0x6e,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x0e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x65, 0x78, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x0d, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x75, 0x70, 0x6c, 0x65, 0x78, 0x12, 0x2e, 0x0a, 0x13,
+	0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15,
+	0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x69, 0x6e, 0x6b,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x61, 0x64, 0x76,
+	0x65, 0x72, 0x74, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c,
+	0x73, 0x72, 0x69, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x73, 0x72, 0x69, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x12, 0x20, 0x0a, 0x0b, 0x73, 0x72, 0x69, 0x6f, 0x76, 0x6e, 0x75, 0x6d, 0x76, 0x66, 0x73, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x72, 0x69, 0x6f, 0x76, 0x6e, 0x75, 0x6d, 0x76,
+	0x66, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x72, 0x69, 0x6f, 0x76, 0x5f, 0x76, 0x66, 0x73, 0x5f,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x72, 0x69,
+	0x6f, 0x76, 0x56, 0x66, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x09, 0x70, 0x65,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x33, 0x0a, 0x10, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x0f, 0x70, 0x65, 0x65, 0x72, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6d,
+	0x61, 0x63, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18,
+	0x80, 0x01, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x4d, 0x61, 0x63, 0x12, 0x2a, 0x0a, 0x0c, 0x70,
+	0x65, 0x65, 0x72, 0x5f, 0x6d, 0x67, 0x6d, 0x74, 0x5f, 0x69, 0x70, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x65,
+	0x72, 0x4d, 0x67, 0x6d, 0x74, 0x49, 0x70, 0x12, 0x25, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72,
+	0x03, 0x18, 0x80, 0x01, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x46,
+	0x0a, 0x0c, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x11,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73,
+	0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x69, 0x70, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6d, 0x63, 0x5f,
+	0x6e, 0x65, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x62, 0x6d, 0x63, 0x4e, 0x65,
+	0x74, 0x22, 0x53, 0x0a, 0x0b, 0x43, 0x50, 0x55, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x12, 0x44, 0x0a, 0x07, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74,
+	0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x07, 0x73,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x75, 0x0a, 0x06, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x4e, 0x0a,
+	0x0b, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75,
+	0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f,
+	0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08,
+	0x01, 0x52, 0x0a, 0x63, 0x6f, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x51, 0x0a,
+	0x09, 0x43, 0x6f, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f,
+	0x72, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x6f, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x09, 0x63, 0x6f, 0x72, 0x65, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x92,
+	0x01, 0x04, 0x08, 0x01, 0x18, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x22, 0xb6, 0x01, 0x0a, 0x09, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26,
+	0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x70, 0x01, 0x52, 0x09, 0x69, 0x70, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3a, 0x0a, 0x13, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x62, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x10, 0x80, 0x01, 0x28, 0x01, 0x52,
+	0x11, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x42, 0x69,
+	0x74, 0x73, 0x12, 0x45, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67,
+	0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0xef, 0x01, 0x0a, 0x09, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x50, 0x43, 0x49, 0x12, 0x25, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x5f, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72,
+	0x03, 0x18, 0x80, 0x01, 0x52, 0x08, 0x64, 0x65, 0x76, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x25,
+	0x0a, 0x09, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x08, 0x76, 0x65, 0x6e,
+	0x64, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18,
+	0x80, 0x01, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x04,
+	0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72,
+	0x03, 0x18, 0x80, 0x01, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x20, 0x0a, 0x06, 0x64, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72,
+	0x03, 0x18, 0x80, 0x01, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x09,
+	0x6e, 0x75, 0x6d, 0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x10, 0xfa, 0x42, 0x0d, 0x1a, 0x0b, 0x28, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0x01, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x61, 0x4e, 0x6f, 0x64, 0x65, 0x22, 0x2c, 0x0a, 0x0a, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x05, 0x63, 0x6c, 0x61,
 	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18,
-	0x80, 0x01, 0x52, 0x08, 0x64, 0x65, 0x76, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x22, 0x2c, 0x0a, 0x0a,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x05, 0x63, 0x6c,
-	0x61, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03,
-	0x18, 0x80, 0x01, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x22, 0xb3, 0x02, 0x0a, 0x09, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x55, 0x53, 0x42, 0x12, 0x1e, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80,
-	0x01, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x08, 0x69, 0x64, 0x76, 0x65,
-	0x6e, 0x64, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72,
-	0x03, 0x18, 0x80, 0x01, 0x52, 0x08, 0x69, 0x64, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x26,
-	0x0a, 0x09, 0x69, 0x64, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x09, 0x69, 0x64, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x75, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x03, 0x62, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x2a, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18,
-	0x80, 0x01, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x44, 0x0a, 0x0a, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f,
-	0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x73, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
-	0x22, 0xd1, 0x01, 0x0a, 0x06, 0x42, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x41, 0x0a, 0x07, 0x62,
-	0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x68,
-	0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x42,
-	0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x52, 0x06, 0x62, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3c,
-	0x0a, 0x08, 0x62, 0x6d, 0x63, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68,
-	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6d, 0x63, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x07, 0x62, 0x6d, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x46, 0x0a, 0x07,
-	0x42, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x4d, 0x49, 0x10,
-	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x44, 0x46, 0x49, 0x53, 0x48, 0x10, 0x01, 0x12, 0x07,
-	0x0a, 0x03, 0x50, 0x44, 0x55, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x50, 0x52, 0x4f, 0x10,
-	0x03, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x44, 0x4f, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f,
-	0x4e, 0x45, 0x10, 0x05, 0x22, 0x7e, 0x0a, 0x07, 0x42, 0x6d, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x1d, 0x0a, 0x05, 0x62, 0x6d, 0x5f, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
-	0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x04, 0x62, 0x6d, 0x49, 0x70, 0x12, 0x29,
-	0x0a, 0x0b, 0x62, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x0a, 0x62,
-	0x6d, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x0b, 0x62, 0x6d, 0x5f,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
-	0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x0a, 0x62, 0x6d, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x22, 0x9f, 0x01, 0x0a, 0x21, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48,
-	0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x47,
-	0x75, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x68, 0x6f,
-	0x73, 0x74, 0x5f, 0x67, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xfa,
-	0x42, 0x09, 0x72, 0x07, 0x10, 0x01, 0x28, 0x24, 0xb0, 0x01, 0x01, 0x52, 0x08, 0x68, 0x6f, 0x73,
-	0x74, 0x47, 0x75, 0x69, 0x64, 0x12, 0x4f, 0x0a, 0x0b, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x68, 0x6f, 0x73,
-	0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x21, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x42,
-	0x79, 0x47, 0x55, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x09,
-	0x68, 0x6f, 0x73, 0x74, 0x5f, 0x67, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0c, 0xfa, 0x42, 0x09, 0x72, 0x07, 0x10, 0x01, 0x28, 0x24, 0xb0, 0x01, 0x01, 0x52, 0x08, 0x68,
-	0x6f, 0x73, 0x74, 0x47, 0x75, 0x69, 0x64, 0x12, 0x4f, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x68,
-	0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e,
-	0x66, 0x6f, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x24, 0x0a, 0x22, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f,
-	0x42, 0x79, 0x47, 0x55, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xae,
-	0x02, 0x0a, 0x2a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x48, 0x6f,
-	0x73, 0x74, 0x47, 0x55, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a,
-	0x09, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x67, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x28, 0x24, 0xb0, 0x01, 0x01, 0x52, 0x08, 0x68, 0x6f,
-	0x73, 0x74, 0x47, 0x75, 0x69, 0x64, 0x12, 0x51, 0x0a, 0x0f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x28, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62,
-	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4e, 0x0a, 0x0e, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x27, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74,
-	0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x64, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22,
-	0x2d, 0x0a, 0x2b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x48, 0x6f,
-	0x73, 0x74, 0x47, 0x55, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x5a,
-	0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x17,
-	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4e,
-	0x46, 0x49, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x10,
-	0x01, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
-	0x5f, 0x44, 0x59, 0x4e, 0x41, 0x4d, 0x49, 0x43, 0x10, 0x02, 0x2a, 0xa1, 0x01, 0x0a, 0x0d, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x1a,
-	0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
-	0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x49,
-	0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e,
-	0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x55, 0x4e,
-	0x4e, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e,
-	0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44,
-	0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x05, 0x2a, 0xf3,
-	0x02, 0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x42, 0x4f, 0x4f, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x80, 0x01, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x22, 0xb3, 0x02, 0x0a, 0x09, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x55, 0x53, 0x42, 0x12, 0x1e, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01,
+	0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x08, 0x69, 0x64, 0x76, 0x65, 0x6e,
+	0x64, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03,
+	0x18, 0x80, 0x01, 0x52, 0x08, 0x69, 0x64, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x26, 0x0a,
+	0x09, 0x69, 0x64, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x09, 0x69, 0x64, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x03, 0x62, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x2a, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80,
+	0x01, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x44, 0x0a, 0x0a, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x73, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x22,
+	0xd1, 0x01, 0x0a, 0x06, 0x42, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x41, 0x0a, 0x07, 0x62, 0x6d,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x68, 0x6f,
+	0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x42, 0x6d,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x52, 0x06, 0x62, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3c, 0x0a,
+	0x08, 0x62, 0x6d, 0x63, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6d, 0x63, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x07, 0x62, 0x6d, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x46, 0x0a, 0x07, 0x42,
+	0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x4d, 0x49, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x44, 0x46, 0x49, 0x53, 0x48, 0x10, 0x01, 0x12, 0x07, 0x0a,
+	0x03, 0x50, 0x44, 0x55, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x50, 0x52, 0x4f, 0x10, 0x03,
+	0x12, 0x07, 0x0a, 0x03, 0x46, 0x44, 0x4f, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x05, 0x22, 0x7e, 0x0a, 0x07, 0x42, 0x6d, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d,
+	0x0a, 0x05, 0x62, 0x6d, 0x5f, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x04, 0x62, 0x6d, 0x49, 0x70, 0x12, 0x29, 0x0a,
+	0x0b, 0x62, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x0a, 0x62, 0x6d,
+	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x0b, 0x62, 0x6d, 0x5f, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x0a, 0x62, 0x6d, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x22, 0xf6, 0x01, 0x0a, 0x21, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x6f,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x47, 0x75,
+	0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x68, 0x6f, 0x73,
+	0x74, 0x5f, 0x67, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xfa, 0x42,
+	0x09, 0x72, 0x07, 0x10, 0x01, 0x28, 0x24, 0xb0, 0x01, 0x01, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74,
+	0x47, 0x75, 0x69, 0x64, 0x12, 0x4f, 0x0a, 0x0b, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x68, 0x6f, 0x73, 0x74,
+	0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2c,
+	0x0a, 0x12, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x5f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e, 0x73, 0x22, 0xdd, 0x01, 0x0a,
+	0x12, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x67, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x72, 0x07, 0x10, 0x01, 0x28,
+	0x24, 0xb0, 0x01, 0x01, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x47, 0x75, 0x69, 0x64, 0x12, 0x45,
+	0x0a, 0x0b, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f,
+	0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48,
+	0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x4e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x9f, 0x01, 0x0a,
+	0x21, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x47, 0x55, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x67, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x72, 0x07, 0x10, 0x01, 0x28, 0x24,
+	0xb0, 0x01, 0x01, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x47, 0x75, 0x69, 0x64, 0x12, 0x4f, 0x0a,
+	0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75,
+	0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02,
+	0x10, 0x01, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x24,
+	0x0a, 0x22, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x47, 0x55, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x03, 0x0a, 0x2a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x42, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x47, 0x55, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x67, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x28, 0x24, 0xb0,
+	0x01, 0x01, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x47, 0x75, 0x69, 0x64, 0x12, 0x51, 0x0a, 0x0f,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f,
+	0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x0e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x4e, 0x0a, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67,
+	0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x34, 0x0a, 0x16, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x14, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2c,
+	0x0a, 0x12, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x5f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e, 0x73, 0x22, 0x2d, 0x0a, 0x2b,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x47,
+	0x55, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x5a, 0x0a, 0x0a, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x10, 0x01, 0x12, 0x17,
+	0x0a, 0x13, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x59,
+	0x4e, 0x41, 0x4d, 0x49, 0x43, 0x10, 0x02, 0x2a, 0xa1, 0x01, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4e, 0x53,
+	0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4e, 0x53,
+	0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x53, 0x54,
+	0x41, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x53, 0x54, 0x41,
+	0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e,
+	0x47, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x04, 0x12,
+	0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x05, 0x2a, 0xf3, 0x02, 0x0a, 0x0e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f,
+	0x0a, 0x1b, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x42, 0x4f, 0x4f, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b,
+	0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x42, 0x4f, 0x4f, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x20, 0x0a,
+	0x1c, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12,
 	0x1f, 0x0a, 0x1b, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x42, 0x4f, 0x4f, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02,
-	0x12, 0x20, 0x0a, 0x1c, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x49, 0x4e, 0x47,
-	0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x45,
-	0x44, 0x10, 0x04, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e,
-	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x53,
-	0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e,
-	0x4e, 0x49, 0x4e, 0x47, 0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e,
-	0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
-	0x07, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x09, 0x12,
-	0x21, 0x0a, 0x1d, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
-	0x10, 0x0a, 0x12, 0x20, 0x0a, 0x1c, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x49,
-	0x4e, 0x47, 0x10, 0x0b, 0x32, 0xe4, 0x03, 0x0a, 0x07, 0x48, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72,
-	0x12, 0x85, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x47, 0x75, 0x69, 0x64, 0x12,
-	0x3b, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62,
-	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x48, 0x6f, 0x73,
-	0x74, 0x47, 0x75, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x68,
+	0x55, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x04,
+	0x12, 0x24, 0x0a, 0x20, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e,
+	0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e,
+	0x47, 0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x07, 0x12, 0x1c,
+	0x0a, 0x18, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x09, 0x12, 0x21, 0x0a, 0x1d,
+	0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x0a, 0x12,
+	0x20, 0x0a, 0x1c, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x49, 0x4e, 0x47, 0x10,
+	0x0b, 0x32, 0xd1, 0x04, 0x0a, 0x07, 0x48, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x12, 0x85, 0x01,
+	0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x42, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x47, 0x75, 0x69, 0x64, 0x12, 0x3b, 0x2e, 0x68,
 	0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0xb4, 0x01, 0x0a, 0x23, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x47, 0x55, 0x49, 0x44,
-	0x12, 0x44, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68,
-	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x47, 0x55, 0x49, 0x44, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x45, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72,
-	0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x48, 0x6f, 0x73,
-	0x74, 0x47, 0x55, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x99, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x47, 0x55, 0x49, 0x44, 0x12, 0x3b,
-	0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f,
-	0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x48, 0x6f, 0x73, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79,
-	0x47, 0x55, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x68, 0x6f,
+	0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x6f,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x47, 0x75,
+	0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x68, 0x6f, 0x73, 0x74,
+	0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0xb4, 0x01, 0x0a, 0x23, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x42, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x47, 0x55, 0x49, 0x44, 0x12, 0x44, 0x2e,
+	0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x42, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x47, 0x55, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x45, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f,
+	0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x47, 0x55,
+	0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x99, 0x01, 0x0a,
+	0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x47, 0x55, 0x49, 0x44, 0x12, 0x3b, 0x2e, 0x68, 0x6f,
 	0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64,
 	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x73,
 	0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x47, 0x55, 0x49,
-	0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x16, 0x5a, 0x14, 0x2e,
-	0x3b, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f,
-	0x75, 0x6e, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d,
+	0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x47, 0x55, 0x49, 0x44, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x0b, 0x48, 0x6f, 0x73, 0x74,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67,
+	0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x6d, 0x67, 0x72, 0x5f,
+	0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x16, 0x5a, 0x14, 0x2e, 0x3b, 0x68, 0x6f, 0x73, 0x74, 0x6d,
+	0x67, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2950,7 +3348,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_rawDescGZIP() []byte {
 }
 
 var file_hostmgr_proto_hostmgr_southbound_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_hostmgr_proto_hostmgr_southbound_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_hostmgr_proto_hostmgr_southbound_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_hostmgr_proto_hostmgr_southbound_proto_goTypes = []interface{}{
 	(ConfigMode)(0),                            // 0: hostmgr_southbound_proto.ConfigMode
 	(InstanceState)(0),                         // 1: hostmgr_southbound_proto.InstanceState
@@ -2962,78 +3360,86 @@ var file_hostmgr_proto_hostmgr_southbound_proto_goTypes = []interface{}{
 	(*HostStatusResp)(nil),                     // 7: hostmgr_southbound_proto.HostStatusResp
 	(*Metadata)(nil),                           // 8: hostmgr_southbound_proto.Metadata
 	(*SystemInfo)(nil),                         // 9: hostmgr_southbound_proto.SystemInfo
-	(*ClusterInfo)(nil),                        // 10: hostmgr_southbound_proto.ClusterInfo
-	(*BiosInfo)(nil),                           // 11: hostmgr_southbound_proto.BiosInfo
-	(*OsInfo)(nil),                             // 12: hostmgr_southbound_proto.OsInfo
-	(*Config)(nil),                             // 13: hostmgr_southbound_proto.Config
-	(*OsKernel)(nil),                           // 14: hostmgr_southbound_proto.OsKernel
-	(*OsRelease)(nil),                          // 15: hostmgr_southbound_proto.OsRelease
-	(*Storage)(nil),                            // 16: hostmgr_southbound_proto.Storage
-	(*HWInfo)(nil),                             // 17: hostmgr_southbound_proto.HWInfo
-	(*SystemCPU)(nil),                          // 18: hostmgr_southbound_proto.SystemCPU
-	(*SystemMemory)(nil),                       // 19: hostmgr_southbound_proto.SystemMemory
-	(*SystemDisk)(nil),                         // 20: hostmgr_southbound_proto.SystemDisk
-	(*SystemGPU)(nil),                          // 21: hostmgr_southbound_proto.SystemGPU
-	(*SystemNetwork)(nil),                      // 22: hostmgr_southbound_proto.SystemNetwork
-	(*CPUTopology)(nil),                        // 23: hostmgr_southbound_proto.CPUTopology
-	(*Socket)(nil),                             // 24: hostmgr_southbound_proto.Socket
-	(*CoreGroup)(nil),                          // 25: hostmgr_southbound_proto.CoreGroup
-	(*IPAddress)(nil),                          // 26: hostmgr_southbound_proto.IPAddress
-	(*SystemPCI)(nil),                          // 27: hostmgr_southbound_proto.SystemPCI
-	(*Interfaces)(nil),                         // 28: hostmgr_southbound_proto.Interfaces
-	(*SystemUSB)(nil),                          // 29: hostmgr_southbound_proto.SystemUSB
-	(*BmInfo)(nil),                             // 30: hostmgr_southbound_proto.BmInfo
-	(*BmcInfo)(nil),                            // 31: hostmgr_southbound_proto.BmcInfo
-	(*UpdateHostStatusByHostGuidRequest)(nil),  // 32: hostmgr_southbound_proto.UpdateHostStatusByHostGuidRequest
-	(*UpdateHostSystemInfoByGUIDRequest)(nil),  // 33: hostmgr_southbound_proto.UpdateHostSystemInfoByGUIDRequest
-	(*UpdateHostSystemInfoByGUIDResponse)(nil), // 34: hostmgr_southbound_proto.UpdateHostSystemInfoByGUIDResponse
-	(*UpdateInstanceStateStatusByHostGUIDRequest)(nil),  // 35: hostmgr_southbound_proto.UpdateInstanceStateStatusByHostGUIDRequest
-	(*UpdateInstanceStateStatusByHostGUIDResponse)(nil), // 36: hostmgr_southbound_proto.UpdateInstanceStateStatusByHostGUIDResponse
+	(*AgentInfo)(nil),                          // 10: hostmgr_southbound_proto.AgentInfo
+	(*Agent)(nil),                              // 11: hostmgr_southbound_proto.Agent
+	(*ClusterInfo)(nil),                        // 12: hostmgr_southbound_proto.ClusterInfo
+	(*BiosInfo)(nil),                           // 13: hostmgr_southbound_proto.BiosInfo
+	(*OsInfo)(nil),                             // 14: hostmgr_southbound_proto.OsInfo
+	(*Config)(nil),                             // 15: hostmgr_southbound_proto.Config
+	(*OsKernel)(nil),                           // 16: hostmgr_southbound_proto.OsKernel
+	(*OsRelease)(nil),                          // 17: hostmgr_southbound_proto.OsRelease
+	(*Storage)(nil),                            // 18: hostmgr_southbound_proto.Storage
+	(*HWInfo)(nil),                             // 19: hostmgr_southbound_proto.HWInfo
+	(*SystemCPU)(nil),                          // 20: hostmgr_southbound_proto.SystemCPU
+	(*SystemMemory)(nil),                       // 21: hostmgr_southbound_proto.SystemMemory
+	(*SystemDisk)(nil),                         // 22: hostmgr_southbound_proto.SystemDisk
+	(*SystemGPU)(nil),                          // 23: hostmgr_southbound_proto.SystemGPU
+	(*SystemNetwork)(nil),                      // 24: hostmgr_southbound_proto.SystemNetwork
+	(*CPUTopology)(nil),                        // 25: hostmgr_southbound_proto.CPUTopology
+	(*Socket)(nil),                             // 26: hostmgr_southbound_proto.Socket
+	(*CoreGroup)(nil),                          // 27: hostmgr_southbound_proto.CoreGroup
+	(*IPAddress)(nil),                          // 28: hostmgr_southbound_proto.IPAddress
+	(*SystemPCI)(nil),                          // 29: hostmgr_southbound_proto.SystemPCI
+	(*Interfaces)(nil),                         // 30: hostmgr_southbound_proto.Interfaces
+	(*SystemUSB)(nil),                          // 31: hostmgr_southbound_proto.SystemUSB
+	(*BmInfo)(nil),                             // 32: hostmgr_southbound_proto.BmInfo
+	(*BmcInfo)(nil),                            // 33: hostmgr_southbound_proto.BmcInfo
+	(*UpdateHostStatusByHostGuidRequest)(nil),  // 34: hostmgr_southbound_proto.UpdateHostStatusByHostGuidRequest
+	(*HostSessionRequest)(nil),                 // 35: hostmgr_southbound_proto.HostSessionRequest
+	(*UpdateHostSystemInfoByGUIDRequest)(nil),  // 36: hostmgr_southbound_proto.UpdateHostSystemInfoByGUIDRequest
+	(*UpdateHostSystemInfoByGUIDResponse)(nil), // 37: hostmgr_southbound_proto.UpdateHostSystemInfoByGUIDResponse
+	(*UpdateInstanceStateStatusByHostGUIDRequest)(nil),  // 38: hostmgr_southbound_proto.UpdateInstanceStateStatusByHostGUIDRequest
+	(*UpdateInstanceStateStatusByHostGUIDResponse)(nil), // 39: hostmgr_southbound_proto.UpdateInstanceStateStatusByHostGUIDResponse
 }
 var file_hostmgr_proto_hostmgr_southbound_proto_depIdxs = []int32{
 	3,  // 0: hostmgr_southbound_proto.HostStatus.host_status:type_name -> hostmgr_southbound_proto.HostStatus.Host_status
 	4,  // 1: hostmgr_southbound_proto.HostStatusResp.host_action:type_name -> hostmgr_southbound_proto.HostStatusResp.Host_action
-	17, // 2: hostmgr_southbound_proto.SystemInfo.hw_info:type_name -> hostmgr_southbound_proto.HWInfo
-	12, // 3: hostmgr_southbound_proto.SystemInfo.os_info:type_name -> hostmgr_southbound_proto.OsInfo
-	30, // 4: hostmgr_southbound_proto.SystemInfo.bm_ctl_info:type_name -> hostmgr_southbound_proto.BmInfo
-	11, // 5: hostmgr_southbound_proto.SystemInfo.bios_info:type_name -> hostmgr_southbound_proto.BiosInfo
-	10, // 6: hostmgr_southbound_proto.SystemInfo.kc_info:type_name -> hostmgr_southbound_proto.ClusterInfo
-	14, // 7: hostmgr_southbound_proto.OsInfo.kernel:type_name -> hostmgr_southbound_proto.OsKernel
-	15, // 8: hostmgr_southbound_proto.OsInfo.release:type_name -> hostmgr_southbound_proto.OsRelease
-	13, // 9: hostmgr_southbound_proto.OsKernel.config:type_name -> hostmgr_southbound_proto.Config
-	8,  // 10: hostmgr_southbound_proto.OsRelease.metadata:type_name -> hostmgr_southbound_proto.Metadata
-	20, // 11: hostmgr_southbound_proto.Storage.disk:type_name -> hostmgr_southbound_proto.SystemDisk
-	18, // 12: hostmgr_southbound_proto.HWInfo.cpu:type_name -> hostmgr_southbound_proto.SystemCPU
-	21, // 13: hostmgr_southbound_proto.HWInfo.gpu_deprecated:type_name -> hostmgr_southbound_proto.SystemGPU
-	19, // 14: hostmgr_southbound_proto.HWInfo.memory:type_name -> hostmgr_southbound_proto.SystemMemory
-	16, // 15: hostmgr_southbound_proto.HWInfo.storage:type_name -> hostmgr_southbound_proto.Storage
-	22, // 16: hostmgr_southbound_proto.HWInfo.network:type_name -> hostmgr_southbound_proto.SystemNetwork
-	27, // 17: hostmgr_southbound_proto.HWInfo.pci:type_name -> hostmgr_southbound_proto.SystemPCI
-	29, // 18: hostmgr_southbound_proto.HWInfo.usb:type_name -> hostmgr_southbound_proto.SystemUSB
-	21, // 19: hostmgr_southbound_proto.HWInfo.gpu:type_name -> hostmgr_southbound_proto.SystemGPU
-	23, // 20: hostmgr_southbound_proto.SystemCPU.cpu_topology:type_name -> hostmgr_southbound_proto.CPUTopology
-	26, // 21: hostmgr_southbound_proto.SystemNetwork.ip_addresses:type_name -> hostmgr_southbound_proto.IPAddress
-	24, // 22: hostmgr_southbound_proto.CPUTopology.sockets:type_name -> hostmgr_southbound_proto.Socket
-	25, // 23: hostmgr_southbound_proto.Socket.core_groups:type_name -> hostmgr_southbound_proto.CoreGroup
-	0,  // 24: hostmgr_southbound_proto.IPAddress.config_mode:type_name -> hostmgr_southbound_proto.ConfigMode
-	28, // 25: hostmgr_southbound_proto.SystemUSB.interfaces:type_name -> hostmgr_southbound_proto.Interfaces
-	5,  // 26: hostmgr_southbound_proto.BmInfo.bm_type:type_name -> hostmgr_southbound_proto.BmInfo.Bm_type
-	31, // 27: hostmgr_southbound_proto.BmInfo.bmc_info:type_name -> hostmgr_southbound_proto.BmcInfo
-	6,  // 28: hostmgr_southbound_proto.UpdateHostStatusByHostGuidRequest.host_status:type_name -> hostmgr_southbound_proto.HostStatus
-	9,  // 29: hostmgr_southbound_proto.UpdateHostSystemInfoByGUIDRequest.system_info:type_name -> hostmgr_southbound_proto.SystemInfo
-	2,  // 30: hostmgr_southbound_proto.UpdateInstanceStateStatusByHostGUIDRequest.instance_status:type_name -> hostmgr_southbound_proto.InstanceStatus
-	1,  // 31: hostmgr_southbound_proto.UpdateInstanceStateStatusByHostGUIDRequest.instance_state:type_name -> hostmgr_southbound_proto.InstanceState
-	32, // 32: hostmgr_southbound_proto.Hostmgr.UpdateHostStatusByHostGuid:input_type -> hostmgr_southbound_proto.UpdateHostStatusByHostGuidRequest
-	35, // 33: hostmgr_southbound_proto.Hostmgr.UpdateInstanceStateStatusByHostGUID:input_type -> hostmgr_southbound_proto.UpdateInstanceStateStatusByHostGUIDRequest
-	33, // 34: hostmgr_southbound_proto.Hostmgr.UpdateHostSystemInfoByGUID:input_type -> hostmgr_southbound_proto.UpdateHostSystemInfoByGUIDRequest
-	7,  // 35: hostmgr_southbound_proto.Hostmgr.UpdateHostStatusByHostGuid:output_type -> hostmgr_southbound_proto.HostStatusResp
-	36, // 36: hostmgr_southbound_proto.Hostmgr.UpdateInstanceStateStatusByHostGUID:output_type -> hostmgr_southbound_proto.UpdateInstanceStateStatusByHostGUIDResponse
-	34, // 37: hostmgr_southbound_proto.Hostmgr.UpdateHostSystemInfoByGUID:output_type -> hostmgr_southbound_proto.UpdateHostSystemInfoByGUIDResponse
-	35, // [35:38] is the sub-list for method output_type
-	32, // [32:35] is the sub-list for method input_type
-	32, // [32:32] is the sub-list for extension type_name
-	32, // [32:32] is the sub-list for extension extendee
-	0,  // [0:32] is the sub-list for field type_name
+	19, // 2: hostmgr_southbound_proto.SystemInfo.hw_info:type_name -> hostmgr_southbound_proto.HWInfo
+	14, // 3: hostmgr_southbound_proto.SystemInfo.os_info:type_name -> hostmgr_southbound_proto.OsInfo
+	32, // 4: hostmgr_southbound_proto.SystemInfo.bm_ctl_info:type_name -> hostmgr_southbound_proto.BmInfo
+	13, // 5: hostmgr_southbound_proto.SystemInfo.bios_info:type_name -> hostmgr_southbound_proto.BiosInfo
+	12, // 6: hostmgr_southbound_proto.SystemInfo.kc_info:type_name -> hostmgr_southbound_proto.ClusterInfo
+	10, // 7: hostmgr_southbound_proto.SystemInfo.agent_info:type_name -> hostmgr_southbound_proto.AgentInfo
+	11, // 8: hostmgr_southbound_proto.AgentInfo.agents:type_name -> hostmgr_southbound_proto.Agent
+	16, // 9: hostmgr_southbound_proto.OsInfo.kernel:type_name -> hostmgr_southbound_proto.OsKernel
+	17, // 10: hostmgr_southbound_proto.OsInfo.release:type_name -> hostmgr_southbound_proto.OsRelease
+	15, // 11: hostmgr_southbound_proto.OsKernel.config:type_name -> hostmgr_southbound_proto.Config
+	8,  // 12: hostmgr_southbound_proto.OsRelease.metadata:type_name -> hostmgr_southbound_proto.Metadata
+	22, // 13: hostmgr_southbound_proto.Storage.disk:type_name -> hostmgr_southbound_proto.SystemDisk
+	20, // 14: hostmgr_southbound_proto.HWInfo.cpu:type_name -> hostmgr_southbound_proto.SystemCPU
+	23, // 15: hostmgr_southbound_proto.HWInfo.gpu_deprecated:type_name -> hostmgr_southbound_proto.SystemGPU
+	21, // 16: hostmgr_southbound_proto.HWInfo.memory:type_name -> hostmgr_southbound_proto.SystemMemory
+	18, // 17: hostmgr_southbound_proto.HWInfo.storage:type_name -> hostmgr_southbound_proto.Storage
+	24, // 18: hostmgr_southbound_proto.HWInfo.network:type_name -> hostmgr_southbound_proto.SystemNetwork
+	29, // 19: hostmgr_southbound_proto.HWInfo.pci:type_name -> hostmgr_southbound_proto.SystemPCI
+	31, // 20: hostmgr_southbound_proto.HWInfo.usb:type_name -> hostmgr_southbound_proto.SystemUSB
+	23, // 21: hostmgr_southbound_proto.HWInfo.gpu:type_name -> hostmgr_southbound_proto.SystemGPU
+	25, // 22: hostmgr_southbound_proto.SystemCPU.cpu_topology:type_name -> hostmgr_southbound_proto.CPUTopology
+	28, // 23: hostmgr_southbound_proto.SystemNetwork.ip_addresses:type_name -> hostmgr_southbound_proto.IPAddress
+	26, // 24: hostmgr_southbound_proto.CPUTopology.sockets:type_name -> hostmgr_southbound_proto.Socket
+	27, // 25: hostmgr_southbound_proto.Socket.core_groups:type_name -> hostmgr_southbound_proto.CoreGroup
+	0,  // 26: hostmgr_southbound_proto.IPAddress.config_mode:type_name -> hostmgr_southbound_proto.ConfigMode
+	30, // 27: hostmgr_southbound_proto.SystemUSB.interfaces:type_name -> hostmgr_southbound_proto.Interfaces
+	5,  // 28: hostmgr_southbound_proto.BmInfo.bm_type:type_name -> hostmgr_southbound_proto.BmInfo.Bm_type
+	33, // 29: hostmgr_southbound_proto.BmInfo.bmc_info:type_name -> hostmgr_southbound_proto.BmcInfo
+	6,  // 30: hostmgr_southbound_proto.UpdateHostStatusByHostGuidRequest.host_status:type_name -> hostmgr_southbound_proto.HostStatus
+	6,  // 31: hostmgr_southbound_proto.HostSessionRequest.host_status:type_name -> hostmgr_southbound_proto.HostStatus
+	9,  // 32: hostmgr_southbound_proto.UpdateHostSystemInfoByGUIDRequest.system_info:type_name -> hostmgr_southbound_proto.SystemInfo
+	2,  // 33: hostmgr_southbound_proto.UpdateInstanceStateStatusByHostGUIDRequest.instance_status:type_name -> hostmgr_southbound_proto.InstanceStatus
+	1,  // 34: hostmgr_southbound_proto.UpdateInstanceStateStatusByHostGUIDRequest.instance_state:type_name -> hostmgr_southbound_proto.InstanceState
+	34, // 35: hostmgr_southbound_proto.Hostmgr.UpdateHostStatusByHostGuid:input_type -> hostmgr_southbound_proto.UpdateHostStatusByHostGuidRequest
+	38, // 36: hostmgr_southbound_proto.Hostmgr.UpdateInstanceStateStatusByHostGUID:input_type -> hostmgr_southbound_proto.UpdateInstanceStateStatusByHostGUIDRequest
+	36, // 37: hostmgr_southbound_proto.Hostmgr.UpdateHostSystemInfoByGUID:input_type -> hostmgr_southbound_proto.UpdateHostSystemInfoByGUIDRequest
+	35, // 38: hostmgr_southbound_proto.Hostmgr.HostSession:input_type -> hostmgr_southbound_proto.HostSessionRequest
+	7,  // 39: hostmgr_southbound_proto.Hostmgr.UpdateHostStatusByHostGuid:output_type -> hostmgr_southbound_proto.HostStatusResp
+	39, // 40: hostmgr_southbound_proto.Hostmgr.UpdateInstanceStateStatusByHostGUID:output_type -> hostmgr_southbound_proto.UpdateInstanceStateStatusByHostGUIDResponse
+	37, // 41: hostmgr_southbound_proto.Hostmgr.UpdateHostSystemInfoByGUID:output_type -> hostmgr_southbound_proto.UpdateHostSystemInfoByGUIDResponse
+	7,  // 42: hostmgr_southbound_proto.Hostmgr.HostSession:output_type -> hostmgr_southbound_proto.HostStatusResp
+	39, // [39:43] is the sub-list for method output_type
+	35, // [35:39] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_hostmgr_proto_hostmgr_southbound_proto_init() }
@@ -3091,7 +3497,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterInfo); i {
+			switch v := v.(*AgentInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3103,7 +3509,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BiosInfo); i {
+			switch v := v.(*Agent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3115,7 +3521,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OsInfo); i {
+			switch v := v.(*ClusterInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3127,7 +3533,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Config); i {
+			switch v := v.(*BiosInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3139,7 +3545,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OsKernel); i {
+			switch v := v.(*OsInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3151,7 +3557,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OsRelease); i {
+			switch v := v.(*Config); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3163,7 +3569,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Storage); i {
+			switch v := v.(*OsKernel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3175,7 +3581,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HWInfo); i {
+			switch v := v.(*OsRelease); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3187,7 +3593,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemCPU); i {
+			switch v := v.(*Storage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3199,7 +3605,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemMemory); i {
+			switch v := v.(*HWInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3211,7 +3617,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemDisk); i {
+			switch v := v.(*SystemCPU); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3223,7 +3629,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemGPU); i {
+			switch v := v.(*SystemMemory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3235,7 +3641,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemNetwork); i {
+			switch v := v.(*SystemDisk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3247,7 +3653,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CPUTopology); i {
+			switch v := v.(*SystemGPU); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3259,7 +3665,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Socket); i {
+			switch v := v.(*SystemNetwork); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3271,7 +3677,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CoreGroup); i {
+			switch v := v.(*CPUTopology); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3283,7 +3689,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IPAddress); i {
+			switch v := v.(*Socket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3295,7 +3701,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemPCI); i {
+			switch v := v.(*CoreGroup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3307,7 +3713,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Interfaces); i {
+			switch v := v.(*IPAddress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3319,7 +3725,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemUSB); i {
+			switch v := v.(*SystemPCI); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3331,7 +3737,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BmInfo); i {
+			switch v := v.(*Interfaces); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3343,7 +3749,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BmcInfo); i {
+			switch v := v.(*SystemUSB); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3355,7 +3761,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateHostStatusByHostGuidRequest); i {
+			switch v := v.(*BmInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3367,7 +3773,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateHostSystemInfoByGUIDRequest); i {
+			switch v := v.(*BmcInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3379,7 +3785,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateHostSystemInfoByGUIDResponse); i {
+			switch v := v.(*UpdateHostStatusByHostGuidRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3391,7 +3797,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateInstanceStateStatusByHostGUIDRequest); i {
+			switch v := v.(*HostSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3403,6 +3809,42 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			}
 		}
 		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateHostSystemInfoByGUIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateHostSystemInfoByGUIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateInstanceStateStatusByHostGUIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hostmgr_proto_hostmgr_southbound_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateInstanceStateStatusByHostGUIDResponse); i {
 			case 0:
 				return &v.state
@@ -3421,7 +3863,7 @@ func file_hostmgr_proto_hostmgr_southbound_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_hostmgr_proto_hostmgr_southbound_proto_rawDesc,
 			NumEnums:      6,
-			NumMessages:   31,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
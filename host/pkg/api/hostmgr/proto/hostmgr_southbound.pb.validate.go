@@ -66,6 +66,59 @@ func (m *HostStatus) validate(all bool) error {
 
 	// no validation rules for HumanReadableStatus
 
+	if m.GetErrorCode() != "" {
+
+		if utf8.RuneCountInString(m.GetErrorCode()) > 64 {
+			err := HostStatusValidationError{
+				field:  "ErrorCode",
+				reason: "value length must be at most 64 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_HostStatus_ErrorCode_Pattern.MatchString(m.GetErrorCode()) {
+			err := HostStatusValidationError{
+				field:  "ErrorCode",
+				reason: "value does not match regex pattern \"^[A-Za-z0-9_.-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetBootId() != "" {
+
+		if err := m._validateUuid(m.GetBootId()); err != nil {
+			err = HostStatusValidationError{
+				field:  "BootId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetUptimeSeconds() > 3153600000 {
+		err := HostStatusValidationError{
+			field:  "UptimeSeconds",
+			reason: "value must be less than or equal to 3153600000",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return HostStatusMultiError(errors)
 	}
@@ -73,6 +126,14 @@ func (m *HostStatus) validate(all bool) error {
 	return nil
 }
 
+func (m *HostStatus) _validateUuid(uuid string) error {
+	if matched := _hostmgr_southbound_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
 // HostStatusMultiError is an error wrapping multiple validation errors
 // returned by HostStatus.ValidateAll() if the designated constraints aren't met.
 type HostStatusMultiError []error
@@ -143,6 +204,8 @@ var _ interface {
 	ErrorName() string
 } = HostStatusValidationError{}
 
+var _HostStatus_ErrorCode_Pattern = regexp.MustCompile("^[A-Za-z0-9_.-]+$")
+
 // Validate checks the field values on HostStatusResp with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -486,50 +549,418 @@ func (m *SystemInfo) validate(all bool) error {
 				cause:  err,
 			}
 		}
-	}
+	}
+
+	if all {
+		switch v := interface{}(m.GetKcInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SystemInfoValidationError{
+					field:  "KcInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SystemInfoValidationError{
+					field:  "KcInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKcInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SystemInfoValidationError{
+				field:  "KcInfo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAgentInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SystemInfoValidationError{
+					field:  "AgentInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SystemInfoValidationError{
+					field:  "AgentInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAgentInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SystemInfoValidationError{
+				field:  "AgentInfo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SystemInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// SystemInfoMultiError is an error wrapping multiple validation errors
+// returned by SystemInfo.ValidateAll() if the designated constraints aren't met.
+type SystemInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SystemInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SystemInfoMultiError) AllErrors() []error { return m }
+
+// SystemInfoValidationError is the validation error returned by
+// SystemInfo.Validate if the designated constraints aren't met.
+type SystemInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SystemInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SystemInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SystemInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SystemInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SystemInfoValidationError) ErrorName() string { return "SystemInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SystemInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSystemInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SystemInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SystemInfoValidationError{}
+
+// Validate checks the field values on AgentInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AgentInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AgentInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AgentInfoMultiError, or nil
+// if none found.
+func (m *AgentInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AgentInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetAgents()) > 32 {
+		err := AgentInfoValidationError{
+			field:  "Agents",
+			reason: "value must contain no more than 32 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetAgents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AgentInfoValidationError{
+						field:  fmt.Sprintf("Agents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AgentInfoValidationError{
+						field:  fmt.Sprintf("Agents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AgentInfoValidationError{
+					field:  fmt.Sprintf("Agents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AgentInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// AgentInfoMultiError is an error wrapping multiple validation errors
+// returned by AgentInfo.ValidateAll() if the designated constraints aren't met.
+type AgentInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AgentInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AgentInfoMultiError) AllErrors() []error { return m }
+
+// AgentInfoValidationError is the validation error returned by
+// AgentInfo.Validate if the designated constraints aren't met.
+type AgentInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AgentInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AgentInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AgentInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AgentInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AgentInfoValidationError) ErrorName() string { return "AgentInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AgentInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAgentInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AgentInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AgentInfoValidationError{}
+
+// Validate checks the field values on Agent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Agent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Agent with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AgentMultiError, or nil if none found.
+func (m *Agent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Agent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 64 {
+		err := AgentValidationError{
+			field:  "Name",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Agent_Name_Pattern.MatchString(m.GetName()) {
+		err := AgentValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-z0-9][a-z0-9._-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetVersion()) > 64 {
+		err := AgentValidationError{
+			field:  "Version",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Agent_Version_Pattern.MatchString(m.GetVersion()) {
+		err := AgentValidationError{
+			field:  "Version",
+			reason: "value does not match regex pattern \"^v?\\\\d+(\\\\.\\\\d+){0,2}([-+][0-9A-Za-z.+-]*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetFeatures()) > 64 {
+		err := AgentValidationError{
+			field:  "Features",
+			reason: "value must contain no more than 64 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_Agent_Features_Unique := make(map[string]struct{}, len(m.GetFeatures()))
+
+	for idx, item := range m.GetFeatures() {
+		_, _ = idx, item
+
+		if _, exists := _Agent_Features_Unique[item]; exists {
+			err := AgentValidationError{
+				field:  fmt.Sprintf("Features[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_Agent_Features_Unique[item] = struct{}{}
+		}
 
-	if all {
-		switch v := interface{}(m.GetKcInfo()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SystemInfoValidationError{
-					field:  "KcInfo",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if utf8.RuneCountInString(item) > 64 {
+			err := AgentValidationError{
+				field:  fmt.Sprintf("Features[%v]", idx),
+				reason: "value length must be at most 64 runes",
 			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SystemInfoValidationError{
-					field:  "KcInfo",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
-	} else if v, ok := interface{}(m.GetKcInfo()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SystemInfoValidationError{
-				field:  "KcInfo",
-				reason: "embedded message failed validation",
-				cause:  err,
+
+		if !_Agent_Features_Pattern.MatchString(item) {
+			err := AgentValidationError{
+				field:  fmt.Sprintf("Features[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-z0-9][a-z0-9._-]*$\"",
+			}
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return SystemInfoMultiError(errors)
+		return AgentMultiError(errors)
 	}
 
 	return nil
 }
 
-// SystemInfoMultiError is an error wrapping multiple validation errors
-// returned by SystemInfo.ValidateAll() if the designated constraints aren't met.
-type SystemInfoMultiError []error
+// AgentMultiError is an error wrapping multiple validation errors
+// returned by Agent.ValidateAll() if the designated constraints aren't met.
+type AgentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SystemInfoMultiError) Error() string {
+func (m AgentMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -538,11 +969,11 @@ func (m SystemInfoMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SystemInfoMultiError) AllErrors() []error { return m }
+func (m AgentMultiError) AllErrors() []error { return m }
 
-// SystemInfoValidationError is the validation error returned by
-// SystemInfo.Validate if the designated constraints aren't met.
-type SystemInfoValidationError struct {
+// AgentValidationError is the validation error returned by
+// Agent.Validate if the designated constraints aren't met.
+type AgentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -550,22 +981,22 @@ type SystemInfoValidationError struct {
 }
 
 // Field function returns field value.
-func (e SystemInfoValidationError) Field() string { return e.field }
+func (e AgentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SystemInfoValidationError) Reason() string { return e.reason }
+func (e AgentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SystemInfoValidationError) Cause() error { return e.cause }
+func (e AgentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SystemInfoValidationError) Key() bool { return e.key }
+func (e AgentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SystemInfoValidationError) ErrorName() string { return "SystemInfoValidationError" }
+func (e AgentValidationError) ErrorName() string { return "AgentValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SystemInfoValidationError) Error() string {
+func (e AgentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -577,14 +1008,14 @@ func (e SystemInfoValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSystemInfo.%s: %s%s",
+		"invalid %sAgent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SystemInfoValidationError{}
+var _ error = AgentValidationError{}
 
 var _ interface {
 	Field() string
@@ -592,7 +1023,13 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SystemInfoValidationError{}
+} = AgentValidationError{}
+
+var _Agent_Name_Pattern = regexp.MustCompile("^[a-z0-9][a-z0-9._-]*$")
+
+var _Agent_Version_Pattern = regexp.MustCompile("^v?\\d+(\\.\\d+){0,2}([-+][0-9A-Za-z.+-]*)?$")
+
+var _Agent_Features_Pattern = regexp.MustCompile("^[a-z0-9][a-z0-9._-]*$")
 
 // Validate checks the field values on ClusterInfo with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
@@ -1836,6 +2273,21 @@ func (m *HWInfo) validate(all bool) error {
 
 	}
 
+	if m.GetTpmEkHash() != "" {
+
+		if !_HWInfo_TpmEkHash_Pattern.MatchString(m.GetTpmEkHash()) {
+			err := HWInfoValidationError{
+				field:  "TpmEkHash",
+				reason: "value does not match regex pattern \"^[0-9a-fA-F]{64}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return HWInfoMultiError(errors)
 	}
@@ -1913,6 +2365,8 @@ var _ interface {
 	ErrorName() string
 } = HWInfoValidationError{}
 
+var _HWInfo_TpmEkHash_Pattern = regexp.MustCompile("^[0-9a-fA-F]{64}$")
+
 // Validate checks the field values on SystemCPU with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -3360,6 +3814,61 @@ func (m *SystemPCI) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if utf8.RuneCountInString(m.GetVendorId()) > 128 {
+		err := SystemPCIValidationError{
+			field:  "VendorId",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDeviceId()) > 128 {
+		err := SystemPCIValidationError{
+			field:  "DeviceId",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSlot()) > 128 {
+		err := SystemPCIValidationError{
+			field:  "Slot",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDriver()) > 128 {
+		err := SystemPCIValidationError{
+			field:  "Driver",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetNumaNode() < -1 {
+		err := SystemPCIValidationError{
+			field:  "NumaNode",
+			reason: "value must be greater than or equal to -1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return SystemPCIMultiError(errors)
 	}
@@ -4096,6 +4605,10 @@ func (m *UpdateHostStatusByHostGuidRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for SequenceNumber
+
+	// no validation rules for AgentTimestampNs
+
 	if len(errors) > 0 {
 		return UpdateHostStatusByHostGuidRequestMultiError(errors)
 	}
@@ -4186,6 +4699,183 @@ var _ interface {
 	ErrorName() string
 } = UpdateHostStatusByHostGuidRequestValidationError{}
 
+// Validate checks the field values on HostSessionRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HostSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HostSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// HostSessionRequestMultiError, or nil if none found.
+func (m *HostSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HostSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetHostGuid()) < 1 {
+		err := HostSessionRequestValidationError{
+			field:  "HostGuid",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetHostGuid()) > 36 {
+		err := HostSessionRequestValidationError{
+			field:  "HostGuid",
+			reason: "value length must be at most 36 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetHostGuid()); err != nil {
+		err = HostSessionRequestValidationError{
+			field:  "HostGuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetHostStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HostSessionRequestValidationError{
+					field:  "HostStatus",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HostSessionRequestValidationError{
+					field:  "HostStatus",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHostStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HostSessionRequestValidationError{
+				field:  "HostStatus",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AgentTimestampNs
+
+	// no validation rules for SequenceNumber
+
+	if len(errors) > 0 {
+		return HostSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *HostSessionRequest) _validateUuid(uuid string) error {
+	if matched := _hostmgr_southbound_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// HostSessionRequestMultiError is an error wrapping multiple validation errors
+// returned by HostSessionRequest.ValidateAll() if the designated constraints
+// aren't met.
+type HostSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HostSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HostSessionRequestMultiError) AllErrors() []error { return m }
+
+// HostSessionRequestValidationError is the validation error returned by
+// HostSessionRequest.Validate if the designated constraints aren't met.
+type HostSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HostSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HostSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HostSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HostSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HostSessionRequestValidationError) ErrorName() string {
+	return "HostSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HostSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHostSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HostSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HostSessionRequestValidationError{}
+
 // Validate checks the field values on UpdateHostSystemInfoByGUIDRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the first error encountered is returned, or nil if there are
@@ -4531,6 +5221,10 @@ func (m *UpdateInstanceStateStatusByHostGUIDRequest) validate(all bool) error {
 
 	// no validation rules for ProviderStatusDetail
 
+	// no validation rules for SequenceNumber
+
+	// no validation rules for AgentTimestampNs
+
 	if len(errors) > 0 {
 		return UpdateInstanceStateStatusByHostGUIDRequestMultiError(errors)
 	}
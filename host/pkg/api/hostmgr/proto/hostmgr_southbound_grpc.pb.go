@@ -28,6 +28,11 @@ type HostmgrClient interface {
 	UpdateInstanceStateStatusByHostGUID(ctx context.Context, in *UpdateInstanceStateStatusByHostGUIDRequest, opts ...grpc.CallOption) (*UpdateInstanceStateStatusByHostGUIDResponse, error)
 	// This call will update the Host System info
 	UpdateHostSystemInfoByGUID(ctx context.Context, in *UpdateHostSystemInfoByGUIDRequest, opts ...grpc.CallOption) (*UpdateHostSystemInfoByGUIDResponse, error)
+	// Long-lived session opened by the agent. The agent streams heartbeats and status updates,
+	// Host Manager pushes the actions derived from the Host's desired state in Inventory.
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	HostSession(ctx context.Context, opts ...grpc.CallOption) (Hostmgr_HostSessionClient, error)
 }
 
 type hostmgrClient struct {
@@ -65,6 +70,37 @@ func (c *hostmgrClient) UpdateHostSystemInfoByGUID(ctx context.Context, in *Upda
 	return out, nil
 }
 
+func (c *hostmgrClient) HostSession(ctx context.Context, opts ...grpc.CallOption) (Hostmgr_HostSessionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Hostmgr_ServiceDesc.Streams[0], "/hostmgr_southbound_proto.Hostmgr/HostSession", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &hostmgrHostSessionClient{stream}
+	return x, nil
+}
+
+type Hostmgr_HostSessionClient interface {
+	Send(*HostSessionRequest) error
+	Recv() (*HostStatusResp, error)
+	grpc.ClientStream
+}
+
+type hostmgrHostSessionClient struct {
+	grpc.ClientStream
+}
+
+func (x *hostmgrHostSessionClient) Send(m *HostSessionRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *hostmgrHostSessionClient) Recv() (*HostStatusResp, error) {
+	m := new(HostStatusResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // HostmgrServer is the server API for Hostmgr service.
 // All implementations should embed UnimplementedHostmgrServer
 // for forward compatibility
@@ -75,6 +111,11 @@ type HostmgrServer interface {
 	UpdateInstanceStateStatusByHostGUID(context.Context, *UpdateInstanceStateStatusByHostGUIDRequest) (*UpdateInstanceStateStatusByHostGUIDResponse, error)
 	// This call will update the Host System info
 	UpdateHostSystemInfoByGUID(context.Context, *UpdateHostSystemInfoByGUIDRequest) (*UpdateHostSystemInfoByGUIDResponse, error)
+	// Long-lived session opened by the agent. The agent streams heartbeats and status updates,
+	// Host Manager pushes the actions derived from the Host's desired state in Inventory.
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	HostSession(Hostmgr_HostSessionServer) error
 }
 
 // UnimplementedHostmgrServer should be embedded to have forward compatible implementations.
@@ -90,6 +131,9 @@ func (UnimplementedHostmgrServer) UpdateInstanceStateStatusByHostGUID(context.Co
 func (UnimplementedHostmgrServer) UpdateHostSystemInfoByGUID(context.Context, *UpdateHostSystemInfoByGUIDRequest) (*UpdateHostSystemInfoByGUIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateHostSystemInfoByGUID not implemented")
 }
+func (UnimplementedHostmgrServer) HostSession(Hostmgr_HostSessionServer) error {
+	return status.Errorf(codes.Unimplemented, "method HostSession not implemented")
+}
 
 // UnsafeHostmgrServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to HostmgrServer will
@@ -156,6 +200,32 @@ func _Hostmgr_UpdateHostSystemInfoByGUID_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Hostmgr_HostSession_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(HostmgrServer).HostSession(&hostmgrHostSessionServer{stream})
+}
+
+type Hostmgr_HostSessionServer interface {
+	Send(*HostStatusResp) error
+	Recv() (*HostSessionRequest, error)
+	grpc.ServerStream
+}
+
+type hostmgrHostSessionServer struct {
+	grpc.ServerStream
+}
+
+func (x *hostmgrHostSessionServer) Send(m *HostStatusResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *hostmgrHostSessionServer) Recv() (*HostSessionRequest, error) {
+	m := new(HostSessionRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Hostmgr_ServiceDesc is the grpc.ServiceDesc for Hostmgr service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -176,6 +246,13 @@ var Hostmgr_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Hostmgr_UpdateHostSystemInfoByGUID_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "HostSession",
+			Handler:       _Hostmgr_HostSession_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "hostmgr/proto/hostmgr_southbound.proto",
 }
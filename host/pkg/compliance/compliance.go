@@ -0,0 +1,323 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package compliance checks the hardware reported by the hosts against the expected hardware of their SKU
+// or site, declared in compliance profiles, to detect the units delivered or ending up with the wrong hardware.
+//
+// The profiles are loaded from a YAML file:
+//
+//	profiles:
+//	  - name: edge-sku-a
+//	    productNames: ["NUC13ANHi7"]
+//	    cpu:
+//	      model: 13th Gen Intel(R) Core(TM) i7-1360P
+//	      minCores: 12
+//	    memory:
+//	      minGiB: 32
+//	    disks:
+//	      minCount: 1
+//	      minSizeGB: 480
+//	    nics:
+//	      - model: "8086:125c"
+//	        count: 1
+//	    gpus:
+//	      - model: Iris Xe Graphics
+package compliance
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"gopkg.in/yaml.v3"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/pcidevice"
+)
+
+// Keys of the Host metadata recording the compliance of the host with its profile.
+const (
+	// StatusMetadataKey is the key of the compliance status, StatusCompliant or StatusNonCompliant.
+	StatusMetadataKey = "compliance-status"
+	// ProfileMetadataKey is the key of the name of the profile of the host.
+	ProfileMetadataKey = "compliance-profile"
+	// ViolationsMetadataKey is the key of the violations of the profile, if any.
+	ViolationsMetadataKey = "compliance-violations"
+)
+
+// Compliance statuses of the hosts.
+const (
+	StatusCompliant    = "compliant"
+	StatusNonCompliant = "non-compliant"
+)
+
+// MetadataKeys are the keys of the Host metadata set by Metadata.
+var MetadataKeys = []string{StatusMetadataKey, ProfileMetadataKey, ViolationsMetadataKey}
+
+const (
+	bytesPerGiB         = 1 << 30
+	bytesPerGB          = 1e9
+	violationsSeparator = "; "
+)
+
+var (
+	defaultProfilesMu sync.RWMutex
+	defaultProfiles   = &Profiles{}
+)
+
+// CPU is the expected CPU of a profile.
+type CPU struct {
+	// Model is the expected model, ignoring the case
+	Model    string `yaml:"model,omitempty"`
+	MinCores uint32 `yaml:"minCores,omitempty"`
+}
+
+// Memory is the expected memory of a profile.
+type Memory struct {
+	// MinGiB is the minimum memory size, compared to the reported size rounded to the nearest GiB
+	MinGiB uint64 `yaml:"minGiB,omitempty"`
+}
+
+// Disks are the expected disks of a profile.
+type Disks struct {
+	// MinCount is the minimum number of disks of at least MinSizeGB
+	MinCount int `yaml:"minCount,omitempty"`
+	// MinSizeGB is the minimum size of the disks in GB (10^9 bytes), as sold
+	MinSizeGB uint64 `yaml:"minSizeGB,omitempty"`
+}
+
+// Device is an expected NIC or GPU model of a profile.
+type Device struct {
+	// Model is the PCI vendor:device ID of a NIC (e.g. 8086:125c), or the product of a GPU, ignoring the case
+	Model string `yaml:"model"`
+	// Count is the minimum number of devices of the model, 1 if not set
+	Count int `yaml:"count,omitempty"`
+}
+
+// Profile declares the expected hardware of the hosts of a product or of a site.
+type Profile struct {
+	Name string `yaml:"name"`
+	// ProductNames are the product names of the hosts matching the profile, any if empty
+	ProductNames []string `yaml:"productNames,omitempty"`
+	// Sites are the site resource IDs of the hosts matching the profile, any if empty
+	Sites  []string `yaml:"sites,omitempty"`
+	CPU    *CPU     `yaml:"cpu,omitempty"`
+	Memory *Memory  `yaml:"memory,omitempty"`
+	Disks  *Disks   `yaml:"disks,omitempty"`
+	NICs   []Device `yaml:"nics,omitempty"`
+	GPUs   []Device `yaml:"gpus,omitempty"`
+}
+
+// Profiles is the set of compliance profiles.
+type Profiles struct {
+	Profiles []Profile `yaml:"profiles"`
+}
+
+// ParseProfiles parses and validates YAML compliance profiles.
+func ParseProfiles(data []byte) (*Profiles, error) {
+	var profiles Profiles
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&profiles); err != nil {
+		return nil, errors.Errorfc(codes.InvalidArgument, "invalid compliance profiles: %v", err)
+	}
+	if err := profiles.Validate(); err != nil {
+		return nil, err
+	}
+	return &profiles, nil
+}
+
+// LoadProfiles loads YAML compliance profiles from a file, or returns no profile if path is empty.
+func LoadProfiles(path string) (*Profiles, error) {
+	if path == "" {
+		return &Profiles{}, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Errorfc(codes.InvalidArgument, "cannot read the compliance profiles %s: %v", path, err)
+	}
+	return ParseProfiles(data)
+}
+
+// Default returns the compliance profiles of the Host Manager, no profile until it is configured with SetDefault.
+func Default() *Profiles {
+	defaultProfilesMu.RLock()
+	defer defaultProfilesMu.RUnlock()
+	return defaultProfiles
+}
+
+// SetDefault replaces the compliance profiles of the Host Manager.
+func SetDefault(profiles *Profiles) {
+	defaultProfilesMu.Lock()
+	defer defaultProfilesMu.Unlock()
+	defaultProfiles = profiles
+}
+
+// Validate checks that the profiles have distinct names and match some hosts.
+func (p *Profiles) Validate() error {
+	names := make(map[string]struct{}, len(p.Profiles))
+	for _, profile := range p.Profiles {
+		if profile.Name == "" {
+			return errors.Errorfc(codes.InvalidArgument, "compliance profile without name")
+		}
+		if _, ok := names[profile.Name]; ok {
+			return errors.Errorfc(codes.InvalidArgument, "duplicated compliance profile %q", profile.Name)
+		}
+		names[profile.Name] = struct{}{}
+		if len(profile.ProductNames) == 0 && len(profile.Sites) == 0 {
+			return errors.Errorfc(codes.InvalidArgument,
+				"compliance profile %q matches neither a product name nor a site", profile.Name)
+		}
+		for _, device := range slices.Concat(profile.NICs, profile.GPUs) {
+			if device.Model == "" || device.Count < 0 {
+				return errors.Errorfc(codes.InvalidArgument, "invalid device %+v of compliance profile %q",
+					device, profile.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// Match returns the profile of a host with the given product name and site, or nil if none matches.
+// A profile matching both the product name and the site is preferred to a profile matching only the site,
+// itself preferred to a profile matching only the product name. Ties are broken by the order of the profiles.
+func (p *Profiles) Match(productName, siteID string) *Profile {
+	var matched *Profile
+	best := 0
+	for i := range p.Profiles {
+		profile := &p.Profiles[i]
+		score := 0
+		switch {
+		case len(profile.Sites) == 0:
+		case siteID != "" && slices.Contains(profile.Sites, siteID):
+			score += 2
+		default:
+			continue
+		}
+		switch {
+		case len(profile.ProductNames) == 0:
+		case productName != "" && slices.ContainsFunc(profile.ProductNames, func(name string) bool {
+			return strings.EqualFold(name, productName)
+		}):
+			score++
+		default:
+			continue
+		}
+		if score > best {
+			matched, best = profile, score
+		}
+	}
+	return matched
+}
+
+// Evaluate returns the violations of the profile by the reported hardware, sorted, or nil if the host complies.
+func (p *Profile) Evaluate(hwInfo *pb.HWInfo) []string {
+	var violations []string
+	violations = append(violations, p.CPU.evaluate(hwInfo.GetCpu())...)
+	if p.Memory != nil {
+		gib := uint64(math.Round(float64(hwInfo.GetMemory().GetSize()) / bytesPerGiB))
+		if gib < p.Memory.MinGiB {
+			violations = append(violations, fmt.Sprintf("memory: expected at least %d GiB, found %d GiB",
+				p.Memory.MinGiB, gib))
+		}
+	}
+	violations = append(violations, p.Disks.evaluate(hwInfo.GetStorage().GetDisk())...)
+	violations = append(violations, evaluateDevices("nic", p.NICs, nicModels(hwInfo))...)
+	violations = append(violations, evaluateDevices("gpu", p.GPUs, gpuModels(hwInfo))...)
+	slices.Sort(violations)
+	return violations
+}
+
+func (c *CPU) evaluate(cpu *pb.SystemCPU) []string {
+	if c == nil {
+		return nil
+	}
+	var violations []string
+	if c.Model != "" && !strings.EqualFold(strings.TrimSpace(cpu.GetModel()), c.Model) {
+		violations = append(violations, fmt.Sprintf("cpu model: expected %s, found %s", c.Model, cpu.GetModel()))
+	}
+	if cpu.GetCores() < c.MinCores {
+		violations = append(violations, fmt.Sprintf("cpu cores: expected at least %d, found %d",
+			c.MinCores, cpu.GetCores()))
+	}
+	return violations
+}
+
+func (d *Disks) evaluate(disks []*pb.SystemDisk) []string {
+	if d == nil {
+		return nil
+	}
+	count := 0
+	for _, disk := range disks {
+		if float64(disk.GetSize())/bytesPerGB >= float64(d.MinSizeGB) {
+			count++
+		}
+	}
+	if count >= d.MinCount {
+		return nil
+	}
+	return []string{fmt.Sprintf("disks: expected at least %d of at least %d GB, found %d", d.MinCount, d.MinSizeGB, count)}
+}
+
+// nicModels returns the PCI vendor:device IDs of the NICs, found from their PCI address in the PCI devices.
+func nicModels(hwInfo *pb.HWInfo) []string {
+	pciModels := make(map[string]string, len(hwInfo.GetPci()))
+	for _, pci := range hwInfo.GetPci() {
+		pciModels[pci.GetSlot()] = pcidevice.NormalizeID(pci.GetVendorId()) + ":" + pcidevice.NormalizeID(pci.GetDeviceId())
+	}
+	var models []string
+	for _, nic := range hwInfo.GetNetwork() {
+		if model, ok := pciModels[nic.GetPciId()]; ok {
+			models = append(models, model)
+		}
+	}
+	return models
+}
+
+func gpuModels(hwInfo *pb.HWInfo) []string {
+	var models []string
+	for _, gpu := range hwInfo.GetGpu() {
+		if gpu.GetProduct() != "" {
+			models = append(models, strings.TrimSpace(gpu.GetProduct()))
+		}
+	}
+	return models
+}
+
+func evaluateDevices(kind string, expected []Device, models []string) []string {
+	var violations []string
+	for _, device := range expected {
+		count := 0
+		for _, model := range models {
+			if strings.EqualFold(model, device.Model) {
+				count++
+			}
+		}
+		if minCount := max(device.Count, 1); count < minCount {
+			violations = append(violations, fmt.Sprintf("%s %s: expected at least %d, found %d",
+				kind, device.Model, minCount, count))
+		}
+	}
+	return violations
+}
+
+// Metadata returns the Host metadata recording the compliance of the host with the profile.
+// The violations key is set only if the host does not comply.
+func Metadata(profile *Profile, violations []string) map[string]string {
+	metadata := map[string]string{
+		StatusMetadataKey:  StatusCompliant,
+		ProfileMetadataKey: profile.Name,
+	}
+	if len(violations) > 0 {
+		metadata[StatusMetadataKey] = StatusNonCompliant
+		metadata[ViolationsMetadataKey] = strings.Join(violations, violationsSeparator)
+	}
+	return metadata
+}
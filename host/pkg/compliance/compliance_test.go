@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compliance_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/compliance"
+)
+
+const profiles = `
+profiles:
+  - name: sku-a
+    productNames: ["NUC13ANHi7"]
+    cpu:
+      model: 13th Gen Intel(R) Core(TM) i7-1360P
+      minCores: 12
+    memory:
+      minGiB: 32
+    disks:
+      minCount: 1
+      minSizeGB: 480
+    nics:
+      - model: "8086:125C"
+        count: 2
+    gpus:
+      - model: Iris Xe Graphics
+  - name: site-1
+    sites: ["site-1"]
+    memory:
+      minGiB: 16
+  - name: sku-a-site-1
+    productNames: ["NUC13ANHi7"]
+    sites: ["site-1"]
+`
+
+var hwInfo = &pb.HWInfo{
+	ProductName: "NUC13ANHi7",
+	Cpu:         &pb.SystemCPU{Model: "13th Gen Intel(R) Core(TM) i7-1360P", Cores: 12},
+	Memory:      &pb.SystemMemory{Size: 32 << 30},
+	Storage:     &pb.Storage{Disk: []*pb.SystemDisk{{Name: "nvme0n1", Size: 512_110_190_592}}},
+	Network: []*pb.SystemNetwork{
+		{Name: "eth0", PciId: "0000:57:00.0"},
+		{Name: "eth1", PciId: "0000:58:00.0"},
+		{Name: "wlan0"},
+	},
+	Pci: []*pb.SystemPCI{
+		{DevClass: "0200", VendorId: "8086", DeviceId: "125c", Slot: "0000:57:00.0"},
+		{DevClass: "0200", VendorId: "0x8086", DeviceId: "0x125C", Slot: "0000:58:00.0"},
+	},
+	Gpu: []*pb.SystemGPU{{PciId: "0000:00:02.0", Product: "Iris Xe Graphics"}},
+}
+
+func TestParseProfiles(t *testing.T) {
+	testCases := map[string]struct {
+		profiles string
+		valid    bool
+	}{
+		"Valid":          {profiles: profiles, valid: true},
+		"Empty":          {profiles: "profiles: []", valid: true},
+		"UnknownField":   {profiles: "profiles: [{name: a, sites: [s], cpu: {cores: 4}}]"},
+		"MissingName":    {profiles: "profiles: [{sites: [s]}]"},
+		"DuplicatedName": {profiles: "profiles: [{name: a, sites: [s]}, {name: a, productNames: [p]}]"},
+		"MatchesNothing": {profiles: "profiles: [{name: a, memory: {minGiB: 8}}]"},
+		"MissingModel":   {profiles: "profiles: [{name: a, sites: [s], nics: [{count: 1}]}]"},
+		"NegativeCount":  {profiles: "profiles: [{name: a, sites: [s], gpus: [{model: x, count: -1}]}]"},
+		"InvalidYAML":    {profiles: "profiles: [{"},
+	}
+	for tcName, tc := range testCases {
+		t.Run(tcName, func(t *testing.T) {
+			_, err := compliance.ParseProfiles([]byte(tc.profiles))
+			if !tc.valid {
+				require.Error(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	p, err := compliance.ParseProfiles([]byte(profiles))
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		productName, siteID string
+		expected            string
+	}{
+		"ProductAndSite": {productName: "nuc13anhi7", siteID: "site-1", expected: "sku-a-site-1"},
+		"SiteOnly":       {productName: "NUC12WSHi7", siteID: "site-1", expected: "site-1"},
+		"ProductOnly":    {productName: "NUC13ANHi7", siteID: "site-2", expected: "sku-a"},
+		"NoSite":         {productName: "NUC13ANHi7", expected: "sku-a"},
+		"None":           {productName: "NUC12WSHi7", siteID: "site-2"},
+	}
+	for tcName, tc := range testCases {
+		t.Run(tcName, func(t *testing.T) {
+			profile := p.Match(tc.productName, tc.siteID)
+			if tc.expected == "" {
+				assert.Nil(t, profile)
+				return
+			}
+			require.NotNil(t, profile)
+			assert.Equal(t, tc.expected, profile.Name)
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	p, err := compliance.ParseProfiles([]byte(profiles))
+	require.NoError(t, err)
+	profile := p.Match("NUC13ANHi7", "")
+	require.NotNil(t, profile)
+	assert.Empty(t, profile.Evaluate(hwInfo))
+
+	assert.Equal(t, []string{
+		"cpu cores: expected at least 12, found 0",
+		"cpu model: expected 13th Gen Intel(R) Core(TM) i7-1360P, found ",
+		"disks: expected at least 1 of at least 480 GB, found 0",
+		"gpu Iris Xe Graphics: expected at least 1, found 0",
+		"memory: expected at least 32 GiB, found 0 GiB",
+		"nic 8086:125C: expected at least 2, found 0",
+	}, profile.Evaluate(&pb.HWInfo{}))
+
+	downgraded := &pb.HWInfo{
+		Cpu:     &pb.SystemCPU{Model: "13th gen intel(r) core(tm) i7-1360p", Cores: 8},
+		Memory:  &pb.SystemMemory{Size: 16 << 30},
+		Storage: &pb.Storage{Disk: []*pb.SystemDisk{{Name: "sda", Size: 256_060_514_304}}},
+		Network: hwInfo.GetNetwork()[:1],
+		Pci:     hwInfo.GetPci(),
+		Gpu:     hwInfo.GetGpu(),
+	}
+	assert.Equal(t, []string{
+		"cpu cores: expected at least 12, found 8",
+		"disks: expected at least 1 of at least 480 GB, found 0",
+		"memory: expected at least 32 GiB, found 16 GiB",
+		"nic 8086:125C: expected at least 2, found 1",
+	}, profile.Evaluate(downgraded))
+}
+
+func TestLoadProfiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "profiles.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(profiles), 0o600))
+	p, err := compliance.LoadProfiles(path)
+	require.NoError(t, err)
+	assert.Len(t, p.Profiles, 3)
+
+	_, err = compliance.LoadProfiles(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Error(t, err)
+
+	// Without file, there is no profile
+	p, err = compliance.LoadProfiles("")
+	require.NoError(t, err)
+	assert.Empty(t, p.Profiles)
+}
+
+func TestMetadata(t *testing.T) {
+	profile := &compliance.Profile{Name: "sku-a"}
+	assert.Equal(t, map[string]string{
+		compliance.StatusMetadataKey:  compliance.StatusCompliant,
+		compliance.ProfileMetadataKey: "sku-a",
+	}, compliance.Metadata(profile, nil))
+	violations := []string{
+		"cpu cores: expected at least 12, found 8",
+		"memory: expected at least 32 GiB, found 16 GiB",
+	}
+	assert.Equal(t, map[string]string{
+		compliance.StatusMetadataKey:     compliance.StatusNonCompliant,
+		compliance.ProfileMetadataKey:    "sku-a",
+		compliance.ViolationsMetadataKey: violations[0] + "; " + violations[1],
+	}, compliance.Metadata(profile, violations))
+}
@@ -12,6 +12,7 @@ import (
 	"google.golang.org/grpc/codes"
 
 	inv_errors "github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
 )
 
 // HostMgrConfig contains configuration for the host manager..
@@ -25,10 +26,36 @@ type HostMgrConfig struct {
 	TLSCertPath          string
 	InsecureGRPC         bool
 	EnableHostDiscovery  bool
+	HostDiscoveryRate    float64
+	HostDiscoveryBurst   int
 	DisabledProvisioning bool
 	EnableUUIDCache      bool
 	UUIDCacheTTL         time.Duration
 	UUIDCacheTTLOffset   int
+	// SystemInfoApplyParallelism is the number of concurrent Inventory calls, the default one if zero
+	SystemInfoApplyParallelism int
+	SystemInfoDryRun           bool
+	EnforceStatusTransitions   bool
+	// MinAgentVersions is a comma separated list of agent=version pairs
+	MinAgentVersions string
+	// IdentityMismatchPolicy is reject or quarantine, reject if empty
+	IdentityMismatchPolicy string
+	// ClockSkewThreshold is the clock skew above which the running hosts are degraded, disabled if zero
+	ClockSkewThreshold time.Duration
+	// DisableCredentialsManagement keeps the secrets reported by the hosts in memory instead of Vault, for testing only
+	DisableCredentialsManagement bool
+	// HostStateDir is the directory of the state tracked for the hosts, kept in memory if empty
+	HostStateDir string
+	// PCIDevicesDir is the directory of the PCI devices reported by the hosts, kept in memory if empty
+	PCIDevicesDir string
+	// HwJournalDir is the directory of the hardware change journal, kept in memory if empty
+	HwJournalDir string
+	// HwJournalMaxEntries is the number of hardware change journal entries kept per host, the default one if zero
+	HwJournalMaxEntries int
+	// LabelRulesPath is the path of the label rules, the built-in rules are used if empty
+	LabelRulesPath string
+	// ComplianceProfilesPath is the path of the compliance profiles, the compliance is not evaluated if empty
+	ComplianceProfilesPath string
 }
 
 // Validate checks if the configuration is valid.
@@ -51,5 +78,33 @@ func (c HostMgrConfig) Validate() error {
 		}
 	}
 
+	if c.EnableHostDiscovery && (c.HostDiscoveryRate < 0 || c.HostDiscoveryBurst < 1) {
+		return inv_errors.Errorfc(codes.InvalidArgument,
+			"invalid host discovery rate limit: rate %f, burst %d", c.HostDiscoveryRate, c.HostDiscoveryBurst)
+	}
+
+	if c.SystemInfoApplyParallelism < 0 {
+		return inv_errors.Errorfc(codes.InvalidArgument,
+			"invalid system information apply parallelism: %d", c.SystemInfoApplyParallelism)
+	}
+
+	if c.HwJournalMaxEntries < 0 {
+		return inv_errors.Errorfc(codes.InvalidArgument,
+			"invalid hardware journal size: %d", c.HwJournalMaxEntries)
+	}
+
+	if c.ClockSkewThreshold < 0 {
+		return inv_errors.Errorfc(codes.InvalidArgument,
+			"invalid clock skew threshold: %s", c.ClockSkewThreshold)
+	}
+
+	if _, err := hmgr_util.ParseMinAgentVersions(c.MinAgentVersions); err != nil {
+		return err
+	}
+
+	if _, err := hmgr_util.ParseIdentityMismatchPolicy(c.IdentityMismatchPolicy); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -6,6 +6,7 @@ package config_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -23,6 +24,14 @@ func TestHostMgrConfig_Validate(t *testing.T) {
 		TLSCertPath         string
 		InsecureGRPC        bool
 		EnableHostDiscovery bool
+		HostDiscoveryRate   float64
+		HostDiscoveryBurst  int
+
+		SystemInfoApplyParallelism int
+		MinAgentVersions           string
+		IdentityMismatchPolicy     string
+		ClockSkewThreshold         time.Duration
+		HwJournalMaxEntries        int
 	}
 	tests := []struct {
 		name       string
@@ -71,6 +80,135 @@ func TestHostMgrConfig_Validate(t *testing.T) {
 			wantErr:    true,
 			grpcStatus: codes.InvalidArgument,
 		},
+		{
+			name: "Success_HostDiscovery",
+			fields: fields{
+				InventoryAddr:       "localhost:50001",
+				InsecureGRPC:        true,
+				EnableHostDiscovery: true,
+				HostDiscoveryRate:   10,
+				HostDiscoveryBurst:  5,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Failed_HostDiscoveryNegativeRate",
+			fields: fields{
+				InventoryAddr:       "localhost:50001",
+				InsecureGRPC:        true,
+				EnableHostDiscovery: true,
+				HostDiscoveryRate:   -1,
+				HostDiscoveryBurst:  5,
+			},
+			wantErr:    true,
+			grpcStatus: codes.InvalidArgument,
+		},
+		{
+			name: "Failed_HostDiscoveryNoBurst",
+			fields: fields{
+				InventoryAddr:       "localhost:50001",
+				InsecureGRPC:        true,
+				EnableHostDiscovery: true,
+				HostDiscoveryRate:   10,
+			},
+			wantErr:    true,
+			grpcStatus: codes.InvalidArgument,
+		},
+		{
+			name: "Success_SystemInfoApplyParallelism",
+			fields: fields{
+				InventoryAddr:              "localhost:50001",
+				InsecureGRPC:               true,
+				SystemInfoApplyParallelism: 16,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Failed_NegativeSystemInfoApplyParallelism",
+			fields: fields{
+				InventoryAddr:              "localhost:50001",
+				InsecureGRPC:               true,
+				SystemInfoApplyParallelism: -1,
+			},
+			wantErr:    true,
+			grpcStatus: codes.InvalidArgument,
+		},
+		{
+			name: "Success_MinAgentVersions",
+			fields: fields{
+				InventoryAddr:    "localhost:50001",
+				InsecureGRPC:     true,
+				MinAgentVersions: "node-agent=1.2.0,platform-update-agent=v1.0",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Failed_InvalidMinAgentVersions",
+			fields: fields{
+				InventoryAddr:    "localhost:50001",
+				InsecureGRPC:     true,
+				MinAgentVersions: "node-agent=latest",
+			},
+			wantErr:    true,
+			grpcStatus: codes.InvalidArgument,
+		},
+		{
+			name: "Success_QuarantineIdentityMismatch",
+			fields: fields{
+				InventoryAddr:          "localhost:50001",
+				InsecureGRPC:           true,
+				IdentityMismatchPolicy: "quarantine",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Failed_InvalidIdentityMismatchPolicy",
+			fields: fields{
+				InventoryAddr:          "localhost:50001",
+				InsecureGRPC:           true,
+				IdentityMismatchPolicy: "accept",
+			},
+			wantErr:    true,
+			grpcStatus: codes.InvalidArgument,
+		},
+		{
+			name: "Success_DisabledClockSkewThreshold",
+			fields: fields{
+				InventoryAddr:      "localhost:50001",
+				InsecureGRPC:       true,
+				ClockSkewThreshold: 0,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Failed_NegativeClockSkewThreshold",
+			fields: fields{
+				InventoryAddr:      "localhost:50001",
+				InsecureGRPC:       true,
+				ClockSkewThreshold: -time.Minute,
+			},
+			wantErr:    true,
+			grpcStatus: codes.InvalidArgument,
+		},
+		{
+			name: "Success_DefaultHwJournalMaxEntries",
+			fields: fields{
+				InventoryAddr:       "localhost:50001",
+				InsecureGRPC:        true,
+				HwJournalMaxEntries: 0,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Failed_NegativeHwJournalMaxEntries",
+			fields: fields{
+				InventoryAddr:       "localhost:50001",
+				InsecureGRPC:        true,
+				HwJournalMaxEntries: -1,
+			},
+			wantErr:    true,
+			grpcStatus: codes.InvalidArgument,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -82,6 +220,14 @@ func TestHostMgrConfig_Validate(t *testing.T) {
 				TLSCertPath:         tt.fields.TLSCertPath,
 				InsecureGRPC:        tt.fields.InsecureGRPC,
 				EnableHostDiscovery: tt.fields.EnableHostDiscovery,
+				HostDiscoveryRate:   tt.fields.HostDiscoveryRate,
+				HostDiscoveryBurst:  tt.fields.HostDiscoveryBurst,
+
+				SystemInfoApplyParallelism: tt.fields.SystemInfoApplyParallelism,
+				MinAgentVersions:           tt.fields.MinAgentVersions,
+				IdentityMismatchPolicy:     tt.fields.IdentityMismatchPolicy,
+				ClockSkewThreshold:         tt.fields.ClockSkewThreshold,
+				HwJournalMaxEntries:        tt.fields.HwJournalMaxEntries,
 			}
 			if err := c.Validate(); (err != nil) != tt.wantErr {
 				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
@@ -31,12 +31,22 @@ func Wrap(err error) error {
 		errors.As(err, &pb.SystemInfoMultiError{}),
 		errors.As(err, &pb.BiosInfoValidationError{}),
 		errors.As(err, &pb.BiosInfoMultiError{}),
+		errors.As(err, &pb.AgentInfoValidationError{}),
+		errors.As(err, &pb.AgentInfoMultiError{}),
+		errors.As(err, &pb.AgentValidationError{}),
+		errors.As(err, &pb.AgentMultiError{}),
 		errors.As(err, &pb.UpdateInstanceStateStatusByHostGUIDRequestMultiError{}),
 		errors.As(err, &pb.UpdateInstanceStateStatusByHostGUIDRequestValidationError{}),
 		errors.As(err, &pb.UpdateHostStatusByHostGuidRequestMultiError{}),
 		errors.As(err, &pb.UpdateHostStatusByHostGuidRequestValidationError{}),
 		errors.As(err, &pb.UpdateHostSystemInfoByGUIDRequestMultiError{}),
-		errors.As(err, &pb.UpdateHostSystemInfoByGUIDRequestValidationError{}):
+		errors.As(err, &pb.UpdateHostSystemInfoByGUIDRequestValidationError{}),
+		errors.As(err, &pb.ExpireHostRequestMultiError{}),
+		errors.As(err, &pb.ExpireHostRequestValidationError{}),
+		errors.As(err, &pb.ForgetHostRequestMultiError{}),
+		errors.As(err, &pb.ForgetHostRequestValidationError{}),
+		errors.As(err, &pb.TimeoutSettingsMultiError{}),
+		errors.As(err, &pb.TimeoutSettingsValidationError{}):
 		return inv_errors.Errorfc(codes.InvalidArgument, "%s", err.Error())
 	}
 	return inv_errors.Wrap(err)
@@ -0,0 +1,252 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package hostcache keeps a local copy of the Hosts read by the southbound handlers, so that
+// the frequent heartbeats do not read the Host from Inventory every time.
+//
+// The cache is read-through: a missing Host is loaded from Inventory and kept, until the Host
+// is changed in Inventory (events), by the Host Manager itself or until it is older than the TTL.
+// The TTL bounds the staleness of a Host in case of missed events.
+package hostcache
+
+import (
+	"flag"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
+	hrm_metrics "github.com/open-edge-platform/infra-managers/host/pkg/metrics"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+var zlog = logging.GetLogger("HostManagerHostCache")
+
+const defaultHostCacheTTL = 5 * time.Minute
+
+var (
+	hostCacheTTL = flag.Duration(
+		"hostCacheTTL",
+		defaultHostCacheTTL,
+		"Flag to set how long a Host is served from the local cache before being read again from Inventory, "+
+			"0 disables the cache.",
+	)
+	onceTTL sync.Once
+)
+
+var cache = newHostCache()
+
+// hostKey identifies a Host by its GUID, GUIDs are case-insensitive.
+type hostKey struct {
+	tenantID string
+	guid     string
+}
+
+func newHostKey(tenantID, guid string) hostKey {
+	return hostKey{tenantID: tenantID, guid: strings.ToLower(guid)}
+}
+
+type entry struct {
+	host     *computev1.HostResource
+	loadedAt time.Time
+}
+
+type hostCache struct {
+	lock  sync.Mutex
+	ttl   time.Duration
+	hosts map[hostKey]*entry
+	// byResourceID indexes the cached Hosts by resource ID, the events only carry the resource ID
+	byResourceID map[util.TenantIDResourceIDTuple]hostKey
+	// clock is incremented by every invalidation, a Host invalidated after its load started may be outdated
+	// and it is not cached
+	clock uint64
+	// invalidated is the clock of the last invalidation of each Host, only kept while older loads are in progress
+	invalidated map[util.TenantIDResourceIDTuple]uint64
+	// loading counts the loads in progress by the clock they started at
+	loading map[uint64]int
+	// resetAt is the clock of the last invalidation of all the Hosts
+	resetAt uint64
+}
+
+func newHostCache() *hostCache {
+	return &hostCache{
+		hosts:        make(map[hostKey]*entry),
+		byResourceID: make(map[util.TenantIDResourceIDTuple]hostKey),
+		invalidated:  make(map[util.TenantIDResourceIDTuple]uint64),
+		loading:      make(map[uint64]int),
+	}
+}
+
+func loadTTL() {
+	onceTTL.Do(func() {
+		cache.lock.Lock()
+		defer cache.lock.Unlock()
+		cache.ttl = *hostCacheTTL
+	})
+}
+
+// SetTTL sets how long a Host is served from the cache, overriding the flag. A TTL of 0 disables the cache.
+func SetTTL(ttl time.Duration) {
+	loadTTL()
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	cache.ttl = ttl
+	if ttl <= 0 {
+		cache.clear()
+	}
+}
+
+// Get returns the Host with the given GUID from the cache. If the Host is not cached, or is too old,
+// it is loaded with the given function and cached. The returned Host is a copy, it can be modified.
+func Get(tenantID, guid string, load func() (*computev1.HostResource, error)) (*computev1.HostResource, error) {
+	loadTTL()
+	key := newHostKey(tenantID, guid)
+
+	host, start, enabled := cache.lookup(key)
+	if host != nil {
+		hrm_metrics.HostCacheLookups.WithLabelValues("hit").Inc()
+		return host, nil
+	}
+	if !enabled {
+		return load()
+	}
+	hrm_metrics.HostCacheLookups.WithLabelValues("miss").Inc()
+
+	host, err := load()
+	if err != nil {
+		cache.endLoad(start)
+		return nil, err
+	}
+	cache.store(key, host, start)
+	return host, nil
+}
+
+// Invalidate drops the Host from the cache, it is loaded again on the next Get.
+func Invalidate(hostID util.TenantIDResourceIDTuple) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	if len(cache.loading) > 0 {
+		cache.clock++
+		cache.invalidated[hostID] = cache.clock
+	}
+	if key, ok := cache.byResourceID[hostID]; ok {
+		zlog.Debug().Msgf("Host %s has been invalidated in the cache", hostID)
+		cache.remove(key)
+	}
+}
+
+// Sync drops the cached Hosts that are not in the given list, e.g. the deleted and untrusted Hosts.
+func Sync(hostIDs []util.TenantIDResourceIDTuple) {
+	desired := make(map[util.TenantIDResourceIDTuple]struct{}, len(hostIDs))
+	for _, hostID := range hostIDs {
+		desired[hostID] = struct{}{}
+	}
+
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	// The Hosts being loaded are not known, none of them is cached
+	cache.reset()
+	for hostID, key := range cache.byResourceID {
+		if _, ok := desired[hostID]; !ok {
+			zlog.Debug().Msgf("Host %s is not known anymore, removing it from the cache", hostID)
+			cache.remove(key)
+		}
+	}
+}
+
+// Len returns the number of cached Hosts.
+func Len() int {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	return len(cache.hosts)
+}
+
+// lookup returns a copy of the cached Host, if any and not expired, otherwise it starts a load of the Host and
+// returns the clock it started at, the load is ended by store or endLoad. Expired Hosts are dropped.
+func (c *hostCache) lookup(key hostKey) (host *computev1.HostResource, start uint64, enabled bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.ttl <= 0 {
+		return nil, 0, false
+	}
+	if e, ok := c.hosts[key]; ok {
+		if time.Since(e.loadedAt) < c.ttl {
+			return cloneHost(e.host), 0, true
+		}
+		c.remove(key)
+	}
+	c.loading[c.clock]++
+	return nil, c.clock, true
+}
+
+// store ends the load of the Host started at start and caches a copy of the Host, unless it has been
+// invalidated while being loaded.
+func (c *hostCache) store(key hostKey, host *computev1.HostResource, start uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	hostID := util.TenantIDResourceIDTuple{TenantID: key.tenantID, ResourceID: host.GetResourceId()}
+	outdated := c.resetAt > start || c.invalidated[hostID] > start
+	c.endLoadLocked(start)
+	if c.ttl <= 0 || outdated || host.GetResourceId() == "" {
+		return
+	}
+	c.remove(key)
+	c.hosts[key] = &entry{host: cloneHost(host), loadedAt: time.Now()}
+	c.byResourceID[hostID] = key
+}
+
+// endLoad ends the load started at start, without caching the Host.
+func (c *hostCache) endLoad(start uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.endLoadLocked(start)
+}
+
+// endLoadLocked ends the load started at start and forgets the invalidations that happened before
+// the loads still in progress.
+func (c *hostCache) endLoadLocked(start uint64) {
+	c.loading[start]--
+	if c.loading[start] <= 0 {
+		delete(c.loading, start)
+	}
+	oldest := c.clock
+	for loadStart := range c.loading {
+		oldest = min(oldest, loadStart)
+	}
+	for hostID, at := range c.invalidated {
+		if at <= oldest {
+			delete(c.invalidated, hostID)
+		}
+	}
+}
+
+// reset invalidates all the Hosts, including the ones being loaded.
+func (c *hostCache) reset() {
+	c.clock++
+	c.resetAt = c.clock
+}
+
+func (c *hostCache) remove(key hostKey) {
+	e, ok := c.hosts[key]
+	if !ok {
+		return
+	}
+	delete(c.hosts, key)
+	delete(c.byResourceID, util.TenantIDResourceIDTuple{TenantID: key.tenantID, ResourceID: e.host.GetResourceId()})
+}
+
+func (c *hostCache) clear() {
+	c.reset()
+	c.hosts = make(map[hostKey]*entry)
+	c.byResourceID = make(map[util.TenantIDResourceIDTuple]hostKey)
+}
+
+// cloneHost copies the Host, the cached Hosts are never shared with the callers.
+func cloneHost(host *computev1.HostResource) *computev1.HostResource {
+	clone, _ := proto.Clone(host).(*computev1.HostResource)
+	return clone
+}
@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostcache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inv_errors "github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hostcache"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+const (
+	tenant1 = "11111111-1111-1111-1111-111111111111"
+	tenant2 = "22222222-2222-2222-2222-222222222222"
+	guid1   = "bfd3b2a0-5a3d-4b6a-8d6a-0e1c2f3a4b5c"
+)
+
+// loader counts the Hosts loaded from Inventory.
+type loader struct {
+	host  *computev1.HostResource
+	err   error
+	loads int
+}
+
+func (l *loader) load() (*computev1.HostResource, error) {
+	l.loads++
+	return l.host, l.err
+}
+
+func newLoader(tenantID, resourceID string) *loader {
+	return &loader{host: &computev1.HostResource{TenantId: tenantID, ResourceId: resourceID, Uuid: guid1}}
+}
+
+func enableCache(t *testing.T, ttl time.Duration) {
+	t.Helper()
+	hostcache.SetTTL(ttl)
+	t.Cleanup(func() { hostcache.SetTTL(0) })
+}
+
+func TestGet(t *testing.T) {
+	enableCache(t, time.Minute)
+	l := newLoader(tenant1, "host-12345678")
+
+	host, err := hostcache.Get(tenant1, guid1, l.load)
+	require.NoError(t, err)
+	assert.Equal(t, "host-12345678", host.GetResourceId())
+	assert.Equal(t, 1, l.loads)
+
+	// Served from the cache, GUIDs are case-insensitive
+	host, err = hostcache.Get(tenant1, "BFD3B2A0-5A3D-4B6A-8D6A-0E1C2F3A4B5C", l.load)
+	require.NoError(t, err)
+	assert.Equal(t, "host-12345678", host.GetResourceId())
+	assert.Equal(t, 1, l.loads)
+	assert.Equal(t, 1, hostcache.Len())
+
+	// The cached Host cannot be modified by the callers
+	host.HostStatus = "Modified"
+	host, err = hostcache.Get(tenant1, guid1, l.load)
+	require.NoError(t, err)
+	assert.Empty(t, host.GetHostStatus())
+
+	// The same GUID in another tenant is another Host
+	l2 := newLoader(tenant2, "host-87654321")
+	host, err = hostcache.Get(tenant2, guid1, l2.load)
+	require.NoError(t, err)
+	assert.Equal(t, "host-87654321", host.GetResourceId())
+	assert.Equal(t, 1, l2.loads)
+	assert.Equal(t, 2, hostcache.Len())
+}
+
+func TestGet_Errors(t *testing.T) {
+	enableCache(t, time.Minute)
+	l := &loader{err: inv_errors.Errorfc(codes.NotFound, "host not found")}
+
+	// Errors are not cached
+	for i := 1; i <= 2; i++ {
+		_, err := hostcache.Get(tenant1, guid1, l.load)
+		require.Error(t, err)
+		assert.True(t, inv_errors.IsNotFound(err))
+		assert.Equal(t, i, l.loads)
+	}
+	assert.Zero(t, hostcache.Len())
+}
+
+func TestInvalidate(t *testing.T) {
+	enableCache(t, time.Minute)
+	l := newLoader(tenant1, "host-12345678")
+
+	_, err := hostcache.Get(tenant1, guid1, l.load)
+	require.NoError(t, err)
+
+	// Another Host, or the same resource ID in another tenant, does not invalidate the cached Host
+	hostcache.Invalidate(util.TenantIDResourceIDTuple{TenantID: tenant1, ResourceID: "host-87654321"})
+	hostcache.Invalidate(util.TenantIDResourceIDTuple{TenantID: tenant2, ResourceID: "host-12345678"})
+	_, err = hostcache.Get(tenant1, guid1, l.load)
+	require.NoError(t, err)
+	assert.Equal(t, 1, l.loads)
+
+	hostcache.Invalidate(util.TenantIDResourceIDTuple{TenantID: tenant1, ResourceID: "host-12345678"})
+	assert.Zero(t, hostcache.Len())
+	_, err = hostcache.Get(tenant1, guid1, l.load)
+	require.NoError(t, err)
+	assert.Equal(t, 2, l.loads)
+}
+
+func TestInvalidate_WhileLoading(t *testing.T) {
+	enableCache(t, time.Minute)
+	hostID := util.TenantIDResourceIDTuple{TenantID: tenant1, ResourceID: "host-12345678"}
+	l := newLoader(tenant1, hostID.ResourceID)
+
+	// The Host changes while being loaded, the loaded one may be outdated
+	_, err := hostcache.Get(tenant1, guid1, func() (*computev1.HostResource, error) {
+		hostcache.Invalidate(hostID)
+		return l.load()
+	})
+	require.NoError(t, err)
+	assert.Zero(t, hostcache.Len())
+
+	_, err = hostcache.Get(tenant1, guid1, l.load)
+	require.NoError(t, err)
+	assert.Equal(t, 2, l.loads)
+	assert.Equal(t, 1, hostcache.Len())
+}
+
+func TestInvalidate_OtherHostWhileLoading(t *testing.T) {
+	enableCache(t, time.Minute)
+	l := newLoader(tenant1, "host-12345678")
+
+	// The invalidation of another Host does not prevent caching the loaded one
+	_, err := hostcache.Get(tenant1, guid1, func() (*computev1.HostResource, error) {
+		hostcache.Invalidate(util.TenantIDResourceIDTuple{TenantID: tenant1, ResourceID: "host-87654321"})
+		hostcache.Invalidate(util.TenantIDResourceIDTuple{TenantID: tenant2, ResourceID: "host-12345678"})
+		return l.load()
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, hostcache.Len())
+
+	_, err = hostcache.Get(tenant1, guid1, l.load)
+	require.NoError(t, err)
+	assert.Equal(t, 1, l.loads)
+}
+
+func TestSync(t *testing.T) {
+	enableCache(t, time.Minute)
+	const guid2 = "c0e4c3b1-6b4e-4c7b-9e7b-1f2d3e4b5c6d"
+	l1 := newLoader(tenant1, "host-12345678")
+	l2 := newLoader(tenant1, "host-87654321")
+
+	_, err := hostcache.Get(tenant1, guid1, l1.load)
+	require.NoError(t, err)
+	_, err = hostcache.Get(tenant1, guid2, l2.load)
+	require.NoError(t, err)
+	assert.Equal(t, 2, hostcache.Len())
+
+	// Only the listed Hosts are kept
+	hostcache.Sync([]util.TenantIDResourceIDTuple{{TenantID: tenant1, ResourceID: "host-87654321"}})
+	assert.Equal(t, 1, hostcache.Len())
+	_, err = hostcache.Get(tenant1, guid2, l2.load)
+	require.NoError(t, err)
+	assert.Equal(t, 1, l2.loads)
+	_, err = hostcache.Get(tenant1, guid1, l1.load)
+	require.NoError(t, err)
+	assert.Equal(t, 2, l1.loads)
+
+	hostcache.Sync(nil)
+	assert.Zero(t, hostcache.Len())
+}
+
+func TestTTL(t *testing.T) {
+	enableCache(t, 50*time.Millisecond)
+	l := newLoader(tenant1, "host-12345678")
+
+	_, err := hostcache.Get(tenant1, guid1, l.load)
+	require.NoError(t, err)
+	_, err = hostcache.Get(tenant1, guid1, l.load)
+	require.NoError(t, err)
+	assert.Equal(t, 1, l.loads)
+
+	// Expired Hosts are loaded again
+	time.Sleep(100 * time.Millisecond)
+	_, err = hostcache.Get(tenant1, guid1, l.load)
+	require.NoError(t, err)
+	assert.Equal(t, 2, l.loads)
+
+	// A disabled cache always loads the Host
+	hostcache.SetTTL(0)
+	assert.Zero(t, hostcache.Len())
+	for i := 3; i <= 4; i++ {
+		_, err = hostcache.Get(tenant1, guid1, l.load)
+		require.NoError(t, err)
+		assert.Equal(t, i, l.loads)
+	}
+	assert.Zero(t, hostcache.Len())
+}
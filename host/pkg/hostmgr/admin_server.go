@@ -0,0 +1,361 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr
+
+import (
+	"context"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
+	inv_errors "github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/oam"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/policy/rbac"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tenant"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tracing"
+	"github.com/open-edge-platform/infra-managers/host/pkg/alivemgr"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/errors"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hoststate"
+	"github.com/open-edge-platform/infra-managers/host/pkg/pcidevice"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+// adminRoles are the roles of the JWT the tenant of the admin requests is extracted from.
+var adminRoles = []string{"im-rw", "im-r"}
+
+// operatorMethods change the settings shared by all tenants. They are not scoped to a tenant,
+// they are authorized by the operator RBAC rules instead of the admin ones.
+var operatorMethods = map[string]bool{
+	"UpdateTimeoutSettings": true,
+}
+
+type adminServer struct {
+	pb.UnimplementedHostmgrAdminServer
+	rbac         *rbac.Policy
+	operatorRbac *rbac.Policy
+	authEnabled  bool
+}
+
+// StartOamGrpcSrv starts the OAM gRPC server. Besides the health checks, it serves the admin service,
+// whose requests are authenticated with the admin RBAC rules, or the operator ones for the settings shared by all
+// tenants. The health checks are never authenticated.
+func StartOamGrpcSrv(
+	lis net.Listener,
+	readyChan chan bool,
+	termChan chan bool,
+	wg *sync.WaitGroup,
+	options ...Option,
+) {
+	zlog.Info().Msg("Start OAM gRPC Server")
+	opts := parseOptions(options...)
+
+	var srvOpts []grpc.ServerOption
+	if opts.enableTracing {
+		srvOpts = tracing.EnableGrpcServerTracing(srvOpts)
+	}
+
+	var adminPolicy, operatorPolicy *rbac.Policy
+	if opts.enableAuth {
+		zlog.Info().Msg("Authentication is enabled, starting admin RBAC server")
+		var err error
+		adminPolicy, err = rbac.New(opts.adminRbacRulesPath)
+		if err != nil {
+			zlog.Fatal().Msg("Failed to start admin RBAC OPA server")
+		}
+		operatorPolicy, err = rbac.New(opts.operatorRbacRulesPath)
+		if err != nil {
+			zlog.Fatal().Msg("Failed to start operator RBAC OPA server")
+		}
+	}
+	tenantInterceptor := tenant.GetExtractTenantIDInterceptor(adminRoles)
+	srvOpts = append(srvOpts, grpc.ChainUnaryInterceptor(
+		unaryInterceptorForService(pb.HostmgrAdmin_ServiceDesc.ServiceName, operatorMethods, tenantInterceptor)))
+
+	s := grpc.NewServer(srvOpts...)
+	health := oam.NewOAM()
+	grpc_health_v1.RegisterHealthServer(s, health)
+	pb.RegisterHostmgrAdminServer(s, &adminServer{
+		rbac:         adminPolicy,
+		operatorRbac: operatorPolicy,
+		authEnabled:  opts.enableAuth,
+	})
+	reflection.Register(s)
+
+	go func() {
+		zlog.Info().Msgf("Serving OAM gRPC on %s", lis.Addr().String())
+		if err := s.Serve(lis); err != nil {
+			zlog.Fatal().Err(err).Msg("Cannot start Host Manager OAM server")
+		}
+	}()
+
+	for {
+		select {
+		case ready := <-readyChan:
+			health.SetReady(ready)
+			zlog.Info().Msgf("Host Manager readiness set to %t", ready)
+		case termSig := <-termChan:
+			if termSig {
+				s.Stop()
+				zlog.Info().Msg("stopping OAM server")
+			}
+			// exit WaitGroup when done
+			wg.Done()
+			return
+		}
+	}
+}
+
+// unaryInterceptorForService runs the interceptor only for the methods of the given service, but the excluded ones.
+func unaryInterceptorForService(
+	service string, excluded map[string]bool, inter grpc.UnaryServerInterceptor,
+) grpc.UnaryServerInterceptor {
+	prefix := "/" + service + "/"
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		method, ok := strings.CutPrefix(info.FullMethod, prefix)
+		if !ok || excluded[method] {
+			return handler(ctx, req)
+		}
+		return inter(ctx, req, info, handler)
+	}
+}
+
+// authorize checks that the request is allowed by the admin RBAC rules and returns the tenant of the caller.
+func (s *adminServer) authorize(ctx context.Context, operation, method string) (string, error) {
+	if s.authEnabled && !s.rbac.IsRequestAuthorized(ctx, operation) {
+		err := inv_errors.Errorfc(codes.PermissionDenied, "Request is blocked by RBAC")
+		zlog.InfraSec().InfraErr(err).Msgf("Request %s is not authenticated", method)
+		return "", err
+	}
+	tenantID, present := tenant.GetTenantIDFromContext(ctx)
+	if !present {
+		// This should never happen! Interceptor should either fail or set it!
+		err := inv_errors.Errorfc(codes.Unauthenticated, "Tenant ID is not present in context")
+		zlog.InfraSec().InfraErr(err).Msgf("Request %s is not authenticated", method)
+		return "", err
+	}
+	return tenantID, nil
+}
+
+// authorizeOperator checks that the request is allowed by the operator RBAC rules, whatever the tenant of the caller.
+func (s *adminServer) authorizeOperator(ctx context.Context, operation, method string) error {
+	if s.authEnabled && !s.operatorRbac.IsRequestAuthorized(ctx, operation) {
+		err := inv_errors.Errorfc(codes.PermissionDenied, "Request is blocked by RBAC")
+		zlog.InfraSec().InfraErr(err).Msgf("Request %s is not authenticated", method)
+		return err
+	}
+	return nil
+}
+
+func (s *adminServer) ListTrackedHosts(ctx context.Context,
+	_ *pb.ListTrackedHostsRequest,
+) (*pb.ListTrackedHostsResponse, error) {
+	tenantID, err := s.authorize(ctx, rbac.ListKey, "ListTrackedHosts")
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	resp := &pb.ListTrackedHostsResponse{}
+	for _, tracked := range alivemgr.ListTrackedHosts(tenantID) {
+		remaining := tracked.Deadline.Sub(now)
+		if remaining < 0 || tracked.Expired {
+			remaining = 0
+		}
+		resp.Hosts = append(resp.Hosts, &pb.TrackedHost{
+			ResourceId:         tracked.Host.ResourceID,
+			LastHeartbeatMs:    tracked.LastHeartbeat.UnixMilli(),
+			TimeoutMs:          tracked.Timeout.Milliseconds(),
+			RemainingTimeoutMs: remaining.Milliseconds(),
+			Expired:            tracked.Expired,
+		})
+	}
+	return resp, nil
+}
+
+func (s *adminServer) ExpireHost(ctx context.Context, in *pb.ExpireHostRequest) (*pb.ExpireHostResponse, error) {
+	tenantID, err := s.authorize(ctx, rbac.UpdateKey, "ExpireHost")
+	if err != nil {
+		return nil, err
+	}
+	if err = in.ValidateAll(); err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Invalid request: %v", in)
+		return nil, errors.Wrap(err)
+	}
+
+	hostID := hmgr_util.TenantIDResourceIDTuple{TenantID: tenantID, ResourceID: in.GetResourceId()}
+	if !alivemgr.ExpireHost(hostID) {
+		return nil, inv_errors.Errorfc(codes.NotFound, "Host %s is not tracked", hostID)
+	}
+	zlog.InfraSec().Info().Msgf("Heartbeat of %s has been expired by an admin request", hostID)
+	return &pb.ExpireHostResponse{}, nil
+}
+
+func (s *adminServer) ForgetHost(ctx context.Context, in *pb.ForgetHostRequest) (*pb.ForgetHostResponse, error) {
+	tenantID, err := s.authorize(ctx, rbac.DeleteKey, "ForgetHost")
+	if err != nil {
+		return nil, err
+	}
+	if err = in.ValidateAll(); err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Invalid request: %v", in)
+		return nil, errors.Wrap(err)
+	}
+
+	hostID := hmgr_util.TenantIDResourceIDTuple{TenantID: tenantID, ResourceID: in.GetResourceId()}
+	if !alivemgr.ForgetHostByID(hostID) {
+		return nil, inv_errors.Errorfc(codes.NotFound, "Host %s is not tracked", hostID)
+	}
+	zlog.InfraSec().Info().Msgf("Heartbeat of %s has been forgotten by an admin request", hostID)
+	return &pb.ForgetHostResponse{}, nil
+}
+
+func (s *adminServer) GetTimeoutSettings(ctx context.Context,
+	_ *pb.GetTimeoutSettingsRequest,
+) (*pb.TimeoutSettings, error) {
+	if _, err := s.authorize(ctx, rbac.GetKey, "GetTimeoutSettings"); err != nil {
+		return nil, err
+	}
+	return timeoutSettingsToProto(alivemgr.GetSettings()), nil
+}
+
+func (s *adminServer) UpdateTimeoutSettings(ctx context.Context, in *pb.TimeoutSettings) (*pb.TimeoutSettings, error) {
+	if err := s.authorizeOperator(ctx, rbac.UpdateKey, "UpdateTimeoutSettings"); err != nil {
+		return nil, err
+	}
+	if err := in.ValidateAll(); err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Invalid request: %v", in)
+		return nil, errors.Wrap(err)
+	}
+
+	if err := alivemgr.SetSettings(alivemgr.Settings{
+		BaseTimeout:    time.Duration(in.GetBaseTimeoutSeconds()) * time.Second,
+		TimeoutTimes:   int(in.GetTimeoutTimes()),
+		DynamicTimeout: in.GetDynamicTimeout(),
+	}); err != nil {
+		return nil, inv_errors.ErrorToSanitizedGrpcError(err)
+	}
+	zlog.InfraSec().Info().Msgf("Heartbeat timeout settings of all tenants changed by an operator request: %v", in)
+	return timeoutSettingsToProto(alivemgr.GetSettings()), nil
+}
+
+func (s *adminServer) FindHostsByPCIDevice(ctx context.Context,
+	in *pb.FindHostsByPCIDeviceRequest,
+) (*pb.FindHostsByPCIDeviceResponse, error) {
+	tenantID, err := s.authorize(ctx, rbac.ListKey, "FindHostsByPCIDevice")
+	if err != nil {
+		return nil, err
+	}
+	if err = in.ValidateAll(); err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Invalid request: %v", in)
+		return nil, errors.Wrap(err)
+	}
+
+	found, err := pcidevice.Find(pcidevice.Default(), tenantID, pcidevice.ByID(in.GetVendorId(), in.GetDeviceId()))
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Failed to find the hosts by PCI device of tenant %s", tenantID)
+		return nil, inv_errors.ErrorToSanitizedGrpcError(err)
+	}
+	resp := &pb.FindHostsByPCIDeviceResponse{}
+	for host, devices := range found {
+		hostDevices := &pb.HostPCIDevices{ResourceId: host.ResourceID}
+		for _, device := range devices {
+			hostDevices.Devices = append(hostDevices.Devices, pciDeviceToProto(device))
+		}
+		resp.Hosts = append(resp.Hosts, hostDevices)
+	}
+	slices.SortFunc(resp.Hosts, func(a, b *pb.HostPCIDevices) int {
+		return strings.Compare(a.GetResourceId(), b.GetResourceId())
+	})
+	return resp, nil
+}
+
+func (s *adminServer) GetHostState(ctx context.Context, in *pb.GetHostStateRequest) (*pb.HostState, error) {
+	tenantID, err := s.authorize(ctx, rbac.GetKey, "GetHostState")
+	if err != nil {
+		return nil, err
+	}
+	if err = in.ValidateAll(); err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Invalid request: %v", in)
+		return nil, errors.Wrap(err)
+	}
+
+	hostID := hmgr_util.TenantIDResourceIDTuple{TenantID: tenantID, ResourceID: in.GetResourceId()}
+	state, err := hoststate.Default().Get(hostID)
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Failed to get the state of Host %s", hostID)
+		return nil, inv_errors.ErrorToSanitizedGrpcError(err)
+	}
+	return hostStateToProto(in.GetResourceId(), state), nil
+}
+
+func (s *adminServer) ReleaseHost(ctx context.Context, in *pb.ReleaseHostRequest) (*pb.ReleaseHostResponse, error) {
+	tenantID, err := s.authorize(ctx, rbac.UpdateKey, "ReleaseHost")
+	if err != nil {
+		return nil, err
+	}
+	if err = in.ValidateAll(); err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Invalid request: %v", in)
+		return nil, errors.Wrap(err)
+	}
+
+	hostID := hmgr_util.TenantIDResourceIDTuple{TenantID: tenantID, ResourceID: in.GetResourceId()}
+	released, err := releaseHost(hostID)
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Failed to release Host %s", hostID)
+		return nil, inv_errors.ErrorToSanitizedGrpcError(err)
+	}
+	if !released {
+		return nil, inv_errors.Errorfc(codes.FailedPrecondition, "Host %s is not quarantined", hostID)
+	}
+	zlog.InfraSec().Info().Msgf("Host %s has been released by an admin request", hostID)
+	return &pb.ReleaseHostResponse{}, nil
+}
+
+func hostStateToProto(resourceID string, state hoststate.State) *pb.HostState {
+	var bootTimeMs int64
+	if !state.BootTime.IsZero() {
+		bootTimeMs = state.BootTime.UnixMilli()
+	}
+	return &pb.HostState{
+		ResourceId:            resourceID,
+		TpmEkHash:             state.TPMEKHash,
+		Quarantined:           state.IsQuarantined(),
+		IdentityMismatch:      state.IdentityMismatch,
+		BootId:                state.BootID,
+		BootTimeMs:            bootTimeMs,
+		RebootCount:           state.RebootCount,
+		UnexpectedRebootCount: state.UnexpectedRebootCount,
+		LastReboot:            state.LastReboot,
+		ClockSkewSeconds:      state.ClockSkewSeconds,
+		ClockSkewMeasured:     state.ClockSkewMeasured,
+	}
+}
+
+func pciDeviceToProto(device pcidevice.Device) *pb.PCIDevice {
+	return &pb.PCIDevice{
+		Slot:     device.Slot,
+		DevClass: device.Class,
+		VendorId: device.VendorID,
+		DeviceId: device.DeviceID,
+		Driver:   device.Driver,
+		NumaNode: device.NumaNode,
+	}
+}
+
+func timeoutSettingsToProto(settings alivemgr.Settings) *pb.TimeoutSettings {
+	return &pb.TimeoutSettings{
+		BaseTimeoutSeconds: int64(settings.BaseTimeout / time.Second),
+		// The number of timeouts is set from an int32, or from a flag with a small default value
+		TimeoutTimes:   int32(settings.TimeoutTimes),
+		DynamicTimeout: settings.DynamicTimeout,
+	}
+}
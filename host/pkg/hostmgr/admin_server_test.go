@@ -0,0 +1,245 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/policy/rbac"
+	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
+	"github.com/open-edge-platform/infra-managers/host/pkg/alivemgr"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hostmgr"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hoststate"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+const (
+	adminRbacRules    = "../../rego/admin.rego"
+	operatorRbacRules = "../../rego/operator.rego"
+)
+
+// startAdminServer starts an OAM server on its own bufconn listener, stopped at the end of the test.
+func startAdminServer(t *testing.T) *grpc.ClientConn {
+	t.Helper()
+	lis := bufconn.Listen(bufferSize)
+	oamTermChan := make(chan bool)
+	readyChan := make(chan bool, 1)
+	oamWg := sync.WaitGroup{}
+	oamWg.Add(1)
+	go hostmgr.StartOamGrpcSrv(lis, readyChan, oamTermChan, &oamWg,
+		hostmgr.EnableAuth(true),
+		hostmgr.WithAdminRbacRulesPath(adminRbacRules),
+		hostmgr.WithOperatorRbacRulesPath(operatorRbacRules),
+	)
+	readyChan <- true
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, conn.Close())
+		oamTermChan <- true
+		oamWg.Wait()
+	})
+	return conn
+}
+
+// createContextWithOperatorJWT returns the context of a request of an operator of the platform,
+// whose role is not scoped to a project.
+func createContextWithOperatorJWT(t *testing.T) (context.Context, context.CancelFunc) {
+	t.Helper()
+	_, jwtToken, err := inv_testing.CreateJWTWithClaims(t, &jwt.MapClaims{
+		"iss": "https://keycloak.kind.internal/realms/master",
+		"exp": time.Now().Add(time.Hour).Unix(),
+		"typ": "Bearer",
+		"realm_access": map[string]any{
+			"roles": []string{"hostmgr-operator"},
+		},
+	})
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	return rbac.AddJWTToTheOutgoingContext(ctx, jwtToken), cancel
+}
+
+func TestOamServer_HealthIsNotAuthenticated(t *testing.T) {
+	conn := startAdminServer(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	require.Eventually(t, func() bool {
+		resp, checkErr := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+		return checkErr == nil && resp.GetStatus() == grpc_health_v1.HealthCheckResponse_SERVING
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestOamServer_AdminRequiresIMRoles(t *testing.T) {
+	client := pb.NewHostmgrAdminClient(startAdminServer(t))
+
+	// The JWT of the edge nodes does not grant access to the admin service
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+	_, err := client.ListTrackedHosts(ctx, &pb.ListTrackedHostsRequest{})
+	require.Error(t, err)
+
+	// The read-only role cannot change the settings
+	readCtx, readCancel := context.WithTimeout(context.Background(), time.Second)
+	defer readCancel()
+	_, jwtToken, err := inv_testing.CreateJWTWithReadRole(t, tenant1)
+	require.NoError(t, err)
+	readCtx = rbac.AddJWTToTheOutgoingContext(readCtx, jwtToken)
+	_, err = client.GetTimeoutSettings(readCtx, &pb.GetTimeoutSettingsRequest{})
+	require.NoError(t, err)
+	_, err = client.UpdateTimeoutSettings(readCtx, &pb.TimeoutSettings{BaseTimeoutSeconds: 10, TimeoutTimes: 3})
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Nor the read-write role, the settings are shared by all tenants
+	rwCtx, rwCancel := inv_testing.CreateContextWithJWT(t, tenant1)
+	defer rwCancel()
+	_, err = client.UpdateTimeoutSettings(rwCtx, &pb.TimeoutSettings{BaseTimeoutSeconds: 10, TimeoutTimes: 3})
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The operators are not scoped to a tenant, they cannot use the other methods
+	operatorCtx, operatorCancel := createContextWithOperatorJWT(t)
+	defer operatorCancel()
+	_, err = client.ListTrackedHosts(operatorCtx, &pb.ListTrackedHostsRequest{})
+	require.Error(t, err)
+}
+
+func TestOamServer_TrackedHosts(t *testing.T) {
+	client := pb.NewHostmgrAdminClient(startAdminServer(t))
+	ctx, cancel := inv_testing.CreateContextWithJWT(t, tenant1)
+	defer cancel()
+
+	host := &computev1.HostResource{ResourceId: "host-a0a0a0a0", TenantId: tenant1}
+	otherTenantHost := &computev1.HostResource{ResourceId: "host-a0a0a0a1", TenantId: tenant2}
+	for _, h := range []*computev1.HostResource{host, otherTenantHost} {
+		require.NoError(t, alivemgr.UpdateHostHeartBeat(h))
+		t.Cleanup(func() { alivemgr.ForgetHost(h) })
+	}
+
+	// Only the hosts of the tenant of the caller are listed
+	resp, err := client.ListTrackedHosts(ctx, &pb.ListTrackedHostsRequest{})
+	require.NoError(t, err)
+	var tracked *pb.TrackedHost
+	for _, th := range resp.GetHosts() {
+		assert.NotEqual(t, otherTenantHost.GetResourceId(), th.GetResourceId())
+		if th.GetResourceId() == host.GetResourceId() {
+			tracked = th
+		}
+	}
+	require.NotNil(t, tracked)
+	assert.False(t, tracked.GetExpired())
+	assert.Positive(t, tracked.GetLastHeartbeatMs())
+	assert.Positive(t, tracked.GetRemainingTimeoutMs())
+	assert.LessOrEqual(t, tracked.GetRemainingTimeoutMs(), tracked.GetTimeoutMs())
+
+	// The hosts of another tenant cannot be forgotten
+	_, err = client.ForgetHost(ctx, &pb.ForgetHostRequest{ResourceId: otherTenantHost.GetResourceId()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.True(t, alivemgr.IsHostTracked(otherTenantHost))
+
+	_, err = client.ForgetHost(ctx, &pb.ForgetHostRequest{ResourceId: "invalid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.ForgetHost(ctx, &pb.ForgetHostRequest{ResourceId: host.GetResourceId()})
+	require.NoError(t, err)
+	assert.False(t, alivemgr.IsHostTracked(host))
+
+	_, err = client.ExpireHost(ctx, &pb.ExpireHostRequest{ResourceId: host.GetResourceId()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestOamServer_TimeoutSettings(t *testing.T) {
+	client := pb.NewHostmgrAdminClient(startAdminServer(t))
+	ctx, cancel := inv_testing.CreateContextWithJWT(t, tenant1)
+	defer cancel()
+
+	previous, err := client.GetTimeoutSettings(ctx, &pb.GetTimeoutSettingsRequest{})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		restoreCtx, restoreCancel := createContextWithOperatorJWT(t)
+		defer restoreCancel()
+		_, restoreErr := client.UpdateTimeoutSettings(restoreCtx, previous)
+		assert.NoError(t, restoreErr)
+	})
+
+	operatorCtx, operatorCancel := createContextWithOperatorJWT(t)
+	defer operatorCancel()
+	_, err = client.UpdateTimeoutSettings(operatorCtx, &pb.TimeoutSettings{BaseTimeoutSeconds: 0, TimeoutTimes: 3})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	updated, err := client.UpdateTimeoutSettings(operatorCtx,
+		&pb.TimeoutSettings{BaseTimeoutSeconds: 20, TimeoutTimes: 4, DynamicTimeout: true})
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), updated.GetBaseTimeoutSeconds())
+	assert.Equal(t, int32(4), updated.GetTimeoutTimes())
+	assert.True(t, updated.GetDynamicTimeout())
+	assert.Equal(t, alivemgr.Settings{BaseTimeout: 20 * time.Second, TimeoutTimes: 4, DynamicTimeout: true},
+		alivemgr.GetSettings())
+}
+
+func TestOamServer_HostState(t *testing.T) {
+	client := pb.NewHostmgrAdminClient(startAdminServer(t))
+	ctx, cancel := inv_testing.CreateContextWithJWT(t, tenant1)
+	defer cancel()
+
+	otherTenantHost := hmgr_util.TenantIDResourceIDTuple{TenantID: tenant2, ResourceID: "host-a0a0a0a2"}
+	_, err := hoststate.Default().Update(otherTenantHost, func(state *hoststate.State) bool {
+		state.IdentityMismatch = "serial number: pinned SN-1234, reported SN-5678"
+		state.RecordBoot("0b7a1e0c-3c6e-4c1d-9a51-8a8f2b6c4d01", time.UnixMilli(1760612400000), "")
+		state.RecordClockSkew(-2 * time.Minute)
+		return true
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, hoststate.Default().Delete(otherTenantHost)) })
+
+	// The hosts of another tenant are not seen, nor released
+	state, err := client.GetHostState(ctx, &pb.GetHostStateRequest{ResourceId: otherTenantHost.ResourceID})
+	require.NoError(t, err)
+	assert.False(t, state.GetQuarantined())
+	_, err = client.ReleaseHost(ctx, &pb.ReleaseHostRequest{ResourceId: otherTenantHost.ResourceID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = client.GetHostState(ctx, &pb.GetHostStateRequest{ResourceId: "invalid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = client.ReleaseHost(ctx, &pb.ReleaseHostRequest{ResourceId: "invalid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The read-only role cannot release the hosts
+	readCtx, readCancel := context.WithTimeout(context.Background(), time.Second)
+	defer readCancel()
+	_, jwtToken, err := inv_testing.CreateJWTWithReadRole(t, tenant2)
+	require.NoError(t, err)
+	readCtx = rbac.AddJWTToTheOutgoingContext(readCtx, jwtToken)
+	state, err = client.GetHostState(readCtx, &pb.GetHostStateRequest{ResourceId: otherTenantHost.ResourceID})
+	require.NoError(t, err)
+	assert.True(t, state.GetQuarantined())
+	assert.Equal(t, "0b7a1e0c-3c6e-4c1d-9a51-8a8f2b6c4d01", state.GetBootId())
+	assert.Equal(t, int64(1760612400000), state.GetBootTimeMs())
+	assert.True(t, state.GetClockSkewMeasured())
+	assert.Equal(t, int64(-120), state.GetClockSkewSeconds())
+	_, err = client.ReleaseHost(readCtx, &pb.ReleaseHostRequest{ResourceId: otherTenantHost.ResourceID})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr
+
+import (
+	"time"
+
+	inv_status "github.com/open-edge-platform/infra-core/inventory/v2/pkg/status"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hoststate"
+	hrm_metrics "github.com/open-edge-platform/infra-managers/host/pkg/metrics"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+// clockSkewThreshold is the clock skew above which the indication of the running hosts is degraded, set in
+// StartInvGrpcCli.
+var clockSkewThreshold = ClockSkewThresholdValue
+
+// SetClockSkewThreshold sets the clock skew above which the indication of the running hosts is degraded, zero to
+// disable it.
+func SetClockSkewThreshold(threshold time.Duration) {
+	clockSkewThreshold = threshold
+}
+
+// measureClockSkew returns the clock skew measured on the agent time, if reported, exported as a metric.
+func measureClockSkew(agentTimeNs uint64, now time.Time) (time.Duration, bool) {
+	skew, ok := hmgr_util.ClockSkew(agentTimeNs, now)
+	if ok {
+		hrm_metrics.ClockSkewSeconds.Observe(skew.Abs().Seconds())
+	}
+	return skew, ok
+}
+
+// hostStatusOf returns the Host status of the status reported by the agent, with a degraded indication if the
+// clock of the running host is skewed.
+func hostStatusOf(state hoststate.State, status *pb.HostStatus) inv_status.ResourceStatus {
+	return hmgr_util.HostStatusWithClockSkew(status.GetHostStatus(), state.IsClockSkewed(clockSkewThreshold))
+}
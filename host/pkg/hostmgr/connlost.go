@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	"github.com/open-edge-platform/infra-managers/host/pkg/alivemgr"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hostcache"
+	inv_mgr_cli "github.com/open-edge-platform/infra-managers/host/pkg/invclient"
+	hrm_metrics "github.com/open-edge-platform/infra-managers/host/pkg/metrics"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+const (
+	defaultConnLostWorkers  = 16
+	defaultConnLostMaxPause = 5 * time.Minute
+	// connLostResumeInterval is the interval at which the updates paused by a storm are checked.
+	connLostResumeInterval = 5 * time.Second
+	// connLostResumeBatch is the maximum number of paused updates resumed at each interval.
+	connLostResumeBatch = 200
+)
+
+var (
+	connLostWorkers = flag.Int(
+		"connLostWorkers",
+		defaultConnLostWorkers,
+		"Flag to set the number of concurrent Inventory updates of the hosts that lost their heartbeat.",
+	)
+	connLostMaxPause = flag.Duration(
+		"connLostMaxPause",
+		defaultConnLostMaxPause,
+		"Flag to set how long the updates of the hosts that lost their heartbeat are paused by a connection-loss "+
+			"storm, they are then resumed in batches even if the storm is not over.",
+	)
+)
+
+// connLostUpdate is the update of a host that lost its heartbeat as "No Connection".
+type connLostUpdate struct {
+	hostID util.TenantIDResourceIDTuple
+	lostAt time.Time
+}
+
+// connLostUpdater updates the hosts that lost their heartbeat with a bounded pool of workers.
+// During a connection-loss storm the updates are paused: most hosts usually reconnect once the Host Manager
+// is up again or the uplink of the site is back, and their update is dropped. The others are resumed in batches.
+type connLostUpdater struct {
+	updates  chan connLostUpdate
+	paused   map[util.TenantIDResourceIDTuple]connLostUpdate
+	maxPause time.Duration
+	timeout  time.Duration
+}
+
+func newConnLostUpdater(ctx context.Context, timeout time.Duration) *connLostUpdater {
+	workers := *connLostWorkers
+	if workers <= 0 {
+		zlog.InfraSec().Warn().Msgf("invalid number of workers %d, continuing with default value", workers)
+		workers = defaultConnLostWorkers
+	}
+	u := &connLostUpdater{
+		updates:  make(chan connLostUpdate, workers),
+		paused:   make(map[util.TenantIDResourceIDTuple]connLostUpdate),
+		maxPause: *connLostMaxPause,
+		timeout:  timeout,
+	}
+	for i := 0; i < workers; i++ {
+		go func() {
+			for update := range u.updates {
+				u.setHostAsConnectionLost(ctx, update)
+			}
+		}()
+	}
+	return u
+}
+
+// lost handles a host that lost its heartbeat, blocking while all the workers are busy.
+func (u *connLostUpdater) lost(hostID util.TenantIDResourceIDTuple, now time.Time) {
+	update := connLostUpdate{hostID: hostID, lostAt: now}
+	if alivemgr.InStorm(hostID) {
+		zlog.Debug().Msgf("Pausing the update of %s during a connection-loss storm", hostID)
+		u.paused[hostID] = update
+		hrm_metrics.PausedConnectionLostUpdates.Set(float64(len(u.paused)))
+		return
+	}
+	u.updates <- update
+}
+
+// resume resumes a batch of the paused updates whose storm is over, or paused for too long.
+// The updates of the hosts that reconnected, or are not tracked anymore, are dropped.
+func (u *connLostUpdater) resume(now time.Time) {
+	if storms := alivemgr.ActiveStorms(); len(storms) > 0 {
+		zlog.InfraSec().Warn().Msgf("%d connection-loss storms in progress, %d host updates paused",
+			len(storms), len(u.paused))
+	}
+
+	resumed := 0
+	for hostID, update := range u.paused {
+		if resumed >= connLostResumeBatch {
+			break
+		}
+		alive, err := alivemgr.GetHostHeartBeat(&computev1.HostResource{
+			TenantId:   hostID.TenantID,
+			ResourceId: hostID.ResourceID,
+		})
+		if err != nil || alive {
+			zlog.Debug().Msgf("Dropping the paused update of %s, the host reconnected or is not tracked", hostID)
+			delete(u.paused, hostID)
+			continue
+		}
+		if alivemgr.InStorm(hostID) && now.Sub(update.lostAt) < u.maxPause {
+			continue
+		}
+		delete(u.paused, hostID)
+		u.updates <- update
+		resumed++
+	}
+	hrm_metrics.PausedConnectionLostUpdates.Set(float64(len(u.paused)))
+}
+
+func (u *connLostUpdater) setHostAsConnectionLost(ctx context.Context, update connLostUpdate) {
+	hbk := update.hostID
+	// Unix timestamps are always positive, so conversion from int64 to uint64 is safe
+	timestampConnLost := uint64(update.lostAt.Unix())
+	if err := backoff.Retry(func() error {
+		childCtx, cancel := context.WithTimeout(ctx, u.timeout)
+		defer cancel()
+		err := inv_mgr_cli.SetHostAsConnectionLost(
+			childCtx, invClientInstance, hbk.TenantID, hbk.ResourceID, timestampConnLost)
+		hostcache.Invalidate(hbk)
+		if err != nil {
+			zlog.InfraSec().Warn().Msgf(
+				"Failed to update %s status as CONNECTION_LOST, retrying in the next backoff interval",
+				hbk,
+			)
+		}
+		return err
+	}, backoff.WithMaxRetries(backoff.NewConstantBackOff(backoffInterval), backoffRetries)); err != nil {
+		zlog.InfraSec().InfraError(
+			"Failed to update %s status as CONNECTION_LOST, even after backoff",
+			hbk,
+		).Send()
+	}
+}
@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inv_errors "github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hostcache"
+	inv_mgr_cli "github.com/open-edge-platform/infra-managers/host/pkg/invclient"
+	"github.com/open-edge-platform/infra-managers/host/pkg/ratelimit"
+	hrm_status "github.com/open-edge-platform/infra-managers/host/pkg/status"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+// discoveryLimiter limits the number of Hosts discovered per tenant, it is replaced in StartInvGrpcCli.
+var discoveryLimiter = ratelimit.NewTenantLimiter(HostDiscoveryRateValue, HostDiscoveryBurstValue)
+
+// SetHostDiscoveryRateLimit sets the per-tenant rate limit of the Host discovery.
+func SetHostDiscoveryRateLimit(ratePerMinute float64, burst int) {
+	discoveryLimiter = ratelimit.NewTenantLimiter(ratePerMinute, burst)
+}
+
+// getHostByGUID returns the Host with the given GUID, from the local cache if possible. The Host
+// is read from Inventory only if it is not cached, e.g. after it has been changed.
+func getHostByGUID(ctx context.Context, tenantID, guid string) (*computev1.HostResource, error) {
+	return hostcache.Get(tenantID, guid, func() (*computev1.HostResource, error) {
+		return inv_mgr_cli.GetHostResourceByGUID(ctx, invClientInstance, tenantID, guid)
+	})
+}
+
+// invalidateHost drops the Host from the local cache, it has to be called after changing the Host in Inventory.
+func invalidateHost(host *computev1.HostResource) {
+	hostcache.Invalidate(hmgr_util.NewTenantIDResourceIDTupleFromHost(host))
+}
+
+// getOrDiscoverHost returns the Host with the given GUID. If the Host does not exist and the Host discovery
+// is allowed, a new Host is registered in Inventory, populated with the system information, if provided.
+// The returned boolean reports whether the Host has just been discovered.
+func getOrDiscoverHost(ctx context.Context, tenantID, guid string, systemInfo *pb.SystemInfo,
+) (*computev1.HostResource, bool, error) {
+	host, err := getHostByGUID(ctx, tenantID, guid)
+	if err == nil || !inv_errors.IsNotFound(err) || !AllowHostDiscoveryValue {
+		return host, false, err
+	}
+
+	if !discoveryLimiter.Allow(tenantID) {
+		zlog.InfraSec().InfraError("Discovery of Host tID=%s, UUID=%s is rate limited", tenantID, guid).
+			Msg("getOrDiscoverHost")
+		return nil, false, inv_errors.Errorfc(codes.ResourceExhausted,
+			"Host tID=%s, UUID=%s cannot be discovered, rate limit exceeded", tenantID, guid)
+	}
+
+	newHost, err := newDiscoveredHost(tenantID, guid, systemInfo)
+	if err != nil {
+		return nil, false, err
+	}
+	created, createErr := inv_mgr_cli.CreateHost(ctx, invClientInstance, tenantID, newHost)
+	if createErr != nil {
+		// The Host may have been discovered concurrently, the creation error is returned otherwise
+		zlog.InfraSec().InfraErr(createErr).Msgf("Failed to discover Host tID=%s, UUID=%s", tenantID, guid)
+		host, err = inv_mgr_cli.GetHostResourceByGUID(ctx, invClientInstance, tenantID, guid)
+		if err != nil {
+			return nil, false, createErr
+		}
+		return host, false, nil
+	}
+
+	zlog.InfraSec().Info().Msgf("Host tID=%s, UUID=%s has been discovered, resID=%s",
+		tenantID, guid, created.GetResourceId())
+	return created, true, nil
+}
+
+// checkHostNotDiscovered rejects the updates of a Host discovered by the Host Manager, until it is onboarded.
+// Nobody has approved the Host yet, its agent is only told the action to take.
+func checkHostNotDiscovered(tenantID string, host *computev1.HostResource) error {
+	if !hmgr_util.IsHostDiscovered(host) {
+		return nil
+	}
+	zlog.InfraSec().InfraError("Host tID=%s, UUID=%s is discovered and not onboarded yet, the update is rejected",
+		tenantID, host.GetUuid()).Msg("checkHostNotDiscovered")
+	return inv_errors.Errorfc(codes.FailedPrecondition,
+		"Host tID=%s, UUID=%s is not onboarded yet", tenantID, host.GetUuid())
+}
+
+// newDiscoveredHost builds the Host registered for an unknown GUID. The Host is registered, waiting to be onboarded.
+func newDiscoveredHost(tenantID, guid string, systemInfo *pb.SystemInfo) (*computev1.HostResource, error) {
+	host := &computev1.HostResource{}
+	if systemInfo != nil {
+		var err error
+		host, _, err = hmgr_util.PopulateHostResourceWithNewSystemInfo(systemInfo)
+		if err != nil {
+			return nil, err
+		}
+	}
+	host.TenantId = tenantID
+	host.Uuid = guid
+	host.Name = guid
+	host.DesiredState = computev1.HostState_HOST_STATE_REGISTERED
+	host.HostStatus = hrm_status.HostStatusDiscovered.Status
+	host.HostStatusIndicator = hrm_status.HostStatusDiscovered.StatusIndicator
+	// Unix timestamps are always positive, so conversion from int64 to uint64 is safe
+	host.HostStatusTimestamp = uint64(time.Now().Unix())
+	return host, nil
+}
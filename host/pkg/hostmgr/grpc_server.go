@@ -14,17 +14,29 @@ import (
 	inv_errors "github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/policy/rbac"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tenant"
-	"github.com/open-edge-platform/infra-managers/host/pkg/alivemgr"
 	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
 	"github.com/open-edge-platform/infra-managers/host/pkg/errors"
 	inv_mgr_cli "github.com/open-edge-platform/infra-managers/host/pkg/invclient"
+	hrm_metrics "github.com/open-edge-platform/infra-managers/host/pkg/metrics"
+	"github.com/open-edge-platform/infra-managers/host/pkg/ordering"
 	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
 )
 
+// Streams of status updates ordered independently. The Host status is updated by both
+// UpdateHostStatusByHostGuid and UpdateInstanceStateStatusByHostGUID, the instance by the latter only.
+const (
+	streamHostStatus     = "host_status"
+	streamInstanceStatus = "instance_status"
+)
+
 type server struct {
 	pb.UnimplementedHostmgrServer
 	rbac        *rbac.Policy
 	authEnabled bool
+	// tracingEnabled traces each message of the sessions, the stream is traced as a whole otherwise
+	tracingEnabled bool
+	// updates orders the status updates of each host
+	updates *ordering.Tracker
 }
 
 //nolint:stylecheck,revive // name of this function should be aligned with the one in .pb.go
@@ -55,24 +67,71 @@ func (s *server) UpdateHostStatusByHostGuid(ctx context.Context,
 		return nil, err
 	}
 
-	hostResc, err := inv_mgr_cli.GetHostResourceByGUID(ctx, invClientInstance, tenantID, guid)
+	// The status updates of a host are handled one at a time, from reading the Host until the update is recorded
+	updates := s.updates.Lock(tenantID, guid)
+	defer updates.Unlock()
+
+	hostResc, discovered, err := getOrDiscoverHost(ctx, tenantID, guid, nil)
 	if err != nil {
 		return nil, inv_errors.ErrorToSanitizedGrpcError(err)
 	}
-
-	if hmgr_util.IsHostUntrusted(hostResc) {
-		zlog.InfraSec().InfraError("Host tID=%s, UUID=%s is not trusted, the message will not be handled", tenantID, guid).
-			Msg("UpdateHostStatusByHostGuid")
-		return nil, inv_errors.Errorfc(codes.Unauthenticated,
-			"Host tID=%s, UUID=%s is not trusted, the message will not be handled", tenantID, guid)
+	if discovered {
+		// The Host has to be onboarded before its status is tracked
+		return &pb.HostStatusResp{HostAction: hmgr_util.GetHostAction(hostResc)}, nil
 	}
 
-	err = s.updateHostStatusIfNeeded(ctx, tenantID, hostResc, status)
-	if err != nil {
+	stamp := ordering.Stamp{Timestamp: in.GetAgentTimestampNs(), Sequence: in.GetSequenceNumber()}
+	if err = s.handleHostStatus(ctx, updates, tenantID, hostResc, status, stamp); err != nil {
 		return nil, err
 	}
 
-	return &pb.HostStatusResp{}, nil
+	return &pb.HostStatusResp{HostAction: hmgr_util.GetHostAction(hostResc)}, nil
+}
+
+// handleHostStatus handles a status reported by the agent, whether it is sent by UpdateHostStatusByHostGuid
+// or on the session of the host, so that the statuses are checked and ordered the same way. The updates of
+// the host are locked by the caller.
+func (s *server) handleHostStatus(ctx context.Context, updates *ordering.HostUpdates, tenantID string,
+	host *computev1.HostResource, status *pb.HostStatus, stamp ordering.Stamp,
+) error {
+	if err := checkHostAcceptsStatus(tenantID, host); err != nil {
+		return err
+	}
+
+	return applyInOrder(updates, tenantID, host.GetUuid(), streamHostStatus, stamp, func() error {
+		return s.updateHostStatusIfNeeded(ctx, tenantID, host, status, stamp.Timestamp)
+	})
+}
+
+// checkHostAcceptsStatus rejects the statuses of an untrusted Host and of a Host discovered by the Host Manager
+// that has not been onboarded yet.
+func checkHostAcceptsStatus(tenantID string, host *computev1.HostResource) error {
+	if hmgr_util.IsHostUntrusted(host) {
+		zlog.InfraSec().InfraError("Host tID=%s, UUID=%s is not trusted, the message will not be handled",
+			tenantID, host.GetUuid()).Msg("checkHostAcceptsStatus")
+		return inv_errors.Errorfc(codes.Unauthenticated,
+			"Host tID=%s, UUID=%s is not trusted, the message will not be handled", tenantID, host.GetUuid())
+	}
+	return checkHostNotDiscovered(tenantID, host)
+}
+
+// applyInOrder applies the status update of the host, unless a later update of the stream has already been applied.
+// Late and replayed updates are ignored, the agent is not expected to send them again. The updates of the host
+// are locked by the caller.
+func applyInOrder(updates *ordering.HostUpdates, tenantID, hostGUID, stream string, stamp ordering.Stamp,
+	apply func() error,
+) error {
+	if updates.IsStale(stream, stamp) {
+		zlog.Warn().Msgf("Ignore stale %s update (timestamp=%d, sequence=%d) of host tID=%s, UUID=%s",
+			stream, stamp.Timestamp, stamp.Sequence, tenantID, hostGUID)
+		hrm_metrics.StaleStatusUpdates.WithLabelValues(stream).Inc()
+		return nil
+	}
+	if err := apply(); err != nil {
+		return err
+	}
+	updates.Record(stream, stamp)
+	return nil
 }
 
 //nolint:cyclop // cyclomatic complexity is high due to update of various Host components
@@ -88,7 +147,8 @@ func (s *server) UpdateHostSystemInfoByGUID(ctx context.Context,
 		}
 	}
 	if err := in.ValidateAll(); err != nil {
-		zlog.InfraSec().InfraErr(err).Msgf("Invalid request %v", in)
+		// The system information holds secrets, e.g. the BMC credentials, it is never logged
+		zlog.InfraSec().InfraErr(err).Msgf("Invalid system information of Host %s", in.GetHostGuid())
 		return nil, errors.Wrap(err)
 	}
 
@@ -103,7 +163,7 @@ func (s *server) UpdateHostSystemInfoByGUID(ctx context.Context,
 		return nil, err
 	}
 
-	hostres, err := inv_mgr_cli.GetHostResourceByGUID(ctx, invClientInstance, tenantID, guid)
+	hostres, discovered, err := getOrDiscoverHost(ctx, tenantID, guid, systemInfo)
 	if err != nil {
 		return nil, inv_errors.ErrorToSanitizedGrpcError(err)
 	}
@@ -115,33 +175,41 @@ func (s *server) UpdateHostSystemInfoByGUID(ctx context.Context,
 			"Host tID=%s, UUID=%s is not trusted, the message will not be handled", tenantID, guid)
 	}
 
-	if !DisabledProvisioningValue && hmgr_util.IsHostNotProvisioned(hostres) {
+	// A discovered Host is not provisioned yet, but its hardware is registered anyway
+	if !discovered && !DisabledProvisioningValue && hmgr_util.IsHostNotProvisioned(hostres) {
 		zlog.InfraSec().
 			InfraError("Host tID=%s, UUID=%s is not yet provisioned, skipping update", tenantID, hostres.GetUuid()).
 			Msg("UpdateHostSystemInfoByGUID")
 		return nil, inv_errors.Errorfc(codes.FailedPrecondition, "")
 	}
 
-	err = updateHost(ctx, tenantID, hostres, systemInfo)
-	if err != nil {
-		return nil, inv_errors.ErrorToSanitizedGrpcError(err)
+	if err = checkHostIdentity(ctx, tenantID, hostres, systemInfo.GetHwInfo()); err != nil {
+		return nil, err
 	}
 
-	if err := updateHoststorage(ctx, tenantID, hostres, systemInfo.HwInfo); err != nil {
+	// Every mutation is planned up front, invalid system information is rejected before any change of the Host
+	plan, err := PlanSystemInfoUpdate(ctx, tenantID, hostres, systemInfo)
+	if err != nil {
 		return nil, inv_errors.ErrorToSanitizedGrpcError(err)
 	}
-
-	if err := updateHostnics(ctx, tenantID, hostres, systemInfo.HwInfo); err != nil {
-		return nil, inv_errors.ErrorToSanitizedGrpcError(err)
+	if SystemInfoDryRunValue {
+		zlog.Info().Msgf("Dry run, skipping %d changes of Host (tID=%s, UUID=%s): %s",
+			plan.Len(), tenantID, hostres.GetUuid(), plan)
+		return &pb.UpdateHostSystemInfoByGUIDResponse{}, nil
 	}
+	zlog.Debug().Msgf("Applying %d changes to Host (tID=%s, UUID=%s): %s", plan.Len(), tenantID, hostres.GetUuid(), plan)
 
-	if err := updateHostusbs(ctx, tenantID, hostres, systemInfo.HwInfo); err != nil {
-		return nil, inv_errors.ErrorToSanitizedGrpcError(err)
-	}
+	// The changes are computed against the Host before the update, they are recorded only once applied
+	changes := hardwareChanges(tenantID, hostres, systemInfo)
 
-	if err := updateHostgpus(ctx, tenantID, hostres, systemInfo.HwInfo); err != nil {
+	// The Host is read again from Inventory after any change, even a partial one
+	if plan.Len() > 0 {
+		defer invalidateHost(hostres)
+	}
+	if err = plan.Apply(ctx); err != nil {
 		return nil, inv_errors.ErrorToSanitizedGrpcError(err)
 	}
+	recordHardwareChanges(tenantID, hostres, changes)
 
 	return &pb.UpdateHostSystemInfoByGUIDResponse{}, nil
 }
@@ -172,28 +240,32 @@ func (s *server) UpdateInstanceStateStatusByHostGUID(
 
 	zlog.Info().Msgf("Updating an Instance for Host (tID=%s, UUID=%s)", tenantID, in.GetHostGuid())
 	// Finding a Host by GUID to get its ResourceID first (needed for querying Instance)
-	host, err := inv_mgr_cli.GetHostResourceByGUID(ctx, invClientInstance, tenantID, in.GetHostGuid())
+	updates := s.updates.Lock(tenantID, in.GetHostGuid())
+	defer updates.Unlock()
+	host, err := getHostByGUID(ctx, tenantID, in.GetHostGuid())
 	if err != nil {
 		return &pb.UpdateInstanceStateStatusByHostGUIDResponse{}, inv_errors.ErrorToSanitizedGrpcError(err)
 	}
 
-	if hmgr_util.IsHostUntrusted(host) {
-		zlog.InfraSec().
-			InfraError("Skip updating instance state for host tID=%s, UUID=%s (untrusted).", tenantID, host.GetUuid()).
-			Msg("UpdateInstanceStateStatusByHostGUID")
-		return &pb.UpdateInstanceStateStatusByHostGUIDResponse{}, inv_errors.Errorfc(codes.Unauthenticated, "")
+	if err = checkHostAcceptsStatus(tenantID, host); err != nil {
+		return &pb.UpdateInstanceStateStatusByHostGUIDResponse{}, err
 	}
 
-	// Deal first with Host status update
-	err = s.updateHostStatusIfNeeded(ctx, tenantID, host, hmgr_util.InstanceStatusToHostStatusMsg(in))
+	stamp := ordering.Stamp{Timestamp: in.GetAgentTimestampNs(), Sequence: in.GetSequenceNumber()}
+	// The Host status is updated by both RPCs, a late instance update must not overwrite a later Host status
+	err = applyInOrder(updates, tenantID, in.GetHostGuid(), streamHostStatus, stamp, func() error {
+		return s.updateHostStatusIfNeeded(ctx, tenantID, host, hmgr_util.InstanceStatusToHostStatusMsg(in),
+			in.GetAgentTimestampNs())
+	})
 	if err != nil {
 		return nil, err
 	}
-
 	if !DisabledProvisioningValue {
-		err = updateInstanceStateStatusByHostGUID(ctx, tenantID, host.GetInstance(), in)
+		err = applyInOrder(updates, tenantID, in.GetHostGuid(), streamInstanceStatus, stamp, func() error {
+			return inv_errors.ErrorToSanitizedGrpcError(updateInstanceStateStatusByHostGUID(ctx, tenantID, host, in))
+		})
 		if err != nil {
-			return nil, inv_errors.ErrorToSanitizedGrpcError(err)
+			return nil, err
 		}
 	}
 
@@ -201,13 +273,10 @@ func (s *server) UpdateInstanceStateStatusByHostGUID(
 }
 
 func (s *server) updateHostStatusIfNeeded(
-	ctx context.Context, tenantID string, host *computev1.HostResource, status *pb.HostStatus,
+	ctx context.Context, tenantID string, host *computev1.HostResource, status *pb.HostStatus, agentTimeNs uint64,
 ) error {
 	hostUUID := host.GetUuid()
-	// update host heartbeat
-	if err := alivemgr.UpdateHostHeartBeat(host); err != nil {
-		zlog.Warn().Err(err).Msg("Failed to update host heartbeat")
-	}
+	state, stateErr := trackHost(tenantID, host, status, agentTimeNs)
 
 	// If host under maintenance, skip everything else
 	if hmgr_util.IsHostUnderMaintain(host) {
@@ -215,6 +284,14 @@ func (s *server) updateHostStatusIfNeeded(
 		return nil
 	}
 
+	// A quarantined host keeps its status until it is released, the status is not updated if it cannot be checked
+	if stateErr != nil {
+		return inv_errors.ErrorToSanitizedGrpcError(stateErr)
+	}
+	if state.IsQuarantined() {
+		return errHostQuarantined(tenantID, host)
+	}
+
 	if !DisabledProvisioningValue && hmgr_util.IsHostNotProvisioned(host) {
 		zlog.InfraSec().
 			InfraError("Skip updating instance state for host tID=%s, UUID=%s (not provisioned)", tenantID, host.GetUuid()).
@@ -222,20 +299,83 @@ func (s *server) updateHostStatusIfNeeded(
 		return inv_errors.Errorfc(codes.FailedPrecondition, "")
 	}
 
-	if hmgr_util.IsSameHostStatus(host, status) {
+	hostStatus := hostStatusOf(state, status)
+	// The indication of a running host is degraded if its clock is skewed
+	if host.GetHostStatusIndicator() == hostStatus.StatusIndicator && hmgr_util.IsSameHostStatus(host, status) {
 		zlog.Info().Msgf("Skip host tID=%s, UUID=%s status update for no changes.", tenantID, hostUUID)
 		return nil
 	}
 
+	if err := checkHostStatusTransition(tenantID, host, status); err != nil {
+		return err
+	}
+
 	hostStatusName := pb.HostStatus_HostStatus_name[int32(status.GetHostStatus())]
 	zlog.Debug().Msgf("Update host resc (tID=%s, resID=%v) status: %v", tenantID, host.GetResourceId(),
 		hostStatusName)
 
-	if err := inv_mgr_cli.SetHostStatus(ctx, invClientInstance, tenantID, host.GetResourceId(),
-		hmgr_util.GetHostStatus(status.GetHostStatus()),
+	defer invalidateHost(host)
+	if err := inv_mgr_cli.SetHostStatusDetail(ctx, invClientInstance, tenantID, host.GetResourceId(),
+		hostStatus, hostStatusDetailsUpdate(status),
 	); err != nil {
 		zlog.InfraSec().InfraError("Failed to update host resource info").Msg("updateHostStatusIfNeeded")
 		return inv_errors.ErrorToSanitizedGrpcError(err)
 	}
 	return nil
 }
+
+// hostStatusDetailsUpdate returns the update of the metadata of the host with the status details reported
+// by the agent, the empty ones are cleared.
+func hostStatusDetailsUpdate(status *pb.HostStatus) *hmgr_util.MetadataUpdate {
+	update := &hmgr_util.MetadataUpdate{Set: hmgr_util.HostStatusMetadata(status)}
+	for _, key := range hmgr_util.HostStatusMetadataKeys {
+		if _, ok := update.Set[key]; !ok {
+			update.Deleted = append(update.Deleted, key)
+		}
+	}
+	return update
+}
+
+// checkHostStatusTransition rejects the host statuses that cannot follow the last one reported by the agent,
+// e.g. RUNNING then REGISTERING from a stale agent. They are only logged if the transitions are not enforced.
+func checkHostStatusTransition(tenantID string, host *computev1.HostResource, status *pb.HostStatus) error {
+	from, to := hmgr_util.ReportedHostStatus(host), status.GetHostStatus()
+	if hmgr_util.IsValidHostStatusTransition(from, to) {
+		return nil
+	}
+	hrm_metrics.IllegalStatusTransitions.WithLabelValues(from.String(), to.String()).Inc()
+	if !EnforceStatusTransitionsValue {
+		zlog.Warn().Msgf("Illegal status transition of host tID=%s, UUID=%s from %s to %s",
+			tenantID, host.GetUuid(), from, to)
+		return nil
+	}
+	zlog.InfraSec().InfraError("Reject illegal status transition of host tID=%s, UUID=%s from %s to %s",
+		tenantID, host.GetUuid(), from, to).Msg("updateHostStatusIfNeeded")
+	return inv_errors.Errorfc(codes.FailedPrecondition, "illegal host status transition from %s to %s", from, to)
+}
+
+// checkInstanceTransition rejects the Instance states and statuses that cannot follow the current ones of the
+// Instance, e.g. RUNNING for a deleted Instance. They are only logged if the transitions are not enforced.
+func checkInstanceTransition(tenantID string, host *computev1.HostResource,
+	in *pb.UpdateInstanceStateStatusByHostGUIDRequest,
+) error {
+	instance := host.GetInstance()
+	var from, to string
+	switch {
+	case !hmgr_util.IsValidInstanceStateTransition(instance.GetCurrentState(), in.GetInstanceState()):
+		from, to = instance.GetCurrentState().String(), in.GetInstanceState().String()
+	case !hmgr_util.IsValidInstanceStatusTransition(hmgr_util.ReportedInstanceStatus(instance), in.GetInstanceStatus()):
+		from, to = hmgr_util.ReportedInstanceStatus(instance).String(), in.GetInstanceStatus().String()
+	default:
+		return nil
+	}
+	hrm_metrics.IllegalStatusTransitions.WithLabelValues(from, to).Inc()
+	if !EnforceStatusTransitionsValue {
+		zlog.Warn().Msgf("Illegal instance transition of host tID=%s, UUID=%s from %s to %s",
+			tenantID, host.GetUuid(), from, to)
+		return nil
+	}
+	zlog.InfraSec().InfraError("Reject illegal instance transition of host tID=%s, UUID=%s from %s to %s",
+		tenantID, host.GetUuid(), from, to).Msg("updateInstanceStateStatusByHostGUID")
+	return inv_errors.Errorfc(codes.FailedPrecondition, "illegal instance transition from %s to %s", from, to)
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hostmgr"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+func TestHostManagerClient_AgentInfo(t *testing.T) {
+	require.NoError(t, hostmgr.SetMinAgentVersions("node-agent=1.2.0"))
+	t.Cleanup(func() { require.NoError(t, hostmgr.SetMinAgentVersions("")) })
+
+	hostInv := createProvisionedHost(t, inv_testing.HostMetadata(`[{"key":"cluster-name","value":"edge"}]`))
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	in, ok := proto.Clone(systemInfo1).(*pb.UpdateHostSystemInfoByGUIDRequest)
+	require.True(t, ok)
+	in.HostGuid = hostInv.GetUuid()
+	in.SystemInfo.AgentInfo = &pb.AgentInfo{Agents: []*pb.Agent{
+		{Name: "node-agent", Version: "1.1.5", Features: []string{"status-sequence"}},
+		{Name: "hd-agent", Version: "1.0.0", Features: []string{"pci-devices", "gpu-info"}},
+	}}
+	_, err := HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+
+	metadata := hostMetadata(t, hostInv.GetUuid())
+	assert.Equal(t, "edge", metadata["cluster-name"])
+	assert.Equal(t, "1.1.5", metadata[hmgr_util.AgentVersionMetadataKey("node-agent")])
+	assert.Equal(t, "gpu-info,pci-devices", metadata[hmgr_util.AgentFeaturesMetadataKey("hd-agent")])
+	assert.Equal(t, "node-agent: running 1.1.5, minimum 1.2.0", metadata[hmgr_util.AgentOutdatedMetadataKey])
+	host := GetHostbyUUID(t, hostInv.GetUuid())
+	assert.True(t, hmgr_util.HasAgentFeature(host, "hd-agent", "gpu-info"))
+	assert.False(t, hmgr_util.AgentVersionAtLeast(host, "node-agent", "1.2.0"))
+
+	// The node agent is upgraded and the hd agent removed
+	in.SystemInfo.AgentInfo = &pb.AgentInfo{Agents: []*pb.Agent{
+		{Name: "node-agent", Version: "1.2.0", Features: []string{"status-sequence", "agent-info"}},
+	}}
+	_, err = HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+	metadata = hostMetadata(t, hostInv.GetUuid())
+	assert.Equal(t, "1.2.0", metadata[hmgr_util.AgentVersionMetadataKey("node-agent")])
+	assert.NotContains(t, metadata, hmgr_util.AgentVersionMetadataKey("hd-agent"))
+	assert.NotContains(t, metadata, hmgr_util.AgentFeaturesMetadataKey("hd-agent"))
+	assert.NotContains(t, metadata, hmgr_util.AgentOutdatedMetadataKey)
+	assert.Equal(t, "edge", metadata["cluster-name"])
+
+	// Agents that do not report the AgentInfo leave the metadata untouched
+	in.SystemInfo.AgentInfo = nil
+	_, err = HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+	assert.Equal(t, metadata, hostMetadata(t, hostInv.GetUuid()))
+}
+
+func TestHostManagerClient_InvalidAgentInfo(t *testing.T) {
+	hostInv := createProvisionedHost(t)
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	for _, agent := range []*pb.Agent{
+		{Name: "Node Agent", Version: "1.2.0"},
+		{Name: "node-agent", Version: "latest"},
+		{Name: "node-agent", Version: "1.2.0", Features: []string{"gpu-info", "gpu-info"}},
+	} {
+		in, ok := proto.Clone(systemInfo1).(*pb.UpdateHostSystemInfoByGUIDRequest)
+		require.True(t, ok)
+		in.HostGuid = hostInv.GetUuid()
+		in.SystemInfo.AgentInfo = &pb.AgentInfo{Agents: []*pb.Agent{agent}}
+		_, err := HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
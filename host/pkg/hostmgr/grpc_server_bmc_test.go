@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hostmgr"
+	"github.com/open-edge-platform/infra-managers/host/pkg/secretstore"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+func TestHostManagerClient_BmcInfo(t *testing.T) {
+	hostInv := createProvisionedHost(t)
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	in, ok := proto.Clone(systemInfo1).(*pb.UpdateHostSystemInfoByGUIDRequest)
+	require.True(t, ok)
+	in.HostGuid = hostInv.GetUuid()
+	in.SystemInfo.BmCtlInfo = &pb.BmInfo{
+		BmType: pb.BmInfo_IPMI,
+		BmcInfo: &pb.BmcInfo{
+			BmIp:       "192.168.100.10",
+			BmUsername: "admin",
+			BmPassword: "secret",
+		},
+	}
+	in.SystemInfo.HwInfo.Network = []*pb.SystemNetwork{
+		{
+			Name:        "eth0",
+			Mac:         "90:49:fa:07:6c:fd",
+			Mtu:         1500,
+			IpAddresses: []*pb.IPAddress{{IpAddress: "192.168.1.12", NetworkPrefixBits: 24}},
+		},
+		{Name: "bmc0", Mac: "90:49:fa:07:6c:fe", Mtu: 1500, BmcNet: true},
+	}
+	_, err := HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+
+	host := GetHostbyUUID(t, hostInv.GetUuid())
+	assert.Equal(t, computev1.BaremetalControllerKind_BAREMETAL_CONTROLLER_KIND_IPMI, host.GetBmcKind())
+	assert.Equal(t, "192.168.100.10", host.GetBmcIp())
+	assert.NotContains(t, host.GetMetadata(), "secret")
+
+	// The credentials are kept in the secret store, referenced by the Host
+	key := secretstore.BmcCredentialsKey(hmgr_util.NewTenantIDResourceIDTupleFromHost(hostInv))
+	metaList, err := hmgr_util.ParseMetadata(host.GetMetadata())
+	require.NoError(t, err)
+	assert.Contains(t, metaList, hmgr_util.Metadata{Key: hmgr_util.BmcCredentialsRefMetadataKey, Value: secretstore.Ref(key)})
+	credentials, err := secretstore.Default().Get(key)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"username":"admin","password":"secret"}`, string(credentials))
+
+	// The BMC IP is linked to the BMC NIC only
+	require.Len(t, host.GetHostNics(), 2)
+	for _, nic := range host.GetHostNics() {
+		addresses := make([]string, 0)
+		for _, ip := range GetIPbyNicID(t, nic.GetResourceId()) {
+			addresses = append(addresses, ip.GetAddress())
+		}
+		if nic.GetBmcInterface() {
+			assert.Equal(t, []string{"192.168.100.10/32"}, addresses)
+		} else {
+			assert.Equal(t, []string{"192.168.1.12/24"}, addresses)
+		}
+	}
+
+	// Reporting the same BMC changes nothing
+	plan, err := hostmgr.PlanSystemInfoUpdate(ctx, tenant1, host, in.GetSystemInfo())
+	require.NoError(t, err)
+	assert.Zero(t, plan.Len(), plan.String())
+}
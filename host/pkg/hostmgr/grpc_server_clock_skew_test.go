@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	hrm_status "github.com/open-edge-platform/infra-managers/host/pkg/status"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+func TestHostManagerClient_ClockSkew(t *testing.T) {
+	hostInv := createProvisionedHost(t)
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+	// The updates are ordered by sequence number, a clock stepped back does not make them stale
+	var sequence uint64
+	updateStatus := func(agentTime time.Time) {
+		t.Helper()
+		sequence++
+		_, err := HostManagerTestClient.UpdateHostStatusByHostGuid(ctx, &pb.UpdateHostStatusByHostGuidRequest{
+			HostGuid:         hostInv.GetUuid(),
+			HostStatus:       &pb.HostStatus{HostStatus: pb.HostStatus_RUNNING},
+			SequenceNumber:   sequence,
+			AgentTimestampNs: uint64(agentTime.UnixNano()),
+		})
+		require.NoError(t, err)
+	}
+	storedSkew := func() time.Duration {
+		t.Helper()
+		skew, ok := hostState(t, hostInv).ClockSkew()
+		require.True(t, ok)
+		return skew
+	}
+
+	// The clock of the host is 10 minutes ahead, the host is still running with a degraded indication
+	updateStatus(time.Now().Add(10 * time.Minute))
+	assert.InDelta(t, 10*time.Minute, storedSkew(), float64(2*time.Second))
+	host := GetHostbyUUID(t, hostInv.GetUuid())
+	assert.Equal(t, hrm_status.HostStatusRunning.Status, host.GetHostStatus())
+	assert.Equal(t, hrm_status.HostStatusClockSkewed.StatusIndicator, host.GetHostStatusIndicator())
+	assert.Equal(t, pb.HostStatus_RUNNING, hmgr_util.ReportedHostStatus(host))
+	// The skew is not tracked in the metadata editable by the users
+	assert.NotContains(t, hostMetadata(t, hostInv.GetUuid()), "clock-skew-seconds")
+
+	// The clock has been fixed
+	updateStatus(time.Now())
+	assert.InDelta(t, 0, storedSkew(), float64(2*time.Second))
+	host = GetHostbyUUID(t, hostInv.GetUuid())
+	assert.Equal(t, hrm_status.HostStatusRunning.Status, host.GetHostStatus())
+	assert.Equal(t, hrm_status.HostStatusRunning.StatusIndicator, host.GetHostStatusIndicator())
+
+	// The skew is measured on the instance updates too
+	_, err := HostManagerTestClient.UpdateInstanceStateStatusByHostGUID(ctx,
+		&pb.UpdateInstanceStateStatusByHostGUIDRequest{
+			HostGuid:         hostInv.GetUuid(),
+			InstanceStatus:   pb.InstanceStatus_INSTANCE_STATUS_RUNNING,
+			InstanceState:    pb.InstanceState_INSTANCE_STATE_RUNNING,
+			SequenceNumber:   sequence + 1,
+			AgentTimestampNs: uint64(time.Now().Add(-10 * time.Minute).UnixNano()),
+		})
+	require.NoError(t, err)
+	assert.InDelta(t, -10*time.Minute, storedSkew(), float64(2*time.Second))
+	host = GetHostbyUUID(t, hostInv.GetUuid())
+	assert.Equal(t, hrm_status.HostStatusRunning.Status, host.GetHostStatus())
+	assert.Equal(t, hrm_status.HostStatusClockSkewed.StatusIndicator, host.GetHostStatusIndicator())
+
+	// An agent not reporting its time keeps the last skew measured
+	_, err = HostManagerTestClient.UpdateHostStatusByHostGuid(ctx, &pb.UpdateHostStatusByHostGuidRequest{
+		HostGuid:       hostInv.GetUuid(),
+		HostStatus:     &pb.HostStatus{HostStatus: pb.HostStatus_ERROR},
+		SequenceNumber: sequence + 2,
+	})
+	require.NoError(t, err)
+	assert.InDelta(t, -10*time.Minute, storedSkew(), float64(2*time.Second))
+	assert.Equal(t, hrm_status.HostStatusError.Status, GetHostbyUUID(t, hostInv.GetUuid()).GetHostStatus())
+}
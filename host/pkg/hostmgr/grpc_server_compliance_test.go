@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/compliance"
+)
+
+func TestHostManagerClient_Compliance(t *testing.T) {
+	compliance.SetDefault(&compliance.Profiles{Profiles: []compliance.Profile{{
+		Name:         "sku-a",
+		ProductNames: []string{"edge-sku-a"},
+		CPU:          &compliance.CPU{Model: "12th Gen Intel(R) Core(TM) i9-12900H", MinCores: hostCPUCores},
+		Memory:       &compliance.Memory{MinGiB: 32},
+	}}})
+	t.Cleanup(func() { compliance.SetDefault(&compliance.Profiles{}) })
+
+	hostInv := createProvisionedHost(t, inv_testing.HostMetadata(`[{"key":"cluster-name","value":"edge"}]`))
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	in, ok := proto.Clone(systemInfo1).(*pb.UpdateHostSystemInfoByGUIDRequest)
+	require.True(t, ok)
+	in.HostGuid = hostInv.GetUuid()
+
+	// No profile matches the host
+	_, err := HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+	metadata := hostMetadata(t, hostInv.GetUuid())
+	for _, key := range compliance.MetadataKeys {
+		assert.NotContains(t, metadata, key)
+	}
+
+	in.SystemInfo.HwInfo.ProductName = "edge-sku-a"
+	_, err = HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+	metadata = hostMetadata(t, hostInv.GetUuid())
+	assert.Equal(t, compliance.StatusNonCompliant, metadata[compliance.StatusMetadataKey])
+	assert.Equal(t, "sku-a", metadata[compliance.ProfileMetadataKey])
+	assert.Equal(t, "memory: expected at least 32 GiB, found 0 GiB", metadata[compliance.ViolationsMetadataKey])
+	assert.Equal(t, "edge", metadata["cluster-name"])
+
+	// The violations are cleared once the hardware is fixed
+	in.SystemInfo.HwInfo.Memory.Size = 32 << 30
+	_, err = HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+	metadata = hostMetadata(t, hostInv.GetUuid())
+	assert.Equal(t, compliance.StatusCompliant, metadata[compliance.StatusMetadataKey])
+	assert.NotContains(t, metadata, compliance.ViolationsMetadataKey)
+}
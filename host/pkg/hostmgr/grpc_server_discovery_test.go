@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hostmgr"
+	hrm_status "github.com/open-edge-platform/infra-managers/host/pkg/status"
+)
+
+const (
+	discoveredHostGUID1 = "BFD3B398-9A4B-480D-AB53-4050ED108F60"
+	discoveredHostGUID2 = "BFD3B398-9A4B-480D-AB53-4050ED108F61"
+	discoveredHostGUID3 = "BFD3B398-9A4B-480D-AB53-4050ED108F62"
+)
+
+func enableHostDiscovery(t *testing.T, burst int) {
+	t.Helper()
+	prevAllowHostDiscovery := hostmgr.AllowHostDiscoveryValue
+	hostmgr.AllowHostDiscoveryValue = true
+	hostmgr.SetHostDiscoveryRateLimit(0, burst)
+	t.Cleanup(func() {
+		hostmgr.AllowHostDiscoveryValue = prevAllowHostDiscovery
+		hostmgr.SetHostDiscoveryRateLimit(hostmgr.HostDiscoveryRateValue, hostmgr.HostDiscoveryBurstValue)
+	})
+}
+
+func TestHostManagerClient_HostDiscoveryDisabled(t *testing.T) {
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	_, err := HostManagerTestClient.UpdateHostStatusByHostGuid(ctx, &pb.UpdateHostStatusByHostGuidRequest{
+		HostGuid:   discoveredHostGUID1,
+		HostStatus: &pb.HostStatus{HostStatus: pb.HostStatus_RUNNING},
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestHostManagerClient_HostDiscovery(t *testing.T) {
+	enableHostDiscovery(t, 2)
+	dao := inv_testing.NewInvResourceDAOOrFail(t)
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	// Unknown Host reporting its system information
+	_, err := HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, &pb.UpdateHostSystemInfoByGUIDRequest{
+		HostGuid: discoveredHostGUID1,
+		SystemInfo: &pb.SystemInfo{
+			HwInfo: &pb.HWInfo{
+				SerialNum:   hostSN,
+				ProductName: "discovered product",
+			},
+			BiosInfo: &pb.BiosInfo{
+				Version: "1.2.3",
+				Vendor:  "Intel",
+			},
+		},
+	})
+	require.NoError(t, err)
+	host := GetHostbyUUID(t, discoveredHostGUID1)
+	t.Cleanup(func() { dao.HardDeleteHost(t, tenant1, host.GetResourceId()) })
+	assert.Equal(t, hostSN, host.GetSerialNumber())
+	assert.Equal(t, "discovered product", host.GetProductName())
+	assert.Equal(t, "Intel", host.GetBiosVendor())
+	assert.Equal(t, computev1.HostState_HOST_STATE_REGISTERED, host.GetDesiredState())
+	assert.Equal(t, hrm_status.HostStatusDiscovered.Status, host.GetHostStatus())
+	assert.Equal(t, hrm_status.HostStatusDiscovered.StatusIndicator, host.GetHostStatusIndicator())
+
+	// Known now, but not provisioned yet
+	_, err = HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, &pb.UpdateHostSystemInfoByGUIDRequest{
+		HostGuid:   discoveredHostGUID1,
+		SystemInfo: &pb.SystemInfo{HwInfo: &pb.HWInfo{SerialNum: hostSN}},
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Unknown Host reporting its status
+	resp, err := HostManagerTestClient.UpdateHostStatusByHostGuid(ctx, &pb.UpdateHostStatusByHostGuidRequest{
+		HostGuid:   discoveredHostGUID2,
+		HostStatus: &pb.HostStatus{HostStatus: pb.HostStatus_BOOTING},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, pb.HostStatusResp_NONE, resp.GetHostAction())
+	host = GetHostbyUUID(t, discoveredHostGUID2)
+	t.Cleanup(func() { dao.HardDeleteHost(t, tenant1, host.GetResourceId()) })
+	assert.Equal(t, hrm_status.HostStatusDiscovered.Status, host.GetHostStatus())
+
+	// Nobody has approved the Host yet, its statuses are rejected until it is onboarded
+	_, err = HostManagerTestClient.UpdateHostStatusByHostGuid(ctx, &pb.UpdateHostStatusByHostGuidRequest{
+		HostGuid:   discoveredHostGUID2,
+		HostStatus: &pb.HostStatus{HostStatus: pb.HostStatus_RUNNING},
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = HostManagerTestClient.UpdateInstanceStateStatusByHostGUID(ctx,
+		&pb.UpdateInstanceStateStatusByHostGUIDRequest{
+			HostGuid:       discoveredHostGUID2,
+			InstanceStatus: pb.InstanceStatus_INSTANCE_STATUS_RUNNING,
+			InstanceState:  pb.InstanceState_INSTANCE_STATE_RUNNING,
+		})
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, hrm_status.HostStatusDiscovered.Status, GetHostbyUUID(t, discoveredHostGUID2).GetHostStatus())
+
+	// Burst is consumed and no token is refilled
+	_, err = HostManagerTestClient.UpdateHostStatusByHostGuid(ctx, &pb.UpdateHostStatusByHostGuidRequest{
+		HostGuid:   discoveredHostGUID3,
+		HostStatus: &pb.HostStatus{HostStatus: pb.HostStatus_BOOTING},
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Rate limit is per tenant
+	ctxT2, cancelT2 := inv_testing.CreateContextWithENJWT(t, tenant2)
+	defer cancelT2()
+	_, err = HostManagerTestClient.UpdateHostStatusByHostGuid(ctxT2, &pb.UpdateHostStatusByHostGuidRequest{
+		HostGuid:   discoveredHostGUID3,
+		HostStatus: &pb.HostStatus{HostStatus: pb.HostStatus_BOOTING},
+	})
+	require.NoError(t, err)
+	hostT2, err := inv_testing.TestClients[inv_testing.RMClient].GetTenantAwareInventoryClient().
+		GetHostByUUID(ctxT2, tenant2, discoveredHostGUID3)
+	require.NoError(t, err)
+	t.Cleanup(func() { dao.HardDeleteHost(t, tenant2, hostT2.GetResourceId()) })
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hwjournal"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+	om_status "github.com/open-edge-platform/infra-onboarding/onboarding-manager/pkg/status"
+)
+
+func TestHostManagerClient_HardwareJournal(t *testing.T) {
+	dao := inv_testing.NewInvResourceDAOOrFail(t)
+	hostInv := dao.CreateHost(t, tenant1)
+	os := dao.CreateOs(t, tenant1)
+	dao.CreateInstanceWithOpts(t, tenant1, hostInv, os, true, func(inst *computev1.InstanceResource) {
+		inst.ProvisioningStatus = om_status.ProvisioningStatusDone.Status
+		inst.ProvisioningStatusIndicator = om_status.ProvisioningStatusDone.StatusIndicator
+	})
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	in, ok := proto.Clone(systemInfo1).(*pb.UpdateHostSystemInfoByGUIDRequest)
+	require.True(t, ok)
+	in.HostGuid = hostInv.GetUuid()
+	in.SystemInfo.HwInfo.Network = []*pb.SystemNetwork{
+		{Name: "eth0", Mac: "90:49:fa:07:6c:fd", PciId: "0000:00:1f.6", Mtu: 1500},
+	}
+	_, err := HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+
+	hostID := hmgr_util.NewTenantIDResourceIDTupleFromHost(hostInv)
+	history, err := hwjournal.Default().History(hostID)
+	require.NoError(t, err)
+	recorded := len(history)
+
+	// Same system information, nothing is recorded
+	_, err = HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+	history, err = hwjournal.Default().History(hostID)
+	require.NoError(t, err)
+	assert.Len(t, history, recorded)
+
+	// The NIC has been renamed
+	in.SystemInfo.HwInfo.Network[0].Name = "enp0s31f6"
+	_, err = HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+	history, err = hwjournal.Default().History(hostID)
+	require.NoError(t, err)
+	require.Len(t, history, recorded+1)
+	assert.Equal(t, []hwjournal.Change{{
+		Kind:      hwjournal.ChangeModified,
+		Component: hwjournal.ComponentNic,
+		ID:        "enp0s31f6",
+		Field:     "device_name",
+		Old:       "eth0",
+		New:       "enp0s31f6",
+	}}, history[len(history)-1].Changes)
+}
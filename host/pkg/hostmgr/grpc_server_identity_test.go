@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hostmgr"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hoststate"
+	"github.com/open-edge-platform/infra-managers/host/pkg/invclient"
+	hrm_status "github.com/open-edge-platform/infra-managers/host/pkg/status"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+var tpmEKHash = strings.Repeat("ab", 32)
+
+// hostState returns the state tracked for the host.
+func hostState(t *testing.T, host *computev1.HostResource) hoststate.State {
+	t.Helper()
+	state, err := hoststate.Default().Get(hmgr_util.NewTenantIDResourceIDTupleFromHost(host))
+	require.NoError(t, err)
+	return state
+}
+
+func TestHostManagerClient_PinnedIdentity(t *testing.T) {
+	hostInv := createProvisionedHost(t)
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	// The identity is pinned when first reported
+	in, ok := proto.Clone(systemInfo1).(*pb.UpdateHostSystemInfoByGUIDRequest)
+	require.True(t, ok)
+	in.HostGuid = hostInv.GetUuid()
+	in.SystemInfo.HwInfo.ProductName = "edge-sku-a"
+	in.SystemInfo.HwInfo.TpmEkHash = strings.ToUpper(tpmEKHash)
+	_, err := HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+	host := GetHostbyUUID(t, hostInv.GetUuid())
+	assert.Equal(t, hostSN, host.GetSerialNumber())
+	assert.Equal(t, "edge-sku-a", host.GetProductName())
+	assert.Equal(t, tpmEKHash, hostState(t, hostInv).TPMEKHash)
+
+	// A report without identity keeps the pinned one
+	_, err = HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, &pb.UpdateHostSystemInfoByGUIDRequest{
+		HostGuid:   hostInv.GetUuid(),
+		SystemInfo: &pb.SystemInfo{BiosInfo: systemInfo1.GetSystemInfo().GetBiosInfo()},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, hostSN, GetHostbyUUID(t, hostInv.GetUuid()).GetSerialNumber())
+
+	testCases := map[string]func(hwInfo *pb.HWInfo){
+		"SerialNumber": func(hwInfo *pb.HWInfo) { hwInfo.SerialNum = "cloned" },
+		"ProductName":  func(hwInfo *pb.HWInfo) { hwInfo.ProductName = "edge-sku-b" },
+		"TPMEKHash":    func(hwInfo *pb.HWInfo) { hwInfo.TpmEkHash = strings.Repeat("cd", 32) },
+	}
+	for tcName, mismatch := range testCases {
+		t.Run(tcName, func(t *testing.T) {
+			cloned, ok := proto.Clone(in).(*pb.UpdateHostSystemInfoByGUIDRequest)
+			require.True(t, ok)
+			mismatch(cloned.GetSystemInfo().GetHwInfo())
+			cloned.SystemInfo.HwInfo.Memory.Size = 32 << 30
+			_, err := HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, cloned)
+			require.Error(t, err)
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+			// Nothing is applied, the host is not quarantined by default
+			host := GetHostbyUUID(t, hostInv.GetUuid())
+			assert.Equal(t, hostSN, host.GetSerialNumber())
+			assert.Equal(t, "edge-sku-a", host.GetProductName())
+			assert.Equal(t, systemInfo1.GetSystemInfo().GetHwInfo().GetMemory().GetSize(), host.GetMemoryBytes())
+			assert.False(t, hostState(t, hostInv).IsQuarantined())
+		})
+	}
+}
+
+func TestHostManagerClient_QuarantinedIdentity(t *testing.T) {
+	hostmgr.SetIdentityMismatchPolicy(hmgr_util.IdentityMismatchQuarantine)
+	t.Cleanup(func() { hostmgr.SetIdentityMismatchPolicy(hmgr_util.IdentityMismatchReject) })
+
+	hostInv := createProvisionedHost(t, func(host *computev1.HostResource) { host.SerialNumber = hostSN })
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	in, ok := proto.Clone(systemInfo1).(*pb.UpdateHostSystemInfoByGUIDRequest)
+	require.True(t, ok)
+	in.HostGuid = hostInv.GetUuid()
+	in.SystemInfo.HwInfo.SerialNum = "cloned"
+	_, err := HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	host := GetHostbyUUID(t, hostInv.GetUuid())
+	assert.Equal(t, hostSN, host.GetSerialNumber())
+	assert.Equal(t, hrm_status.HostStatusQuarantined.Status, host.GetHostStatus())
+	assert.Equal(t, hrm_status.HostStatusQuarantined.StatusIndicator, host.GetHostStatusIndicator())
+	assert.Equal(t, "serial number: pinned "+hostSN+", reported cloned", hostState(t, hostInv).IdentityMismatch)
+
+	// Every report is rejected until the host is released, the status is kept
+	in.SystemInfo.HwInfo.SerialNum = hostSN
+	_, err = HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = HostManagerTestClient.UpdateHostStatusByHostGuid(ctx, &pb.UpdateHostStatusByHostGuidRequest{
+		HostGuid:   hostInv.GetUuid(),
+		HostStatus: &pb.HostStatus{HostStatus: pb.HostStatus_RUNNING},
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, hrm_status.HostStatusQuarantined.Status, GetHostbyUUID(t, hostInv.GetUuid()).GetHostStatus())
+
+	// The quarantine is not kept in the metadata, editing them does not release the host
+	err = invclient.UpdateInvResourceFields(ctx, inv_testing.TestClients[inv_testing.RMClient].GetTenantAwareInventoryClient(),
+		tenant1, &computev1.HostResource{ResourceId: hostInv.GetResourceId(), Metadata: "[]"},
+		[]string{computev1.HostResourceFieldMetadata})
+	require.NoError(t, err)
+	_, err = HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Released by an admin request
+	admin := pb.NewHostmgrAdminClient(startAdminServer(t))
+	adminCtx, adminCancel := inv_testing.CreateContextWithJWT(t, tenant1)
+	defer adminCancel()
+	state, err := admin.GetHostState(adminCtx, &pb.GetHostStateRequest{ResourceId: hostInv.GetResourceId()})
+	require.NoError(t, err)
+	assert.True(t, state.GetQuarantined())
+	assert.Equal(t, "serial number: pinned "+hostSN+", reported cloned", state.GetIdentityMismatch())
+	_, err = admin.ReleaseHost(adminCtx, &pb.ReleaseHostRequest{ResourceId: hostInv.GetResourceId()})
+	require.NoError(t, err)
+	_, err = admin.ReleaseHost(adminCtx, &pb.ReleaseHostRequest{ResourceId: hostInv.GetResourceId()})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.False(t, hostState(t, hostInv).IsQuarantined())
+	_, err = HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+}
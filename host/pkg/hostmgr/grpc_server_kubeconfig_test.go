@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/secretstore"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+func TestHostManagerClient_Kubeconfig(t *testing.T) {
+	// The kubeconfig was stored in clear by previous versions
+	hostInv := createProvisionedHost(t, inv_testing.HostMetadata(
+		`[{"key":"cluster-name","value":"edge"},{"key":"kubeconfig","value":"old-kubeconfig"}]`))
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	in, ok := proto.Clone(systemInfo1).(*pb.UpdateHostSystemInfoByGUIDRequest)
+	require.True(t, ok)
+	in.HostGuid = hostInv.GetUuid()
+	in.SystemInfo.KcInfo = &pb.ClusterInfo{Kubeconfig: "kubeconfig"}
+	_, err := HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+
+	// Only a reference to the secret is kept, the user metadata is merged
+	key := secretstore.KubeconfigKey(hmgr_util.NewTenantIDResourceIDTupleFromHost(hostInv))
+	host := GetHostbyUUID(t, hostInv.GetUuid())
+	assert.JSONEq(t,
+		`[{"key":"cluster-name","value":"edge"},{"key":"kubeconfig-ref","value":"`+secretstore.Ref(key)+`"},`+
+			`{"key":"label/core_type","value":"E-Cores,P-Cores"},{"key":"label/cpu.arch","value":"x86"},`+
+			`{"key":"label/cpu.vendor","value":"intel"},{"key":"label/gpu.present","value":"false"}]`,
+		host.GetMetadata())
+	assert.NotContains(t, host.GetMetadata(), `"kubeconfig"`)
+	kubeconfig, err := secretstore.Default().Get(key)
+	require.NoError(t, err)
+	assert.Equal(t, "kubeconfig", string(kubeconfig))
+
+	// Reporting the same kubeconfig again changes nothing
+	_, err = HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+	assert.Equal(t, host.GetMetadata(), GetHostbyUUID(t, hostInv.GetUuid()).GetMetadata())
+
+	// A new kubeconfig replaces the secret, the reference is unchanged
+	in.SystemInfo.KcInfo.Kubeconfig = "new-kubeconfig"
+	_, err = HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+	assert.Equal(t, host.GetMetadata(), GetHostbyUUID(t, hostInv.GetUuid()).GetMetadata())
+	kubeconfig, err = secretstore.Default().Get(key)
+	require.NoError(t, err)
+	assert.Equal(t, "new-kubeconfig", string(kubeconfig))
+}
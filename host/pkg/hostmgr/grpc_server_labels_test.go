@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/labels"
+)
+
+func TestHostManagerClient_Labels(t *testing.T) {
+	hostInv := createProvisionedHost(t, inv_testing.HostMetadata(`[{"key":"cluster-name","value":"edge"}]`))
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	in, ok := proto.Clone(systemInfo1).(*pb.UpdateHostSystemInfoByGUIDRequest)
+	require.True(t, ok)
+	in.HostGuid = hostInv.GetUuid()
+	in.SystemInfo.HwInfo.Cpu.Features = []string{"avx2", "avx512f"}
+	in.SystemInfo.HwInfo.Memory.Size = 64 << 30
+	in.SystemInfo.HwInfo.Network = []*pb.SystemNetwork{
+		{Name: "eth0", Mac: "90:49:fa:07:6c:fd", Mtu: 1500, Sriovenabled: true, SriovVfsTotal: 8},
+	}
+	_, err := HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+
+	metadata := hostMetadata(t, hostInv.GetUuid())
+	assert.Equal(t, "edge", metadata["cluster-name"])
+	assert.Equal(t, "true", metadata[labels.MetadataKeyPrefix+"cpu.avx512"])
+	assert.Equal(t, "true", metadata[labels.MetadataKeyPrefix+"mem.gb>=64"])
+	assert.Equal(t, "true", metadata[labels.MetadataKeyPrefix+"nic.sriov"])
+	assert.Equal(t, "E-Cores,P-Cores", metadata[labels.MetadataKeyPrefix+"core_type"])
+
+	// The labels follow the reported hardware
+	in.SystemInfo.HwInfo.Network = []*pb.SystemNetwork{}
+	_, err = HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+	metadata = hostMetadata(t, hostInv.GetUuid())
+	assert.NotContains(t, metadata, labels.MetadataKeyPrefix+"nic.sriov")
+	assert.Equal(t, "true", metadata[labels.MetadataKeyPrefix+"cpu.avx512"])
+
+	// The rules are configurable
+	labels.SetDefault(&labels.RuleSet{Rules: []labels.Rule{
+		{Label: "cpu.cores>=8", Fact: labels.FactCPUCores, Op: labels.OpGreaterOrEqual, Value: "8"},
+	}})
+	t.Cleanup(func() { labels.SetDefault(labels.DefaultRules()) })
+	_, err = HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+	metadata = hostMetadata(t, hostInv.GetUuid())
+	assert.Equal(t, "true", metadata[labels.MetadataKeyPrefix+"cpu.cores>=8"])
+	assert.NotContains(t, metadata, labels.MetadataKeyPrefix+"cpu.avx512")
+	assert.Equal(t, "edge", metadata["cluster-name"])
+}
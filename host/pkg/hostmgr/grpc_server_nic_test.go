@@ -7,6 +7,7 @@ package hostmgr_test
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
@@ -659,3 +660,58 @@ func assertSameIPAddress(t *testing.T, expectedIPs, actualIPs []*networkv1.IPAdd
 			return inv_testing.ProtoEqualOrDiff(expected, actual)
 		})
 }
+
+// Verify a renamed NIC keeps its resource and IP addresses.
+func TestHostManagerClient_RenameNic(t *testing.T) {
+	dao := inv_testing.NewInvResourceDAOOrFail(t)
+	hostInv := dao.CreateHost(t, tenant1)
+	os := dao.CreateOs(t, tenant1)
+	dao.CreateInstanceWithOpts(t, tenant1, hostInv, os, true, func(inst *computev1.InstanceResource) {
+		inst.ProvisioningStatus = om_status.ProvisioningStatusDone.Status
+		inst.ProvisioningStatusIndicator = om_status.ProvisioningStatusDone.StatusIndicator
+	})
+	t.Cleanup(func() { HardDeleteHostnicResourcesWithUpdateHostSystemInfo(t, tenant1, systemInfo1) })
+
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	network := []*pb.SystemNetwork{
+		{
+			Name:  "eth0",
+			Mac:   "90:49:fa:07:6c:fa",
+			PciId: "0000:00:1f.6",
+			Mtu:   1500,
+			IpAddresses: []*pb.IPAddress{
+				{
+					IpAddress:         "192.168.0.11",
+					NetworkPrefixBits: 24,
+					ConfigMode:        pb.ConfigMode_CONFIG_MODE_STATIC,
+				},
+			},
+		},
+	}
+	systemInfo1.HostGuid = hostInv.GetUuid()
+	systemInfo1.SystemInfo.HwInfo.Network = network
+	_, err := HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, systemInfo1)
+	require.NoError(t, err)
+
+	host := GetHostbyUUID(t, hostInv.GetUuid())
+	require.Len(t, host.GetHostNics(), 1)
+	nicID := host.GetHostNics()[0].GetResourceId()
+	invIPs := GetIPbyNicID(t, nicID)
+	require.Len(t, invIPs, 1)
+	ipID := invIPs[0].GetResourceId()
+
+	// Predictable interface naming after an OS update
+	network[0].Name = "enp0s31f6"
+	_, err = HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, systemInfo1)
+	require.NoError(t, err)
+
+	host = GetHostbyUUID(t, hostInv.GetUuid())
+	require.Len(t, host.GetHostNics(), 1)
+	assert.Equal(t, nicID, host.GetHostNics()[0].GetResourceId())
+	assert.Equal(t, "enp0s31f6", host.GetHostNics()[0].GetDeviceName())
+	invIPs = GetIPbyNicID(t, nicID)
+	require.Len(t, invIPs, 1)
+	assert.Equal(t, ipID, invIPs[0].GetResourceId())
+}
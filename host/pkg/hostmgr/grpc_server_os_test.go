@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+func hostMetadata(t *testing.T, hostUUID string) map[string]string {
+	t.Helper()
+
+	metaList, err := hmgr_util.ParseMetadata(GetHostbyUUID(t, hostUUID).GetMetadata())
+	require.NoError(t, err)
+	metaMap, err := hmgr_util.MetadataToMetaMap(metaList)
+	require.NoError(t, err)
+	return metaMap
+}
+
+func TestHostManagerClient_OSInfo(t *testing.T) {
+	hostInv := createProvisionedHost(t, inv_testing.HostMetadata(`[{"key":"cluster-name","value":"edge"}]`))
+	instanceOS := GetHostbyUUID(t, hostInv.GetUuid()).GetInstance().GetOs()
+	require.NotNil(t, instanceOS)
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	in, ok := proto.Clone(systemInfo1).(*pb.UpdateHostSystemInfoByGUIDRequest)
+	require.True(t, ok)
+	in.HostGuid = hostInv.GetUuid()
+	in.SystemInfo.OsInfo = &pb.OsInfo{
+		Kernel: &pb.OsKernel{
+			Version: "6.12.0-1-emt",
+			Config:  []*pb.Config{{Key: "CONFIG_SMP", Value: "y"}},
+		},
+		Release: &pb.OsRelease{
+			Id:       "emt",
+			Version:  "3.0",
+			Metadata: []*pb.Metadata{{Key: hmgr_util.OSReleaseImageIDKey, Value: instanceOS.GetImageId()}},
+		},
+	}
+	_, err := HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+
+	metadata := hostMetadata(t, hostInv.GetUuid())
+	assert.Equal(t, "edge", metadata["cluster-name"])
+	assert.Equal(t, "6.12.0-1-emt", metadata[hmgr_util.OSKernelVersionMetadataKey])
+	assert.Equal(t, "emt 3.0", metadata[hmgr_util.OSReleaseMetadataKey])
+	assert.NotEmpty(t, metadata[hmgr_util.OSKernelConfigMetadataKey])
+	assert.NotContains(t, metadata, hmgr_util.OSDriftMetadataKey)
+
+	// The host has been reimaged out-of-band
+	in.SystemInfo.OsInfo.Release.Metadata[0].Value = "another-image"
+	_, err = HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+	metadata = hostMetadata(t, hostInv.GetUuid())
+	assert.Equal(t, "image_id: expected "+instanceOS.GetImageId()+", running another-image",
+		metadata[hmgr_util.OSDriftMetadataKey])
+
+	// The expected OS is running again
+	in.SystemInfo.OsInfo.Release.Metadata[0].Value = instanceOS.GetImageId()
+	_, err = HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+	metadata = hostMetadata(t, hostInv.GetUuid())
+	assert.NotContains(t, metadata, hmgr_util.OSDriftMetadataKey)
+	assert.Equal(t, "edge", metadata["cluster-name"])
+}
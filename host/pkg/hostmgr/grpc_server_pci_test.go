@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/pcidevice"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+func TestHostManagerClient_PCIDevices(t *testing.T) {
+	hostInv := createProvisionedHost(t)
+	hostID := hmgr_util.NewTenantIDResourceIDTupleFromHost(hostInv)
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	in, ok := proto.Clone(systemInfo1).(*pb.UpdateHostSystemInfoByGUIDRequest)
+	require.True(t, ok)
+	in.HostGuid = hostInv.GetUuid()
+	in.SystemInfo.HwInfo.Pci = []*pb.SystemPCI{
+		{DevClass: "0300", VendorId: "8086", DeviceId: "56a0", Slot: "0000:03:00.0", Driver: "i915"},
+		{DevClass: "1200", VendorId: "1172", DeviceId: "5052", Slot: "0000:04:00.0", NumaNode: 1},
+		// Older agents only report the class
+		{DevClass: "0600"},
+	}
+	_, err := HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+
+	devices, err := pcidevice.Default().Devices(hostID)
+	require.NoError(t, err)
+	assert.Equal(t, []pcidevice.Device{
+		{Slot: "0000:03:00.0", Class: "0300", VendorID: "8086", DeviceID: "56a0", Driver: "i915"},
+		{Slot: "0000:04:00.0", Class: "1200", VendorID: "1172", DeviceID: "5052", NumaNode: 1},
+	}, devices)
+	found, err := pcidevice.Find(pcidevice.Default(), tenant1, pcidevice.ByID("1172", "5052"))
+	require.NoError(t, err)
+	assert.Contains(t, found, hostID)
+
+	// The hosts having the FPGA are found through the admin service, with the IDs in any form
+	adminClient := pb.NewHostmgrAdminClient(startAdminServer(t))
+	adminCtx, adminCancel := inv_testing.CreateContextWithJWT(t, tenant1)
+	defer adminCancel()
+	resp, err := adminClient.FindHostsByPCIDevice(adminCtx, &pb.FindHostsByPCIDeviceRequest{VendorId: "0x1172", DeviceId: "5052"})
+	require.NoError(t, err)
+	expected := &pb.HostPCIDevices{
+		ResourceId: hostInv.GetResourceId(),
+		Devices: []*pb.PCIDevice{
+			{Slot: "0000:04:00.0", DevClass: "1200", VendorId: "1172", DeviceId: "5052", NumaNode: 1},
+		},
+	}
+	assert.True(t, slices.ContainsFunc(resp.GetHosts(), func(host *pb.HostPCIDevices) bool {
+		return proto.Equal(expected, host)
+	}))
+	_, err = adminClient.FindHostsByPCIDevice(adminCtx, &pb.FindHostsByPCIDeviceRequest{VendorId: "intel"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The GPU driver changed and the FPGA has been removed
+	in.SystemInfo.HwInfo.Pci = in.SystemInfo.HwInfo.Pci[:1]
+	in.SystemInfo.HwInfo.Pci[0].Driver = "xe"
+	_, err = HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+	devices, err = pcidevice.Default().Devices(hostID)
+	require.NoError(t, err)
+	assert.Equal(t, []pcidevice.Device{
+		{Slot: "0000:03:00.0", Class: "0300", VendorID: "8086", DeviceID: "56a0", Driver: "xe"},
+	}, devices)
+
+	// The same slot cannot be reported twice
+	in.SystemInfo.HwInfo.Pci = append(in.SystemInfo.HwInfo.Pci, &pb.SystemPCI{Slot: "0000:03:00.0"})
+	_, err = HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
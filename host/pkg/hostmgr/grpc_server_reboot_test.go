@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hoststate"
+	"github.com/open-edge-platform/infra-managers/host/pkg/invclient"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+func TestHostManagerClient_Reboots(t *testing.T) {
+	hostInv := createProvisionedHost(t)
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+	updateStatus := func(bootID string) {
+		t.Helper()
+		_, err := HostManagerTestClient.UpdateHostStatusByHostGuid(ctx, &pb.UpdateHostStatusByHostGuidRequest{
+			HostGuid:   hostInv.GetUuid(),
+			HostStatus: &pb.HostStatus{HostStatus: pb.HostStatus_RUNNING, BootId: bootID, UptimeSeconds: 120},
+		})
+		require.NoError(t, err)
+	}
+
+	// The first boot ID is not a reboot
+	updateStatus("0b7a1e0c-3c6e-4c1d-9a51-8a8f2b6c4d01")
+	state := hostState(t, hostInv)
+	assert.Equal(t, "0b7a1e0c-3c6e-4c1d-9a51-8a8f2b6c4d01", state.BootID)
+	assert.False(t, state.BootTime.IsZero())
+	assert.Empty(t, state.LastReboot)
+
+	// Same boot, nothing changes
+	updateStatus("0b7a1e0c-3c6e-4c1d-9a51-8a8f2b6c4d01")
+	state = hostState(t, hostInv)
+	assert.Zero(t, state.RebootCount)
+	assert.Zero(t, state.UnexpectedRebootCount)
+
+	// Rebooted while running
+	updateStatus("5f2d9c3e-7a14-4b0e-8e6f-1c2d3e4f5a02")
+	state = hostState(t, hostInv)
+	assert.Equal(t, uint64(1), state.RebootCount)
+	assert.Equal(t, uint64(1), state.UnexpectedRebootCount)
+	assert.Equal(t, hoststate.UnexpectedReboot, state.LastReboot)
+	// The reported status is kept along with the boot
+	assert.Equal(t, pb.HostStatus_RUNNING, hmgr_util.ReportedHostStatus(GetHostbyUUID(t, hostInv.GetUuid())))
+
+	// The boots are not tracked in the metadata editable by the users
+	assert.NotContains(t, hostMetadata(t, hostInv.GetUuid()), "reboot-count")
+
+	// Rebooted after a restart has been requested, NB handler is not running in SB tests
+	err := invclient.UpdateInvResourceFields(ctx, inv_testing.TestClients[inv_testing.RMClient].GetTenantAwareInventoryClient(),
+		tenant1, &computev1.HostResource{
+			ResourceId:        hostInv.GetResourceId(),
+			DesiredPowerState: computev1.PowerState_POWER_STATE_RESET,
+		}, []string{computev1.HostResourceFieldDesiredPowerState})
+	require.NoError(t, err)
+	updateStatus("9c8b7a6d-5e4f-4a3b-8c2d-1e0f9a8b7c03")
+	state = hostState(t, hostInv)
+	assert.Equal(t, uint64(2), state.RebootCount)
+	assert.Equal(t, uint64(1), state.UnexpectedRebootCount)
+	assert.Equal(t, hmgr_util.RebootReasonRestart, state.LastReboot)
+}
@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
+	"github.com/open-edge-platform/infra-managers/host/pkg/alivemgr"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/invclient"
+	"github.com/open-edge-platform/infra-managers/host/pkg/sessionmgr"
+	hrm_status "github.com/open-edge-platform/infra-managers/host/pkg/status"
+	om_status "github.com/open-edge-platform/infra-onboarding/onboarding-manager/pkg/status"
+)
+
+func TestHostManagerClient_HostSession(t *testing.T) {
+	dao := inv_testing.NewInvResourceDAOOrFail(t)
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	hostInv := dao.CreateHost(t, tenant1)
+	osInv := dao.CreateOs(t, tenant1)
+	dao.CreateInstanceWithOpts(t, tenant1, hostInv, osInv, true, func(inst *computev1.InstanceResource) {
+		inst.ProvisioningStatus = om_status.ProvisioningStatusDone.Status
+		inst.ProvisioningStatusIndicator = om_status.ProvisioningStatusDone.StatusIndicator
+	})
+
+	stream, err := HostManagerTestClient.HostSession(ctx)
+	require.NoError(t, err)
+
+	// Status update
+	err = stream.Send(&pb.HostSessionRequest{
+		HostGuid: hostInv.GetUuid(),
+		HostStatus: &pb.HostStatus{
+			HostStatus: pb.HostStatus_RUNNING,
+		},
+	})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return GetHostbyUUID(t, hostInv.GetUuid()).GetHostStatus() == hrm_status.HostStatusRunning.Status
+	}, 5*time.Second, 100*time.Millisecond)
+	assert.True(t, alivemgr.IsHostTracked(hostInv))
+	assert.True(t, sessionmgr.IsSessionOpen(hostInv))
+
+	// Plain heartbeat
+	err = stream.Send(&pb.HostSessionRequest{HostGuid: hostInv.GetUuid()})
+	require.NoError(t, err)
+
+	// Power off requested through Inventory, NB handler is not running in SB tests
+	err = invclient.UpdateInvResourceFields(ctx, inv_testing.TestClients[inv_testing.RMClient].GetTenantAwareInventoryClient(),
+		tenant1, &computev1.HostResource{
+			ResourceId:        hostInv.GetResourceId(),
+			DesiredPowerState: computev1.PowerState_POWER_STATE_OFF,
+		}, []string{computev1.HostResourceFieldDesiredPowerState})
+	require.NoError(t, err)
+	require.True(t, sessionmgr.NotifyHostChanged(hostInv))
+
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, pb.HostStatusResp_SHUTDOWN, resp.GetHostAction())
+
+	// Unary status update returns the same action
+	respUnary, err := HostManagerTestClient.UpdateHostStatusByHostGuid(ctx, &pb.UpdateHostStatusByHostGuidRequest{
+		HostGuid: hostInv.GetUuid(),
+		HostStatus: &pb.HostStatus{
+			HostStatus: pb.HostStatus_RUNNING,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, pb.HostStatusResp_SHUTDOWN, respUnary.GetHostAction())
+
+	// Host UUID must match the session
+	err = stream.Send(&pb.HostSessionRequest{HostGuid: "BFD3B398-9A4B-480D-AB53-4050ED108F5D"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Error(t, err)
+	require.Eventually(t, func() bool {
+		return !sessionmgr.IsSessionOpen(hostInv)
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
+func TestHostManagerClient_HostSessionStatusesOnFreshHost(t *testing.T) {
+	hostInv := createProvisionedHost(t)
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	stream, err := HostManagerTestClient.HostSession(ctx)
+	require.NoError(t, err)
+	sendBootID := func(bootID string) {
+		t.Helper()
+		require.NoError(t, stream.Send(&pb.HostSessionRequest{
+			HostGuid:   hostInv.GetUuid(),
+			HostStatus: &pb.HostStatus{HostStatus: pb.HostStatus_RUNNING, BootId: bootID},
+		}))
+	}
+
+	// Each status is compared to the Host changed by the previous one, not to the Host of the session start
+	sendBootID("0b7a1e0c-3c6e-4c1d-9a51-8a8f2b6c4d01")
+	require.Eventually(t, func() bool {
+		return hostState(t, hostInv).BootID == "0b7a1e0c-3c6e-4c1d-9a51-8a8f2b6c4d01"
+	}, 5*time.Second, 100*time.Millisecond)
+	sendBootID("5f2d9c3e-7a14-4b0e-8e6f-1c2d3e4f5a02")
+	require.Eventually(t, func() bool {
+		return hostState(t, hostInv).RebootCount == 1
+	}, 5*time.Second, 100*time.Millisecond)
+	require.NoError(t, stream.CloseSend())
+}
+
+func TestHostManagerClient_HostSessionOrderedAsUnary(t *testing.T) {
+	hostInv := createProvisionedHost(t)
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	_, err := HostManagerTestClient.UpdateHostStatusByHostGuid(ctx, &pb.UpdateHostStatusByHostGuidRequest{
+		HostGuid:       hostInv.GetUuid(),
+		HostStatus:     &pb.HostStatus{HostStatus: pb.HostStatus_ERROR},
+		SequenceNumber: 10,
+	})
+	require.NoError(t, err)
+
+	stream, err := HostManagerTestClient.HostSession(ctx)
+	require.NoError(t, err)
+	// A plain heartbeat measures the clock skew of the host
+	require.NoError(t, stream.Send(&pb.HostSessionRequest{
+		HostGuid:         hostInv.GetUuid(),
+		AgentTimestampNs: uint64(time.Now().Add(10 * time.Minute).UnixNano()),
+	}))
+	require.Eventually(t, func() bool {
+		skew, ok := hostState(t, hostInv).ClockSkew()
+		return ok && skew > 9*time.Minute
+	}, 5*time.Second, 100*time.Millisecond)
+
+	// A status older than the one sent by UpdateHostStatusByHostGuid is ignored, a later one is applied
+	require.NoError(t, stream.Send(&pb.HostSessionRequest{
+		HostGuid:       hostInv.GetUuid(),
+		HostStatus:     &pb.HostStatus{HostStatus: pb.HostStatus_RUNNING, BootId: "0b7a1e0c-3c6e-4c1d-9a51-8a8f2b6c4d01"},
+		SequenceNumber: 9,
+	}))
+	require.NoError(t, stream.Send(&pb.HostSessionRequest{
+		HostGuid:       hostInv.GetUuid(),
+		HostStatus:     &pb.HostStatus{HostStatus: pb.HostStatus_ERROR, BootId: "5f2d9c3e-7a14-4b0e-8e6f-1c2d3e4f5a02"},
+		SequenceNumber: 11,
+	}))
+	require.Eventually(t, func() bool {
+		return hostState(t, hostInv).BootID == "5f2d9c3e-7a14-4b0e-8e6f-1c2d3e4f5a02"
+	}, 5*time.Second, 100*time.Millisecond)
+	assert.Zero(t, hostState(t, hostInv).RebootCount)
+	require.NoError(t, stream.CloseSend())
+}
+
+func TestHostManagerClient_HostSessionErrors(t *testing.T) {
+	// No JWT
+	stream, err := HostManagerTestClient.HostSession(context.Background())
+	require.NoError(t, err)
+	err = stream.Send(&pb.HostSessionRequest{HostGuid: hostGUID})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Error(t, err)
+
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	// Host does not exist
+	stream, err = HostManagerTestClient.HostSession(ctx)
+	require.NoError(t, err)
+	err = stream.Send(&pb.HostSessionRequest{HostGuid: hostGUID})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Error(t, err)
+
+	// Invalid Host UUID
+	stream, err = HostManagerTestClient.HostSession(ctx)
+	require.NoError(t, err)
+	err = stream.Send(&pb.HostSessionRequest{HostGuid: "not-a-uuid"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Error(t, err)
+
+	// Untrusted Host
+	dao := inv_testing.NewInvResourceDAOOrFail(t)
+	hostInv := dao.CreateHost(t, tenant1, func(host *computev1.HostResource) {
+		host.DesiredState = computev1.HostState_HOST_STATE_UNTRUSTED
+	})
+	stream, err = HostManagerTestClient.HostSession(ctx)
+	require.NoError(t, err)
+	err = stream.Send(&pb.HostSessionRequest{HostGuid: hostInv.GetUuid()})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Error(t, err)
+}
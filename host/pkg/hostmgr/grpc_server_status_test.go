@@ -0,0 +1,253 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hostcache"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hostmgr"
+	"github.com/open-edge-platform/infra-managers/host/pkg/invclient"
+	hrm_status "github.com/open-edge-platform/infra-managers/host/pkg/status"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+	test_utils "github.com/open-edge-platform/infra-managers/host/test/utils"
+)
+
+func TestHostManagerClient_HostStatusDetails(t *testing.T) {
+	hostInv := createProvisionedHost(t, inv_testing.HostMetadata(`[{"key":"cluster-name","value":"edge"}]`))
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	updateStatus := func(hostStatus *pb.HostStatus) error {
+		_, err := HostManagerTestClient.UpdateHostStatusByHostGuid(ctx, &pb.UpdateHostStatusByHostGuidRequest{
+			HostGuid:   hostInv.GetUuid(),
+			HostStatus: hostStatus,
+		})
+		return err
+	}
+
+	// The details are sanitized and stored along with the status
+	require.NoError(t, updateStatus(&pb.HostStatus{
+		HostStatus:          pb.HostStatus_ERROR,
+		Details:             "no space left\n\ton /var\x1b" + strings.Repeat("!", hmgr_util.MaxHostStatusDetailLength),
+		HumanReadableStatus: "Disk full",
+		ErrorCode:           "DISK_FULL",
+	}))
+	host := GetHostbyUUID(t, hostInv.GetUuid())
+	assert.Equal(t, hrm_status.HostStatusError.Status, host.GetHostStatus())
+	metadata := hostMetadata(t, hostInv.GetUuid())
+	assert.Equal(t, "edge", metadata["cluster-name"])
+	assert.Len(t, metadata[hmgr_util.HostStatusDetailsMetadataKey], hmgr_util.MaxHostStatusDetailLength)
+	assert.True(t, strings.HasPrefix(metadata[hmgr_util.HostStatusDetailsMetadataKey], "no space left on /var!"))
+	assert.Equal(t, "Disk full", metadata[hmgr_util.HostStatusMessageMetadataKey])
+	assert.Equal(t, "DISK_FULL", metadata[hmgr_util.HostStatusErrorCodeMetadataKey])
+
+	// A change of the details only is a status change
+	require.NoError(t, updateStatus(&pb.HostStatus{
+		HostStatus:          pb.HostStatus_ERROR,
+		HumanReadableStatus: "Disk failure",
+		ErrorCode:           "DISK_IO",
+	}))
+	metadata = hostMetadata(t, hostInv.GetUuid())
+	assert.NotContains(t, metadata, hmgr_util.HostStatusDetailsMetadataKey)
+	assert.Equal(t, "Disk failure", metadata[hmgr_util.HostStatusMessageMetadataKey])
+	assert.Equal(t, "DISK_IO", metadata[hmgr_util.HostStatusErrorCodeMetadataKey])
+
+	// Recovery clears the details
+	require.NoError(t, updateStatus(&pb.HostStatus{HostStatus: pb.HostStatus_RUNNING}))
+	host = GetHostbyUUID(t, hostInv.GetUuid())
+	assert.Equal(t, hrm_status.HostStatusRunning.Status, host.GetHostStatus())
+	metadata = hostMetadata(t, hostInv.GetUuid())
+	assert.Equal(t, map[string]string{"cluster-name": "edge"}, metadata)
+
+	// The error code is not free text
+	err := updateStatus(&pb.HostStatus{HostStatus: pb.HostStatus_ERROR, ErrorCode: "disk full"})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestHostManagerClient_HostStatusTransitions(t *testing.T) {
+	hostInv := createProvisionedHost(t)
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	updateStatus := func(hostStatus pb.HostStatus_HostStatus) error {
+		_, err := HostManagerTestClient.UpdateHostStatusByHostGuid(ctx, &pb.UpdateHostStatusByHostGuidRequest{
+			HostGuid:   hostInv.GetUuid(),
+			HostStatus: &pb.HostStatus{HostStatus: hostStatus},
+		})
+		return err
+	}
+
+	require.NoError(t, updateStatus(pb.HostStatus_RUNNING))
+
+	// A stale agent cannot move the host back
+	err := updateStatus(pb.HostStatus_REGISTERING)
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, hrm_status.HostStatusRunning.Status, GetHostbyUUID(t, hostInv.GetUuid()).GetHostStatus())
+
+	// The instance statuses go through the same transitions
+	_, err = HostManagerTestClient.UpdateInstanceStateStatusByHostGUID(ctx, &pb.UpdateInstanceStateStatusByHostGUIDRequest{
+		HostGuid:       hostInv.GetUuid(),
+		InstanceStatus: pb.InstanceStatus_INSTANCE_STATUS_PROVISIONING,
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// The Instance statuses have their own transitions, e.g. a running Instance is not initialized again
+	updateInstance := func(state pb.InstanceState, instanceStatus pb.InstanceStatus) error {
+		_, err := HostManagerTestClient.UpdateInstanceStateStatusByHostGUID(ctx, &pb.UpdateInstanceStateStatusByHostGUIDRequest{
+			HostGuid:       hostInv.GetUuid(),
+			InstanceState:  state,
+			InstanceStatus: instanceStatus,
+		})
+		return err
+	}
+	require.NoError(t, updateInstance(pb.InstanceState_INSTANCE_STATE_RUNNING, pb.InstanceStatus_INSTANCE_STATUS_RUNNING))
+	err = updateInstance(pb.InstanceState_INSTANCE_STATE_RUNNING, pb.InstanceStatus_INSTANCE_STATUS_INITIALIZING)
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	instance := GetHostbyUUID(t, hostInv.GetUuid()).GetInstance()
+	assert.Equal(t, hrm_status.InstanceStatusRunning.Status, instance.GetInstanceStatus())
+
+	// Once the connection is lost, the previous status is not relevant anymore
+	err = invclient.SetHostStatus(ctx, inv_testing.TestClients[inv_testing.RMClient].GetTenantAwareInventoryClient(),
+		tenant1, hostInv.GetResourceId(), hrm_status.HostStatusNoConnection)
+	require.NoError(t, err)
+	require.NoError(t, updateStatus(pb.HostStatus_REGISTERING))
+	require.NoError(t, updateStatus(pb.HostStatus_RUNNING))
+
+	// Illegal transitions are only logged if not enforced
+	hostmgr.EnforceStatusTransitionsValue = false
+	t.Cleanup(func() { hostmgr.EnforceStatusTransitionsValue = true })
+	require.NoError(t, updateStatus(pb.HostStatus_PROVISIONED))
+	assert.Equal(t, hrm_status.HostStatusUnknown.Status, GetHostbyUUID(t, hostInv.GetUuid()).GetHostStatus())
+}
+
+func TestHostManagerClient_StaleStatusUpdates(t *testing.T) {
+	hostInv := createProvisionedHost(t)
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	updateStatus := func(hostStatus pb.HostStatus_HostStatus, timestamp, sequence uint64) {
+		t.Helper()
+		_, err := HostManagerTestClient.UpdateHostStatusByHostGuid(ctx, &pb.UpdateHostStatusByHostGuidRequest{
+			HostGuid:         hostInv.GetUuid(),
+			HostStatus:       &pb.HostStatus{HostStatus: hostStatus},
+			AgentTimestampNs: timestamp,
+			SequenceNumber:   sequence,
+		})
+		require.NoError(t, err)
+	}
+	assertHostStatus := func(expected string) {
+		t.Helper()
+		assert.Equal(t, expected, GetHostbyUUID(t, hostInv.GetUuid()).GetHostStatus())
+	}
+
+	updateStatus(pb.HostStatus_RUNNING, 200, 2)
+	assertHostStatus(hrm_status.HostStatusRunning.Status)
+
+	// A delayed update cannot overwrite a newer one
+	updateStatus(pb.HostStatus_UPDATING, 100, 1)
+	assertHostStatus(hrm_status.HostStatusRunning.Status)
+
+	// Nor a replayed one
+	updateStatus(pb.HostStatus_ERROR, 200, 2)
+	assertHostStatus(hrm_status.HostStatusRunning.Status)
+
+	// The clock of the agent has been stepped back, the sequence number is authoritative
+	updateStatus(pb.HostStatus_ERROR, 50, 3)
+	assertHostStatus(hrm_status.HostStatusError.Status)
+
+	// The instance updates are ordered with the host status updates for the Host status, and on their own for the instance
+	updateInstance := func(instanceStatus pb.InstanceStatus, timestamp uint64) {
+		t.Helper()
+		_, err := HostManagerTestClient.UpdateInstanceStateStatusByHostGUID(ctx,
+			&pb.UpdateInstanceStateStatusByHostGUIDRequest{
+				HostGuid:         hostInv.GetUuid(),
+				InstanceState:    pb.InstanceState_INSTANCE_STATE_RUNNING,
+				InstanceStatus:   instanceStatus,
+				AgentTimestampNs: timestamp,
+			})
+		require.NoError(t, err)
+	}
+	updateInstance(pb.InstanceStatus_INSTANCE_STATUS_RUNNING, 100)
+	assertHostStatus(hrm_status.HostStatusRunning.Status)
+	updateInstance(pb.InstanceStatus_INSTANCE_STATUS_ERROR, 50)
+	assertHostStatus(hrm_status.HostStatusRunning.Status)
+
+	// A late instance update does not overwrite a later Host status
+	updateStatus(pb.HostStatus_ERROR, 400, 4)
+	updateInstance(pb.InstanceStatus_INSTANCE_STATUS_RUNNING, 150)
+	assertHostStatus(hrm_status.HostStatusError.Status)
+}
+
+func TestHostManagerClient_HostCache(t *testing.T) {
+	hostInv := createProvisionedHost(t)
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	hostcache.SetTTL(time.Minute)
+	t.Cleanup(func() { hostcache.SetTTL(0) })
+
+	updateStatus := func(hostStatus pb.HostStatus_HostStatus) {
+		t.Helper()
+		_, err := HostManagerTestClient.UpdateHostStatusByHostGuid(ctx, &pb.UpdateHostStatusByHostGuidRequest{
+			HostGuid:   hostInv.GetUuid(),
+			HostStatus: &pb.HostStatus{HostStatus: hostStatus},
+		})
+		require.NoError(t, err)
+	}
+	assertHostStatus := func(expected string) {
+		t.Helper()
+		assert.Equal(t, expected, GetHostbyUUID(t, hostInv.GetUuid()).GetHostStatus())
+	}
+
+	// The Host is read again after being changed by the Host Manager
+	updateStatus(pb.HostStatus_RUNNING)
+	assertHostStatus(hrm_status.HostStatusRunning.Status)
+	updateStatus(pb.HostStatus_ERROR)
+	assertHostStatus(hrm_status.HostStatusError.Status)
+	updateStatus(pb.HostStatus_RUNNING)
+	assertHostStatus(hrm_status.HostStatusRunning.Status)
+	// Served from the cache from now on
+	updateStatus(pb.HostStatus_RUNNING)
+	assert.Equal(t, 1, hostcache.Len())
+
+	// The changes made by others are not seen until the Host is invalidated, by the events in the NB handler
+	hostID := hmgr_util.NewTenantIDResourceIDTupleFromHost(hostInv)
+	err := invclient.SetHostStatus(ctx, inv_testing.TestClients[inv_testing.RMClient].GetTenantAwareInventoryClient(),
+		tenant1, hostInv.GetResourceId(), hrm_status.HostStatusNoConnection)
+	require.NoError(t, err)
+	updateStatus(pb.HostStatus_RUNNING)
+	assertHostStatus(hrm_status.HostStatusNoConnection.Status)
+
+	hostcache.Invalidate(hostID)
+	updateStatus(pb.HostStatus_RUNNING)
+	assertHostStatus(hrm_status.HostStatusRunning.Status)
+
+	// With the NB handler, the changes made by others invalidate the cached Host
+	test_utils.CreateHrmClient(t)
+	require.NoError(t, test_utils.CreateNBHandler(t))
+	require.NoError(t, test_utils.HostManagerNBHandler.Start())
+	t.Cleanup(test_utils.HostManagerNBHandler.Stop)
+	err = invclient.SetHostStatus(ctx, inv_testing.TestClients[inv_testing.RMClient].GetTenantAwareInventoryClient(),
+		tenant1, hostInv.GetResourceId(), hrm_status.HostStatusNoConnection)
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		updateStatus(pb.HostStatus_RUNNING)
+		return GetHostbyUUID(t, hostInv.GetUuid()).GetHostStatus() == hrm_status.HostStatusRunning.Status
+	}, 5*time.Second, 100*time.Millisecond)
+}
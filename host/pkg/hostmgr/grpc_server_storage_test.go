@@ -7,6 +7,7 @@ package hostmgr_test
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
@@ -305,3 +306,47 @@ func assertSameHostStorage(t *testing.T, expectedStorages, actualStorages []*com
 		}
 	}
 }
+
+// Verify storages keep their resource when their device names are swapped.
+func TestHostManagerClient_SwapStorages(t *testing.T) {
+	dao := inv_testing.NewInvResourceDAOOrFail(t)
+	hostInv := dao.CreateHost(t, tenant1)
+	os := dao.CreateOs(t, tenant1)
+	dao.CreateInstanceWithOpts(t, tenant1, hostInv, os, true, func(inst *computev1.InstanceResource) {
+		inst.ProvisioningStatus = om_status.ProvisioningStatusDone.Status
+		inst.ProvisioningStatusIndicator = om_status.ProvisioningStatusDone.StatusIndicator
+	})
+	t.Cleanup(func() { HardDeleteHoststoragesWithUpdateHostSystemInfo(t, tenant1, systemInfo1) })
+
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	storage := &pb.Storage{
+		Disk: []*pb.SystemDisk{
+			{Name: "sda", SerialNumber: "1234W45678U", Size: 1000000, Wwid: "0x50026b7684e50ff8"},
+			{Name: "sdb", SerialNumber: "1434W45678U", Size: 2000000, Wwid: "0x55cd2e415346252e"},
+		},
+	}
+	systemInfo1.HostGuid = hostInv.GetUuid()
+	systemInfo1.SystemInfo.HwInfo.Storage = storage
+	_, err := HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, systemInfo1)
+	require.NoError(t, err)
+
+	resourceIDs := make(map[string]string)
+	for _, invStorage := range GetHostbyUUID(t, hostInv.GetUuid()).GetHostStorages() {
+		resourceIDs[invStorage.GetWwid()] = invStorage.GetResourceId()
+	}
+	require.Len(t, resourceIDs, 2)
+
+	// Device names are swapped after a reboot
+	storage.Disk[0].Name, storage.Disk[1].Name = storage.Disk[1].Name, storage.Disk[0].Name
+	_, err = HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, systemInfo1)
+	require.NoError(t, err)
+
+	host := GetHostbyUUID(t, hostInv.GetUuid())
+	require.Len(t, host.GetHostStorages(), 2)
+	for _, invStorage := range host.GetHostStorages() {
+		assert.Equal(t, resourceIDs[invStorage.GetWwid()], invStorage.GetResourceId())
+	}
+	assertSameHostStorage(t, ConvertSystemDiskIntoHostStorages(t, storage, host), host.GetHostStorages())
+}
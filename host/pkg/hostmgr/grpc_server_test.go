@@ -5,6 +5,7 @@
 package hostmgr_test
 
 import (
+	"cmp"
 	"context"
 	"testing"
 	"time"
@@ -485,6 +486,7 @@ func TestHostManagerClient_UpdateHostSystemInfoByGUID(t *testing.T) { //nolint:f
 
 	for tcname, tc := range testcases {
 		t.Run(tcname, func(t *testing.T) {
+			pinnedSN := GetHostbyUUID(t, hostInv.GetUuid()).GetSerialNumber()
 			respGet, err = HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, tc.in)
 			if err != nil {
 				if tc.valid {
@@ -503,7 +505,8 @@ func TestHostManagerClient_UpdateHostSystemInfoByGUID(t *testing.T) { //nolint:f
 
 				// validate some fields
 				host := GetHostbyUUID(t, tc.in.GetHostGuid())
-				assert.Equal(t, tc.in.GetSystemInfo().GetHwInfo().GetSerialNum(), host.GetSerialNumber())
+				// The pinned serial number is kept if not reported
+				assert.Equal(t, cmp.Or(tc.in.GetSystemInfo().GetHwInfo().GetSerialNum(), pinnedSN), host.GetSerialNumber())
 				assert.Equal(t, tc.in.GetSystemInfo().GetHwInfo().GetCpu().GetCores(), host.GetCpuCores())
 
 				hostCPUTopology, marshalErr := hmgr_util.MarshalHostCPUTopology(
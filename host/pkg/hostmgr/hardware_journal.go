@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr
+
+import (
+	"time"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hwjournal"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+// hardwareChanges returns the differences between the Host stored in Inventory and the reported
+// system information. Failures are logged, they never block the update.
+func hardwareChanges(tenantID string, hostres *computev1.HostResource, systemInfo *pb.SystemInfo) []hwjournal.Change {
+	reported, err := reportedHardware(hostres, systemInfo)
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Cannot compute hardware changes of Host tID=%s, UUID=%s",
+			tenantID, hostres.GetUuid())
+		return nil
+	}
+	return hwjournal.Diff(hostres, reported)
+}
+
+// recordHardwareChanges records the changes in the hardware journal, once they have been applied to Inventory.
+// Failures are logged, the Host is already updated.
+func recordHardwareChanges(tenantID string, hostres *computev1.HostResource, changes []hwjournal.Change) {
+	if err := hwjournal.Default().Record(hmgr_util.NewTenantIDResourceIDTupleFromHost(hostres), time.Now(),
+		changes); err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Cannot record hardware changes of Host tID=%s, UUID=%s",
+			tenantID, hostres.GetUuid())
+	}
+}
+
+// reportedHardware builds the Host, with its storages, NICs, USBs and GPUs, described by the system information.
+func reportedHardware(hostres *computev1.HostResource, systemInfo *pb.SystemInfo) (*computev1.HostResource, error) {
+	reported, _, err := hmgr_util.PopulateHostResourceWithNewSystemInfo(systemInfo)
+	if err != nil {
+		return nil, err
+	}
+	hwInfo := systemInfo.GetHwInfo()
+	for _, disk := range hwInfo.GetStorage().GetDisk() {
+		storage, err := hmgr_util.PopulateHoststorageWithDiskInfo(disk, hostres)
+		if err != nil {
+			return nil, err
+		}
+		reported.HostStorages = append(reported.HostStorages, storage)
+	}
+	for _, network := range hwInfo.GetNetwork() {
+		nic, err := hmgr_util.PopulateHostnicWithNetworkInfo(network, hostres)
+		if err != nil {
+			return nil, err
+		}
+		reported.HostNics = append(reported.HostNics, nic)
+	}
+	for _, usbInfo := range hwInfo.GetUsb() {
+		usb, err := hmgr_util.PopulateHostusbWithUsbInfo(usbInfo, hostres)
+		if err != nil {
+			return nil, err
+		}
+		reported.HostUsbs = append(reported.HostUsbs, usb)
+	}
+	for _, gpuInfo := range hwInfo.GetGpu() {
+		gpu, err := hmgr_util.PopulateHostgpuWithGpuInfo(gpuInfo, hostres)
+		if err != nil {
+			return nil, err
+		}
+		reported.HostGpus = append(reported.HostGpus, gpu)
+	}
+	return reported, nil
+}
@@ -6,11 +6,11 @@ package hostmgr
 
 import (
 	"context"
+	"io"
 	"net"
 	"sync"
 	"time"
 
-	"github.com/cenkalti/backoff/v4"
 	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -24,24 +24,145 @@ import (
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tracing"
 	"github.com/open-edge-platform/infra-managers/host/pkg/alivemgr"
 	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/compliance"
 	"github.com/open-edge-platform/infra-managers/host/pkg/config"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hoststate"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hwjournal"
 	inv_mgr_cli "github.com/open-edge-platform/infra-managers/host/pkg/invclient"
+	"github.com/open-edge-platform/infra-managers/host/pkg/labels"
+	hrm_metrics "github.com/open-edge-platform/infra-managers/host/pkg/metrics"
+	"github.com/open-edge-platform/infra-managers/host/pkg/ordering"
+	"github.com/open-edge-platform/infra-managers/host/pkg/pcidevice"
+	"github.com/open-edge-platform/infra-managers/host/pkg/secretstore"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
 )
 
 var zlog = logging.GetLogger("HostManager")
 
 // TODO(max): remove global instances.
 var (
-	invClientInstance         inv_client.TenantAwareInventoryClient
-	AllowHostDiscoveryValue   = true  // Default value in flag
-	DisabledProvisioningValue = false // Default value in flag
+	invClientInstance             inv_client.TenantAwareInventoryClient
+	AllowHostDiscoveryValue       = true  // Default value in flag
+	DisabledProvisioningValue     = false // Default value in flag
+	SystemInfoDryRunValue         = false // Default value in flag
+	EnforceStatusTransitionsValue = true  // Default value in flag
 )
 
+// statusUpdates orders the status updates of each host, shared by the southbound servers.
+var statusUpdates = ordering.NewTracker()
+
+// ForgetStatusUpdates drops the status updates of a deleted host,
+// a host onboarded again with the same UUID starts a new sequence of updates.
+func ForgetStatusUpdates(tenantID, hostGUID string) {
+	statusUpdates.Forget(tenantID, hostGUID)
+}
+
 const (
 	// AllowHostDiscovery enables automatic host discovery.
 	AllowHostDiscovery = "allowHostDiscovery"
 	// AllowHostDiscoveryDescription provides description of the AllowHostDiscovery flag.
 	AllowHostDiscoveryDescription = "Flag to allow Host discovery automatically when it does not exist in the Inventory"
+	// HostDiscoveryRate sets the number of Hosts that can be discovered per minute and per tenant.
+	HostDiscoveryRate = "hostDiscoveryRate"
+	// HostDiscoveryRateDescription provides description of the HostDiscoveryRate flag.
+	HostDiscoveryRateDescription = "Flag to set the number of Hosts that can be discovered per minute and per tenant"
+	// HostDiscoveryRateValue is the default value of the HostDiscoveryRate flag.
+	HostDiscoveryRateValue = 10.0
+	// HostDiscoveryBurst sets the number of Hosts that can be discovered at once per tenant.
+	HostDiscoveryBurst = "hostDiscoveryBurst"
+	// HostDiscoveryBurstDescription provides description of the HostDiscoveryBurst flag.
+	HostDiscoveryBurstDescription = "Flag to set the number of Hosts that can be discovered at once per tenant"
+	// HostDiscoveryBurstValue is the default value of the HostDiscoveryBurst flag.
+	HostDiscoveryBurstValue = 5
+	// SystemInfoApplyParallelism sets the number of concurrent Inventory calls when applying system information.
+	SystemInfoApplyParallelism = "systemInfoApplyParallelism"
+	// SystemInfoApplyParallelismDescription provides description of the SystemInfoApplyParallelism flag.
+	SystemInfoApplyParallelismDescription = "Flag to set the number of concurrent Inventory calls " +
+		"when applying the system information of a Host"
+	// SystemInfoApplyParallelismValue is the default value of the SystemInfoApplyParallelism flag.
+	SystemInfoApplyParallelismValue = 8
+	// SystemInfoDryRun only logs the Inventory changes of the system information updates.
+	SystemInfoDryRun = "systemInfoDryRun"
+	// SystemInfoDryRunDescription provides description of the SystemInfoDryRun flag.
+	SystemInfoDryRunDescription = "Flag to log the Inventory changes planned for the system information " +
+		"of the Hosts without applying them"
+	// EnforceStatusTransitions rejects the host statuses that cannot follow the previous one.
+	EnforceStatusTransitions = "enforceStatusTransitions"
+	// EnforceStatusTransitionsDescription provides description of the EnforceStatusTransitions flag.
+	EnforceStatusTransitionsDescription = "Flag to reject the host statuses reported by agents that cannot follow " +
+		"the previous one, they are only logged otherwise"
+	// MinAgentVersions sets the minimum versions of the agents, below which the hosts are reported as outdated.
+	MinAgentVersions = "minAgentVersions"
+	// MinAgentVersionsDescription provides description of the MinAgentVersions flag.
+	MinAgentVersionsDescription = "Flag to set the minimum versions of the agents as a comma separated list of " +
+		"agent=version pairs (e.g. node-agent=1.2.0), the hosts running older agents are reported as outdated"
+	// IdentityMismatchPolicy sets the handling of the system information not matching the pinned host identity.
+	IdentityMismatchPolicy = "identityMismatchPolicy"
+	// IdentityMismatchPolicyDescription provides description of the IdentityMismatchPolicy flag.
+	IdentityMismatchPolicyDescription = "Flag to set the handling of the system information reported for a Host " +
+		"with another serial number, product name or TPM endorsement key than the pinned ones: reject the reports, " +
+		"or quarantine the Host until it is released through the admin service"
+	// IdentityMismatchPolicyValue is the default value of the IdentityMismatchPolicy flag.
+	IdentityMismatchPolicyValue = "reject"
+	// ClockSkewThreshold sets the clock skew above which the status indication of the running hosts is degraded.
+	ClockSkewThreshold = "clockSkewThreshold"
+	// ClockSkewThresholdDescription provides description of the ClockSkewThreshold flag.
+	ClockSkewThresholdDescription = "Flag to set the skew between the clock of a Host, reported by its agent, and " +
+		"the one of the host manager above which the status indication of the running Host is degraded, 0 to disable it"
+	// ClockSkewThresholdValue is the default value of the ClockSkewThreshold flag.
+	ClockSkewThresholdValue = time.Minute
+	// HostStateDir sets the directory where the state tracked for the hosts, e.g. their pinned identity, is persisted.
+	HostStateDir = "hostStateDir"
+	// HostStateDirDescription provides description of the HostStateDir flag.
+	HostStateDirDescription = "Flag to set the directory where the state tracked for the hosts is persisted, " +
+		"it is kept in memory if empty. The host manager does not start if the directory cannot be written"
+	// HostStateDirValue is the default value of the HostStateDir flag.
+	HostStateDirValue = hoststate.DefaultDir
+	// PCIDevicesDir sets the directory where the PCI devices reported by the hosts are persisted.
+	PCIDevicesDir = "pciDevicesDir"
+	// PCIDevicesDirDescription provides description of the PCIDevicesDir flag.
+	PCIDevicesDirDescription = "Flag to set the directory where the PCI devices of hosts are persisted, " +
+		"they are kept in memory if empty. The host manager does not start if the directory cannot be written"
+	// PCIDevicesDirValue is the default value of the PCIDevicesDir flag.
+	PCIDevicesDirValue = pcidevice.DefaultDir
+	// HwJournalDir sets the directory where the hardware change journal of the hosts is persisted.
+	HwJournalDir = "hwJournalDir"
+	// HwJournalDirDescription provides description of the HwJournalDir flag.
+	HwJournalDirDescription = "Flag to set the directory where the hardware change journal is persisted, " +
+		"it is kept in memory if empty. The host manager does not start if the directory cannot be written"
+	// HwJournalDirValue is the default value of the HwJournalDir flag.
+	HwJournalDirValue = hwjournal.DefaultDir
+	// HwJournalMaxEntries sets the number of hardware change journal entries kept per host.
+	HwJournalMaxEntries = "hwJournalMaxEntries"
+	// HwJournalMaxEntriesDescription provides description of the HwJournalMaxEntries flag.
+	HwJournalMaxEntriesDescription = "Flag to set the number of hardware change journal entries kept per host"
+	// HwJournalMaxEntriesValue is the default value of the HwJournalMaxEntries flag.
+	HwJournalMaxEntriesValue = hwjournal.DefaultMaxEntries
+	// LabelRules sets the path of the rules deriving the host labels from the system information.
+	LabelRules = "labelRules"
+	// LabelRulesDescription provides description of the LabelRules flag.
+	LabelRulesDescription = "Flag to set the path of the JSON file with the rules deriving the host labels from " +
+		"the system information, the built-in rules are used if empty. The host manager does not start if it is invalid"
+	// ComplianceProfiles sets the path of the compliance profiles of the hosts.
+	ComplianceProfiles = "complianceProfiles"
+	// ComplianceProfilesDescription provides description of the ComplianceProfiles flag.
+	ComplianceProfilesDescription = "Flag to set the path of the YAML file with the compliance profiles of the hosts, " +
+		"the compliance is not evaluated if empty. The host manager does not start if it is invalid"
+	// RbacRulesValue is the default value of the RBAC rules flag of the southbound API.
+	RbacRulesValue = "/rego/authz.rego"
+	// AdminRbacRules sets the path of the RBAC rules of the admin service, served on the OAM port.
+	AdminRbacRules = "adminRbacRules"
+	// AdminRbacRulesDescription provides description of the AdminRbacRules flag.
+	AdminRbacRulesDescription = "Flag to set the path of the RBAC rules of the admin service, served on the OAM port"
+	// AdminRbacRulesValue is the default value of the AdminRbacRules flag.
+	AdminRbacRulesValue = "/rego/admin.rego"
+	// OperatorRbacRules sets the path of the RBAC rules of the admin requests changing the settings of all tenants.
+	OperatorRbacRules = "operatorRbacRules"
+	// OperatorRbacRulesDescription provides description of the OperatorRbacRules flag.
+	OperatorRbacRulesDescription = "Flag to set the path of the RBAC rules of the admin requests changing the settings " +
+		"shared by all tenants, granted to the operators of the platform only"
+	// OperatorRbacRulesValue is the default value of the OperatorRbacRules flag.
+	OperatorRbacRulesValue = "/rego/operator.rego"
 	// DisabledProvisioning toggles provisioning-related checks in the host manager.
 	DisabledProvisioning = "disabledProvisioning"
 	// DisabledProvisioningDescription provides description of the DisabledProvisioning flag.
@@ -76,6 +197,20 @@ func WithRbacRulesPath(rbacPath string) Option {
 	}
 }
 
+// WithAdminRbacRulesPath sets the path to the RBAC rules of the admin service.
+func WithAdminRbacRulesPath(rbacPath string) Option {
+	return func(o *Options) {
+		o.adminRbacRulesPath = rbacPath
+	}
+}
+
+// WithOperatorRbacRulesPath sets the path to the RBAC rules of the admin requests changing the settings of all tenants.
+func WithOperatorRbacRulesPath(rbacPath string) Option {
+	return func(o *Options) {
+		o.operatorRbacRulesPath = rbacPath
+	}
+}
+
 // EnableMetrics enables metrics collection for the host manager.
 func EnableMetrics(enable bool) Option {
 	return func(o *Options) {
@@ -100,11 +235,13 @@ func parseOptions(opts ...Option) *Options {
 
 // Options contains configuration options for the host manager.
 type Options struct {
-	enableAuth     bool
-	enableTracing  bool
-	rbacRulesPath  string
-	enableMetrics  bool
-	metricsAddress string
+	enableAuth            bool
+	enableTracing         bool
+	rbacRulesPath         string
+	adminRbacRulesPath    string
+	operatorRbacRulesPath string
+	enableMetrics         bool
+	metricsAddress        string
 }
 
 // Option is a functional option for configuring the host manager.
@@ -158,11 +295,69 @@ func StartInvGrpcCli(
 	SetInvGrpcCli(gcli)
 	zlog.InfraSec().Info().Msg("initial Grpc Client preparation is done.")
 	AllowHostDiscoveryValue = conf.EnableHostDiscovery
+	if conf.EnableHostDiscovery {
+		SetHostDiscoveryRateLimit(conf.HostDiscoveryRate, conf.HostDiscoveryBurst)
+	}
 	DisabledProvisioningValue = conf.DisabledProvisioning
+	SetSystemInfoApplyParallelism(conf.SystemInfoApplyParallelism)
+	SystemInfoDryRunValue = conf.SystemInfoDryRun
+	EnforceStatusTransitionsValue = conf.EnforceStatusTransitions
+	if err = SetMinAgentVersions(conf.MinAgentVersions); err != nil {
+		return nil, nil, err
+	}
+	policy, err := hmgr_util.ParseIdentityMismatchPolicy(conf.IdentityMismatchPolicy)
+	if err != nil {
+		return nil, nil, err
+	}
+	SetIdentityMismatchPolicy(policy)
+	SetClockSkewThreshold(conf.ClockSkewThreshold)
+	secretstore.SetDefault(secretstore.Open(conf.DisableCredentialsManagement))
+	if err = loadHostData(conf); err != nil {
+		return nil, nil, err
+	}
 
 	return gcli, events, nil
 }
 
+// loadHostData loads the configuration and opens the stores of the data derived from the reports of the hosts.
+func loadHostData(conf config.HostMgrConfig) error {
+	states, err := hoststate.Open(conf.HostStateDir)
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Cannot open the host state store")
+		return err
+	}
+	hoststate.SetDefault(states)
+	pciDevices, err := pcidevice.Open(conf.PCIDevicesDir)
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Cannot open the PCI device store")
+		return err
+	}
+	pcidevice.SetDefault(pciDevices)
+	maxEntries := conf.HwJournalMaxEntries
+	if maxEntries == 0 {
+		maxEntries = hwjournal.DefaultMaxEntries
+	}
+	journal, err := hwjournal.Open(conf.HwJournalDir, maxEntries)
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Cannot open the hardware journal")
+		return err
+	}
+	hwjournal.SetDefault(journal)
+	rules, err := labels.LoadRules(conf.LabelRulesPath)
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Cannot load the label rules")
+		return err
+	}
+	labels.SetDefault(rules)
+	profiles, err := compliance.LoadProfiles(conf.ComplianceProfilesPath)
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Cannot load the compliance profiles")
+		return err
+	}
+	compliance.SetDefault(profiles)
+	return nil
+}
+
 // SetInvGrpcCli sets the inventory gRPC client.
 func SetInvGrpcCli(gcli inv_client.TenantAwareInventoryClient) {
 	invClientInstance = gcli
@@ -175,6 +370,15 @@ func CloseInvGrpcCli() {
 	}
 }
 
+// CloseHostData persists the state of the hosts that has not been written yet.
+func CloseHostData() {
+	if closer, ok := hoststate.Default().(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			zlog.Warn().Err(err).Msg("Failed to persist the state of the hosts")
+		}
+	}
+}
+
 // StartGrpcSrv starts the host manager gRPC server.
 func StartGrpcSrv(
 	lis net.Listener,
@@ -189,11 +393,13 @@ func StartGrpcSrv(
 
 	var srvOpts []grpc.ServerOption
 	var unaryInter []grpc.UnaryServerInterceptor
+	var streamInter []grpc.StreamServerInterceptor
 
 	srvMetrics := inv_metrics.GetServerMetricsWithLatency()
 	if opts.enableMetrics {
 		zlog.Info().Msgf("Metrics exporter is enabled")
 		unaryInter = append(unaryInter, srvMetrics.UnaryServerInterceptor())
+		streamInter = append(streamInter, srvMetrics.StreamServerInterceptor())
 	}
 
 	// Enables tracing in gRPC southbound server
@@ -212,16 +418,20 @@ func StartGrpcSrv(
 		}
 	}
 
-	unaryInter = append(unaryInter, tenant.GetExtractTenantIDInterceptor(tenant.GetAgentsRole()))
+	tenantInterceptor := tenant.GetExtractTenantIDInterceptor(tenant.GetAgentsRole())
+	unaryInter = append(unaryInter, tenantInterceptor)
+	streamInter = append(streamInter, streamInterceptorFromUnary(tenantInterceptor))
 
-	srvOpts = append(srvOpts, grpc.ChainUnaryInterceptor(unaryInter...))
+	srvOpts = append(srvOpts, grpc.ChainUnaryInterceptor(unaryInter...), grpc.ChainStreamInterceptor(streamInter...))
 
 	// Create a gRPC server object
 	s := grpc.NewServer(srvOpts...)
 	// Attach the hostmgr service to the server
 	pb.RegisterHostmgrServer(s, &server{
-		rbac:        opaPolicy,
-		authEnabled: opts.enableAuth,
+		rbac:           opaPolicy,
+		authEnabled:    opts.enableAuth,
+		tracingEnabled: opts.enableTracing,
+		updates:        statusUpdates,
 	})
 	reflection.Register(s)
 	// Serve gRPC server when signal is ready
@@ -230,8 +440,9 @@ func StartGrpcSrv(
 	if opts.enableMetrics {
 		// Register metrics
 		srvMetrics.InitializeMetrics(s)
-		inv_metrics.StartMetricsExporter([]prometheus.Collector{inv_metrics.GetClientMetricsWithLatency(), srvMetrics},
-			inv_metrics.WithListenAddress(opts.metricsAddress))
+		collectors := append([]prometheus.Collector{inv_metrics.GetClientMetricsWithLatency(), srvMetrics},
+			hrm_metrics.Collectors()...)
+		inv_metrics.StartMetricsExporter(collectors, inv_metrics.WithListenAddress(opts.metricsAddress))
 	}
 
 	wg.Add(1)
@@ -257,38 +468,47 @@ func StartGrpcSrv(
 	wg.Done()
 }
 
-// StartAvailableManager starts the availability manager.
+// serverStreamWithContext overrides the context of a grpc.ServerStream.
+type serverStreamWithContext struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStreamWithContext) Context() context.Context {
+	return s.ctx
+}
+
+// streamInterceptorFromUnary runs a unary interceptor, that only enriches the context
+// (e.g., the tenant ID extraction), before a streaming call.
+func streamInterceptorFromUnary(unaryInter grpc.UnaryServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		unaryInfo := &grpc.UnaryServerInfo{Server: srv, FullMethod: info.FullMethod}
+		_, err := unaryInter(ss.Context(), nil, unaryInfo, func(ctx context.Context, _ any) (any, error) {
+			return nil, handler(srv, &serverStreamWithContext{ServerStream: ss, ctx: ctx})
+		})
+		return err
+	}
+}
+
+// StartAvailableManager starts the availability manager. The hosts that lose their heartbeat are updated as
+// "No Connection" in Inventory by a bounded pool of workers, the updates are paused during connection-loss storms.
 func StartAvailableManager(termChan chan bool) {
 	ctx := context.Background()
 	zlog.Info().Msg("Start AvailableManager!!!")
 	loseConnHosts := alivemgr.StartAlvMgr(termChan)
 	connLostTimeout := time.Duration(nOperationInventoryHostConnLost * float64(*inv_mgr_cli.InventoryTimeout))
+	updater := newConnLostUpdater(ctx, connLostTimeout)
+	ticker := time.NewTicker(connLostResumeInterval)
+	defer ticker.Stop()
 
 	for {
-		if hbk := <-loseConnHosts; !hbk.IsEmpty() {
-			go func() {
-				// Unix timestamps are always positive, so conversion from int64 to uint64 is safe
-				now := time.Now().Unix()
-				timestampConnLost := uint64(now)
-				if err := backoff.Retry(func() error {
-					childCtx, cancel := context.WithTimeout(ctx, connLostTimeout)
-					defer cancel()
-					err := inv_mgr_cli.SetHostAsConnectionLost(
-						childCtx, invClientInstance, hbk.TenantID, hbk.ResourceID, timestampConnLost)
-					if err != nil {
-						zlog.InfraSec().Warn().Msgf(
-							"Failed to update %s status as CONNECTION_LOST, retrying in the next backoff interval",
-							hbk,
-						)
-					}
-					return err
-				}, backoff.WithMaxRetries(backoff.NewConstantBackOff(backoffInterval), backoffRetries)); err != nil {
-					zlog.InfraSec().InfraError(
-						"Failed to update %s status as CONNECTION_LOST, even after backoff",
-						hbk,
-					).Send()
-				}
-			}()
+		select {
+		case hbk := <-loseConnHosts:
+			if !hbk.IsEmpty() {
+				updater.lost(hbk, time.Now())
+			}
+		case now := <-ticker.C:
+			updater.resume(now)
 		}
 	}
 }
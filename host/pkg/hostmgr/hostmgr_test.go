@@ -6,13 +6,17 @@ package hostmgr_test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/policy/rbac"
 	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
 	"github.com/open-edge-platform/infra-managers/host/pkg/alivemgr"
 	"github.com/open-edge-platform/infra-managers/host/pkg/config"
@@ -94,3 +98,23 @@ func TestHostManager_ConnectionLost(t *testing.T) {
 
 	termChan <- true
 }
+
+// TestDefaultRbacRules checks that the RBAC rules at the default paths of the binary are shipped in the image
+// and are valid, the host manager does not start otherwise.
+func TestDefaultRbacRules(t *testing.T) {
+	dockerfile, err := os.ReadFile("../../Dockerfile")
+	require.NoError(t, err)
+	for _, path := range []string{
+		hostmgr.RbacRulesValue,
+		hostmgr.AdminRbacRulesValue,
+		hostmgr.OperatorRbacRulesValue,
+	} {
+		t.Run(path, func(t *testing.T) {
+			// The image copies the rules of the rego directory to their default path
+			name := filepath.Base(path)
+			assert.Contains(t, string(dockerfile), "/rego/"+name+" "+path+"\n")
+			_, err := rbac.New(filepath.Join("../../rego", name))
+			require.NoError(t, err)
+		})
+	}
+}
@@ -29,7 +29,9 @@ import (
 	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/util"
 	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hostcache"
 	"github.com/open-edge-platform/infra-managers/host/pkg/hostmgr"
+	"github.com/open-edge-platform/infra-managers/host/pkg/secretstore"
 	hutils "github.com/open-edge-platform/infra-managers/host/pkg/utils"
 	test_utils "github.com/open-edge-platform/infra-managers/host/test/utils"
 )
@@ -103,6 +105,10 @@ func StartHostManagerTestingEnvironment() {
 	// Sets gcli (inventory client) in hostmgr
 	hostmgr.SetInvGrpcCli(invClient)
 	// Not starting NB Handler, this is used in SB tests
+	// Without the events, the cached Hosts would not be invalidated when changed by the tests
+	hostcache.SetTTL(0)
+	// Vault is not available in the tests
+	secretstore.SetDefault(secretstore.NewMemoryStore())
 	// Bootstrap server
 	createHostManagerServer()
 	// Bootstrap the clients
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inv_errors "github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hoststate"
+	inv_mgr_cli "github.com/open-edge-platform/infra-managers/host/pkg/invclient"
+	hrm_metrics "github.com/open-edge-platform/infra-managers/host/pkg/metrics"
+	hrm_status "github.com/open-edge-platform/infra-managers/host/pkg/status"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+// identityMismatchPolicy is the handling of the reports not matching the pinned identity, set in StartInvGrpcCli.
+var identityMismatchPolicy = hmgr_util.IdentityMismatchReject
+
+// SetIdentityMismatchPolicy sets the handling of the reports not matching the pinned identity of their host.
+func SetIdentityMismatchPolicy(policy hmgr_util.IdentityMismatchPolicy) {
+	identityMismatchPolicy = policy
+}
+
+// checkHostIdentity rejects the system information of a quarantined host, or not matching the identity pinned
+// on the host. A mismatch is reported as a security event and, depending on the policy, the host is quarantined.
+// The TPM endorsement key hash is pinned when first reported, in the same update of the state of the host
+// as the check, so that concurrent reports cannot pin different keys.
+func checkHostIdentity(ctx context.Context, tenantID string, host *computev1.HostResource, hwInfo *pb.HWInfo,
+) error {
+	var mismatch string
+	state, err := hoststate.Default().Update(hmgr_util.NewTenantIDResourceIDTupleFromHost(host),
+		func(state *hoststate.State) bool {
+			if state.IsQuarantined() {
+				return false
+			}
+			mismatch = hmgr_util.IdentityMismatch(host, state.TPMEKHash, hwInfo)
+			if mismatch != "" {
+				if identityMismatchPolicy != hmgr_util.IdentityMismatchQuarantine {
+					return false
+				}
+				state.IdentityMismatch = mismatch
+				return true
+			}
+			return pinTPMEKHash(state, hwInfo)
+		})
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Failed to check the identity of Host tID=%s, UUID=%s",
+			tenantID, host.GetUuid())
+		return inv_errors.ErrorToSanitizedGrpcError(err)
+	}
+	if mismatch != "" {
+		hrm_metrics.IdentityMismatchReports.Inc()
+		zlog.InfraSec().InfraError("Identity mismatch of Host tID=%s, UUID=%s: %s", tenantID, host.GetUuid(), mismatch).
+			Msg("checkHostIdentity")
+	}
+	if state.IsQuarantined() {
+		// The status is set again if it could not be set when the host was quarantined
+		if err = quarantineHost(ctx, tenantID, host); err != nil {
+			return err
+		}
+	}
+	if mismatch != "" {
+		return inv_errors.Errorfc(codes.PermissionDenied,
+			"Host tID=%s, UUID=%s does not match its pinned identity", tenantID, host.GetUuid())
+	}
+	if state.IsQuarantined() {
+		return errHostQuarantined(tenantID, host)
+	}
+	return nil
+}
+
+// pinTPMEKHash pins the TPM endorsement key hash of the host when first reported, reporting whether it is pinned.
+func pinTPMEKHash(state *hoststate.State, hwInfo *pb.HWInfo) bool {
+	hash := strings.ToLower(strings.TrimSpace(hwInfo.GetTpmEkHash()))
+	if state.TPMEKHash != "" || hash == "" {
+		return false
+	}
+	state.TPMEKHash = hash
+	return true
+}
+
+func errHostQuarantined(tenantID string, host *computev1.HostResource) error {
+	zlog.InfraSec().InfraError("Host tID=%s, UUID=%s is quarantined, the message will not be handled",
+		tenantID, host.GetUuid()).Msg("errHostQuarantined")
+	return inv_errors.Errorfc(codes.PermissionDenied, "Host tID=%s, UUID=%s is quarantined", tenantID, host.GetUuid())
+}
+
+// quarantineHost sets the status of a quarantined host, so that it is noticed and released by an operator.
+func quarantineHost(ctx context.Context, tenantID string, host *computev1.HostResource) error {
+	if host.GetHostStatus() == hrm_status.HostStatusQuarantined.Status {
+		return nil
+	}
+	defer invalidateHost(host)
+	if err := inv_mgr_cli.SetHostStatus(ctx, invClientInstance, tenantID, host.GetResourceId(),
+		hrm_status.HostStatusQuarantined,
+	); err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Failed to quarantine Host tID=%s, UUID=%s", tenantID, host.GetUuid())
+		return inv_errors.ErrorToSanitizedGrpcError(err)
+	}
+	zlog.InfraSec().Warn().Msgf("Host tID=%s, UUID=%s has been quarantined", tenantID, host.GetUuid())
+	return nil
+}
+
+// releaseHost releases a quarantined host once its identity has been checked by an operator. The pinned
+// TPM endorsement key hash is dropped too, the one reported next is pinned. It reports whether the host was
+// quarantined.
+func releaseHost(host hmgr_util.TenantIDResourceIDTuple) (bool, error) {
+	var quarantined bool
+	_, err := hoststate.Default().Update(host, func(state *hoststate.State) bool {
+		quarantined = state.IsQuarantined()
+		if !quarantined {
+			return false
+		}
+		state.IdentityMismatch = ""
+		state.TPMEKHash = ""
+		return true
+	})
+	return quarantined, err
+}
+
+// keepPinnedIdentity keeps the pinned serial number and product name of the host when they are not reported.
+func (b *planBuilder) keepPinnedIdentity(updatedHostres *computev1.HostResource) {
+	if updatedHostres.GetSerialNumber() == "" {
+		updatedHostres.SerialNumber = b.host.GetSerialNumber()
+	}
+	if updatedHostres.GetProductName() == "" {
+		updatedHostres.ProductName = b.host.GetProductName()
+	}
+}
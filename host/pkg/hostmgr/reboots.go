@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr
+
+import (
+	"strconv"
+	"time"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	"github.com/open-edge-platform/infra-managers/host/pkg/alivemgr"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hoststate"
+	hrm_metrics "github.com/open-edge-platform/infra-managers/host/pkg/metrics"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+// trackHost updates the heartbeat of the host and records, in the state of the host, the boot reported with its
+// status and the clock skew measured on the agent time of the request, returning the updated state. The state is
+// kept in memory, so that it is read once per status without waiting for its persistence.
+func trackHost(tenantID string, host *computev1.HostResource, status *pb.HostStatus, agentTimeNs uint64,
+) (hoststate.State, error) {
+	if err := alivemgr.UpdateHostHeartBeat(host); err != nil {
+		zlog.Warn().Err(err).Msg("Failed to update host heartbeat")
+	}
+
+	now := time.Now()
+	reason := hmgr_util.ExpectedRebootReason(host)
+	skew, measured := measureClockSkew(agentTimeNs, now)
+	var rebooted, wasSkewed bool
+	state, err := hoststate.Default().Update(hmgr_util.NewTenantIDResourceIDTupleFromHost(host),
+		func(state *hoststate.State) bool {
+			wasSkewed = state.IsClockSkewed(clockSkewThreshold)
+			var booted bool
+			booted, rebooted = state.RecordBoot(status.GetBootId(), hmgr_util.BootTime(status, now), reason)
+			skewed := measured && state.RecordClockSkew(skew)
+			return booted || skewed
+		})
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Failed to record the boot and clock of Host tID=%s, UUID=%s",
+			tenantID, host.GetUuid())
+		return hoststate.State{}, err
+	}
+	if rebooted {
+		reportReboot(tenantID, host, reason)
+	}
+	if !wasSkewed && state.IsClockSkewed(clockSkewThreshold) {
+		zlog.InfraSec().Warn().Msgf("Clock of Host tID=%s, UUID=%s is skewed by %s",
+			tenantID, host.GetUuid(), skew.Round(time.Second))
+	}
+	return state, nil
+}
+
+// reportReboot reports a reboot of the host, expected if the host was in a state where it had to reboot,
+// otherwise it is reported as a security event.
+func reportReboot(tenantID string, host *computev1.HostResource, reason string) {
+	hrm_metrics.RebootEvents.WithLabelValues(strconv.FormatBool(reason != "")).Inc()
+	if reason == "" {
+		zlog.InfraSec().Warn().Msgf("Unexpected reboot of Host tID=%s, UUID=%s", tenantID, host.GetUuid())
+	} else {
+		zlog.Info().Msgf("Reboot of Host tID=%s, UUID=%s (%s)", tenantID, host.GetUuid(), reason)
+	}
+}
@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inv_errors "github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/policy/rbac"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tenant"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tracing"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	hmgr_errors "github.com/open-edge-platform/infra-managers/host/pkg/errors"
+	"github.com/open-edge-platform/infra-managers/host/pkg/ordering"
+	"github.com/open-edge-platform/infra-managers/host/pkg/sessionmgr"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+// hostSession holds the state of a single HostSession stream.
+type hostSession struct {
+	srv        *server
+	stream     pb.Hostmgr_HostSessionServer
+	tenantID   string
+	guid       string
+	host       *computev1.HostResource
+	lastAction pb.HostStatusResp_HostAction
+}
+
+// HostSession serves the long-lived session opened by an agent.
+// The Host is loaded from Inventory when the session starts and then when Inventory notifies
+// a change of it, heartbeats are handled locally without any Inventory round trip. Each status
+// is compared to the Host read through the Host cache, that the previous status may have changed, and is checked
+// and ordered as the ones of UpdateHostStatusByHostGuid.
+// The action derived from the Host's desired state is pushed to the agent whenever it changes.
+func (s *server) HostSession(stream pb.Hostmgr_HostSessionServer) error {
+	ctx := stream.Context()
+	if s.authEnabled {
+		if !s.rbac.IsRequestAuthorized(ctx, rbac.UpdateKey) {
+			err := inv_errors.Errorfc(codes.PermissionDenied, "Request is blocked by RBAC")
+			zlog.InfraSec().InfraErr(err).Msgf("Request HostSession is not authenticated")
+			return err
+		}
+	}
+
+	tenantID, present := tenant.GetTenantIDFromContext(ctx)
+	if !present {
+		// This should never happen! Interceptor should either fail or set it!
+		err := inv_errors.Errorfc(codes.Unauthenticated, "Tenant ID is not present in context")
+		zlog.InfraSec().InfraErr(err).Msg("Request HostSession is not authenticated")
+		return err
+	}
+
+	// The first message identifies the Host of the session
+	in, err := stream.Recv()
+	if err != nil {
+		return ignoreEOF(err)
+	}
+	if err = in.ValidateAll(); err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Invalid request: %v", in)
+		return hmgr_errors.Wrap(err)
+	}
+
+	guid := in.GetHostGuid()
+	zlog.Info().Msgf("Opening session for Host (tID=%s, UUID=%s)", tenantID, guid)
+	host, err := getHostByGUID(ctx, tenantID, guid)
+	if err != nil {
+		return inv_errors.ErrorToSanitizedGrpcError(err)
+	}
+
+	if hmgr_util.IsHostUntrusted(host) {
+		zlog.InfraSec().InfraError("Host tID=%s, UUID=%s is not trusted, the session will not be opened", tenantID, guid).
+			Msg("HostSession")
+		return inv_errors.Errorfc(codes.Unauthenticated,
+			"Host tID=%s, UUID=%s is not trusted, the session will not be opened", tenantID, guid)
+	}
+
+	hs := &hostSession{
+		srv:        s,
+		stream:     stream,
+		tenantID:   tenantID,
+		guid:       guid,
+		host:       host,
+		lastAction: pb.HostStatusResp_NONE,
+	}
+	return hs.serve(ctx, in)
+}
+
+func (hs *hostSession) serve(ctx context.Context, first *pb.HostSessionRequest) error {
+	sess := sessionmgr.OpenSession(hs.host)
+	defer sessionmgr.CloseSession(sess)
+
+	reqs, recvErr := hs.receive(ctx)
+	if err := hs.pushAction(); err != nil {
+		return err
+	}
+	if err := hs.handleRequest(ctx, first); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			zlog.Info().Msgf("Session of Host (tID=%s, UUID=%s) has been closed", hs.tenantID, hs.guid)
+			return nil
+		case <-sess.Done():
+			zlog.Info().Msgf("Session of Host (tID=%s, UUID=%s) has been superseded", hs.tenantID, hs.guid)
+			return inv_errors.Errorfc(codes.Aborted, "Session has been superseded by a newer one")
+		case err := <-recvErr:
+			zlog.Info().Msgf("Session of Host (tID=%s, UUID=%s) has been closed by the agent", hs.tenantID, hs.guid)
+			return ignoreEOF(err)
+		case req := <-reqs:
+			if err := hs.handleRequest(ctx, req); err != nil {
+				return err
+			}
+		case <-sess.Changed():
+			if err := hs.reload(ctx); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// receive forwards the messages sent by the agent, until the stream is closed.
+func (hs *hostSession) receive(ctx context.Context) (reqsChan <-chan *pb.HostSessionRequest, errChan <-chan error) {
+	reqs := make(chan *pb.HostSessionRequest)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			req, err := hs.stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case reqs <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return reqs, recvErr
+}
+
+// reload is called when the Host has changed in Inventory, it pushes the new action, if any.
+// The Host has already been invalidated in the cache, so it is read from Inventory.
+func (hs *hostSession) reload(ctx context.Context) error {
+	host, err := getHostByGUID(ctx, hs.tenantID, hs.guid)
+	if err != nil {
+		return inv_errors.ErrorToSanitizedGrpcError(err)
+	}
+	hs.host = host
+
+	if err = hs.pushAction(); err != nil {
+		return err
+	}
+	if hmgr_util.IsHostUntrusted(host) {
+		zlog.InfraSec().InfraError("Host tID=%s, UUID=%s has been invalidated, closing the session",
+			hs.tenantID, hs.guid).Msg("HostSession")
+		return inv_errors.Errorfc(codes.Unauthenticated,
+			"Host tID=%s, UUID=%s is not trusted, the session is closed", hs.tenantID, hs.guid)
+	}
+	return nil
+}
+
+func (hs *hostSession) pushAction() error {
+	action := hmgr_util.GetHostAction(hs.host)
+	if action == hs.lastAction {
+		return nil
+	}
+	zlog.Info().Msgf("Pushing action %s to Host (tID=%s, UUID=%s)", action, hs.tenantID, hs.guid)
+	hs.lastAction = action
+	return hs.stream.Send(&pb.HostStatusResp{HostAction: action})
+}
+
+func (hs *hostSession) handleRequest(ctx context.Context, in *pb.HostSessionRequest) error {
+	if hs.srv.tracingEnabled {
+		ctx = tracing.StartTrace(ctx, "HostManager", "HostSession")
+		defer tracing.StopTrace(ctx)
+	}
+	if err := in.ValidateAll(); err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Invalid request: %v", in)
+		return hmgr_errors.Wrap(err)
+	}
+	if !strings.EqualFold(in.GetHostGuid(), hs.guid) {
+		zlog.InfraSec().InfraError("Host UUID=%s does not match the session of Host tID=%s, UUID=%s",
+			in.GetHostGuid(), hs.tenantID, hs.guid).Msg("HostSession")
+		return inv_errors.Errorfc(codes.InvalidArgument, "Host UUID does not match the session")
+	}
+
+	if in.GetHostStatus() == nil {
+		// Plain heartbeat, the clock of the host is still tracked, the failures are only logged
+		_, _ = trackHost(hs.tenantID, hs.host, nil, in.GetAgentTimestampNs())
+		return nil
+	}
+
+	// The statuses of the session are handled as the ones of UpdateHostStatusByHostGuid
+	updates := hs.srv.updates.Lock(hs.tenantID, hs.guid)
+	defer updates.Unlock()
+	host, err := getHostByGUID(ctx, hs.tenantID, hs.guid)
+	if err != nil {
+		return inv_errors.ErrorToSanitizedGrpcError(err)
+	}
+	hs.host = host
+	stamp := ordering.Stamp{Timestamp: in.GetAgentTimestampNs(), Sequence: in.GetSequenceNumber()}
+	return hs.srv.handleHostStatus(ctx, updates, hs.tenantID, host, in.GetHostStatus(), stamp)
+}
+
+func ignoreEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	return err
+}
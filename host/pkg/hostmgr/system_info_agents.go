@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr
+
+import (
+	"maps"
+	"slices"
+	"sync/atomic"
+
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	hrm_metrics "github.com/open-edge-platform/infra-managers/host/pkg/metrics"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+// minAgentVersions holds the minimum versions of the agents, by agent name.
+var minAgentVersions atomic.Pointer[map[string]string]
+
+// SetMinAgentVersions sets the minimum versions of the agents from a comma separated list of agent=version pairs.
+func SetMinAgentVersions(versions string) error {
+	parsed, err := hmgr_util.ParseMinAgentVersions(versions)
+	if err != nil {
+		return err
+	}
+	minAgentVersions.Store(&parsed)
+	return nil
+}
+
+func getMinAgentVersions() map[string]string {
+	if versions := minAgentVersions.Load(); versions != nil {
+		return *versions
+	}
+	return nil
+}
+
+// planAgents adds the metadata of the reported agents to the update. The agents that are not reported anymore
+// are deleted, and so is the outdated agents key if none is outdated.
+func (b *planBuilder) planAgents(agentInfo *pb.AgentInfo, update *hmgr_util.MetadataUpdate) {
+	maps.Copy(update.Set, hmgr_util.AgentInfoMetadata(agentInfo))
+	update.Prefixes = append(update.Prefixes, hmgr_util.AgentMetadataKeyPrefix)
+
+	outdated := hmgr_util.OutdatedAgents(agentInfo, getMinAgentVersions())
+	if outdated == "" {
+		update.Deleted = append(update.Deleted, hmgr_util.AgentOutdatedMetadataKey)
+		return
+	}
+	update.Set[hmgr_util.AgentOutdatedMetadataKey] = outdated
+	metaList, err := hmgr_util.ParseMetadata(b.host.GetMetadata())
+	if err == nil && !slices.Contains(metaList, hmgr_util.Metadata{Key: hmgr_util.AgentOutdatedMetadataKey, Value: outdated}) {
+		hrm_metrics.OutdatedAgentEvents.Inc()
+		zlog.InfraSec().Warn().Msgf("Outdated agents detected on Host (tID=%s, UUID=%s): %s",
+			b.tenantID, b.host.GetUuid(), outdated)
+	}
+}
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr
+
+import (
+	"maps"
+	"slices"
+
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/compliance"
+	hrm_metrics "github.com/open-edge-platform/infra-managers/host/pkg/metrics"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+// planCompliance evaluates the reported hardware against the compliance profile of the host and adds the
+// compliance metadata to the update. All the compliance keys are deleted if no profile matches the host.
+// A host newly found non-compliant is reported as a security event.
+func (b *planBuilder) planCompliance(hwInfo *pb.HWInfo, update *hmgr_util.MetadataUpdate) {
+	profile := compliance.Default().Match(hwInfo.GetProductName(), b.host.GetSite().GetResourceId())
+	if profile == nil {
+		update.Deleted = append(update.Deleted, compliance.MetadataKeys...)
+		return
+	}
+	violations := profile.Evaluate(hwInfo)
+	metadata := compliance.Metadata(profile, violations)
+	maps.Copy(update.Set, metadata)
+	if len(violations) == 0 {
+		update.Deleted = append(update.Deleted, compliance.ViolationsMetadataKey)
+		return
+	}
+
+	metaList, err := hmgr_util.ParseMetadata(b.host.GetMetadata())
+	reported := hmgr_util.Metadata{Key: compliance.ViolationsMetadataKey, Value: metadata[compliance.ViolationsMetadataKey]}
+	if err == nil && !slices.Contains(metaList, reported) {
+		hrm_metrics.ComplianceViolationEvents.Inc()
+		zlog.InfraSec().Warn().Msgf("Host (tID=%s, UUID=%s) does not comply with profile %s: %s",
+			b.tenantID, b.host.GetUuid(), profile.Name, reported.Value)
+	}
+}
@@ -0,0 +1,20 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr
+
+import (
+	"maps"
+
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/labels"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+// planLabels adds the labels derived from the system information to the update, the labels that are not
+// derived anymore are deleted.
+func (b *planBuilder) planLabels(systemInfo *pb.SystemInfo, update *hmgr_util.MetadataUpdate) {
+	maps.Copy(update.Set, labels.Metadata(labels.Default().Derive(labels.Facts(systemInfo))))
+	update.Prefixes = append(update.Prefixes, labels.MetadataKeyPrefix)
+}
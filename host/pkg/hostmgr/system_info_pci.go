@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+
+	inv_errors "github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/pcidevice"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+// planPCIDevices plans the creates, updates and deletes of the PCI devices of the host. PCI devices
+// are not Inventory resources, they are kept in the PCI device store, identified by their slot.
+func (b *planBuilder) planPCIDevices(hwInfo *pb.HWInfo) error {
+	hostID := hmgr_util.NewTenantIDResourceIDTupleFromHost(b.host)
+	reported := make([]pcidevice.Device, 0, len(hwInfo.GetPci()))
+	slots := make(map[string]struct{}, len(hwInfo.GetPci()))
+	for _, pci := range hwInfo.GetPci() {
+		device := pcidevice.FromSystemPCI(pci)
+		if device.Slot == "" {
+			// Older agents only report the device class, such devices cannot be tracked
+			continue
+		}
+		if _, ok := slots[device.Slot]; ok {
+			return inv_errors.Errorfc(codes.InvalidArgument, "PCI slot %s is reported more than once", device.Slot)
+		}
+		slots[device.Slot] = struct{}{}
+		reported = append(reported, device)
+	}
+
+	store := pcidevice.Default()
+	current, err := store.Devices(hostID)
+	if err != nil {
+		return err
+	}
+	matched := make(map[string]struct{}, len(current))
+	for i, currentDevice := range hmgr_util.MatchResources(reported, current, slotOf) {
+		device := reported[i]
+		switch {
+		case currentDevice.Slot == "":
+			b.add(stageApplyDevices, &Mutation{
+				Kind:     MutationCreate,
+				Resource: resourcePCIDevice,
+				Name:     device.Slot,
+				apply: func(context.Context) (string, error) {
+					return "", store.Put(hostID, device)
+				},
+				undo: func(context.Context) error {
+					return store.Delete(hostID, device.Slot)
+				},
+			})
+		case currentDevice != device:
+			b.add(stageApplyDevices, &Mutation{
+				Kind:     MutationUpdate,
+				Resource: resourcePCIDevice,
+				Name:     device.Slot,
+				Fields:   pcidevice.ChangedFields(currentDevice, device),
+				apply: func(context.Context) (string, error) {
+					return "", store.Put(hostID, device)
+				},
+				undo: func(context.Context) error {
+					return store.Put(hostID, currentDevice)
+				},
+			})
+		}
+		matched[device.Slot] = struct{}{}
+	}
+	for _, currentDevice := range current {
+		if _, ok := matched[currentDevice.Slot]; ok {
+			continue
+		}
+		b.add(stageDeleteDevices, &Mutation{
+			Kind:     MutationDelete,
+			Resource: resourcePCIDevice,
+			Name:     currentDevice.Slot,
+			apply: func(context.Context) (string, error) {
+				return "", store.Delete(hostID, currentDevice.Slot)
+			},
+		})
+	}
+	return nil
+}
+
+func slotOf(device pcidevice.Device) string {
+	return device.Slot
+}
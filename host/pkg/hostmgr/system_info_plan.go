@@ -0,0 +1,758 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"net/netip"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	network_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/network/v1"
+	inv_client "github.com/open-edge-platform/infra-core/inventory/v2/pkg/client"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	inv_mgr_cli "github.com/open-edge-platform/infra-managers/host/pkg/invclient"
+	hrm_metrics "github.com/open-edge-platform/infra-managers/host/pkg/metrics"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+// MutationKind is the kind of an Inventory mutation.
+type MutationKind string
+
+const (
+	// MutationCreate creates a resource.
+	MutationCreate MutationKind = "create"
+	// MutationUpdate updates some fields of a resource.
+	MutationUpdate MutationKind = "update"
+	// MutationDelete deletes a resource.
+	MutationDelete MutationKind = "delete"
+)
+
+// Resource kinds of the mutations.
+const (
+	resourceHost        = "host"
+	resourceHoststorage = "hoststorage"
+	resourceHostnic     = "hostnic"
+	resourceIPAddress   = "ipaddress"
+	resourceHostusb     = "hostusb"
+	resourceHostgpu     = "hostgpu"
+	resourceSecret      = "secret"
+	resourcePCIDevice   = "pcidevice"
+)
+
+// Stages of a plan, applied in order. Secrets are stored before the Host references them and IP addresses
+// of created NICs are created after the NICs. Deletes cannot be compensated, they are applied last;
+// IP addresses of removed NICs are deleted before the NICs.
+const (
+	stageStoreSecrets = iota
+	stageApplyDevices
+	stageApplyIPAddresses
+	stageDeleteIPAddresses
+	stageDeleteDevices
+	numStages
+)
+
+const (
+	// Initial interval between two attempts of a mutation, doubled at each attempt.
+	mutationRetryInterval = 100 * time.Millisecond
+	mutationRetries       = uint64(3)
+	// compensationTimeout bounds the compensation of a failed plan, even if the request is canceled.
+	compensationTimeout = 30 * time.Second
+)
+
+// systemInfoApplyParallelism bounds the number of concurrent Inventory calls of a plan.
+var systemInfoApplyParallelism = SystemInfoApplyParallelismValue
+
+// SetSystemInfoApplyParallelism sets the number of concurrent Inventory calls when applying system information.
+func SetSystemInfoApplyParallelism(parallelism int) {
+	if parallelism > 0 {
+		systemInfoApplyParallelism = parallelism
+	}
+}
+
+// Mutation is a single Inventory change of a SystemInfoPlan.
+type Mutation struct {
+	Kind     MutationKind `json:"kind"`
+	Resource string       `json:"resource"`
+	// ResourceID is empty for the resources to create
+	ResourceID string `json:"resourceId,omitempty"`
+	// Name is the device name of a host device or the address of an IP address
+	Name string `json:"name,omitempty"`
+	// Fields are the changed fields of an update
+	Fields []string `json:"fields,omitempty"`
+
+	// apply performs the mutation and returns the ID of the created resource, if any
+	apply func(ctx context.Context) (string, error)
+	// lookup returns the ID of the resource created by a previous attempt, if any
+	lookup func(ctx context.Context) (string, error)
+	// undo reverts the applied mutation, nil if it cannot be reverted, e.g. a delete
+	undo func(ctx context.Context) error
+	// message is the resource being created, its ID is set once created
+	message proto.Message
+}
+
+// SystemInfoPlan is the set of Inventory mutations needed to apply the system information reported
+// by a host. Mutations are grouped in stages applied one after the other, the mutations of a stage
+// are independent and applied in parallel.
+type SystemInfoPlan struct {
+	TenantID string        `json:"tenantId"`
+	HostUUID string        `json:"hostUuid"`
+	Stages   [][]*Mutation `json:"stages"`
+}
+
+// Len returns the number of mutations of the plan.
+func (p *SystemInfoPlan) Len() int {
+	n := 0
+	for _, stage := range p.Stages {
+		n += len(stage)
+	}
+	return n
+}
+
+// String returns the JSON representation of the plan, for debugging purposes.
+func (p *SystemInfoPlan) String() string {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return err.Error()
+	}
+	return string(data)
+}
+
+// Apply applies the stages of the plan in order. Each mutation is retried on transient errors;
+// creates are idempotent, a resource created by a failed attempt is not created again.
+// Applying the plan stops at the first stage with a failed mutation, the mutations already applied are
+// then compensated in reverse order: created resources are deleted and updated ones are restored.
+// Deletes are applied last and cannot be compensated, a failed delete keeps the deletes already applied.
+func (p *SystemInfoPlan) Apply(ctx context.Context) error {
+	var applied []*Mutation
+	for _, stage := range p.Stages {
+		done, err := applyStage(ctx, stage)
+		applied = append(applied, done...)
+		if err != nil {
+			p.compensate(ctx, applied)
+			return err
+		}
+	}
+	return nil
+}
+
+// compensate reverts the applied mutations, in reverse order. Failures are logged, the next report
+// of the system information is planned against the Host as stored in Inventory anyway.
+func (p *SystemInfoPlan) compensate(ctx context.Context, applied []*Mutation) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), compensationTimeout)
+	defer cancel()
+	for _, m := range slices.Backward(applied) {
+		if m.undo == nil {
+			zlog.InfraSec().Warn().Msgf("Mutation %s %s %s of Host (tID=%s, UUID=%s) cannot be compensated",
+				m.Kind, m.Resource, m.Name, p.TenantID, p.HostUUID)
+			continue
+		}
+		if err := withRetry(ctx, func() error { return retryable(m.undo(ctx)) }); err != nil {
+			zlog.InfraSec().InfraErr(err).Msgf("Failed to compensate mutation %s %s %s of Host (tID=%s, UUID=%s)",
+				m.Kind, m.Resource, m.Name, p.TenantID, p.HostUUID)
+		}
+	}
+}
+
+// applyStage applies the mutations of the stage in parallel and returns the ones that have been applied.
+func applyStage(ctx context.Context, stage []*Mutation) ([]*Mutation, error) {
+	errs := make([]error, len(stage))
+	sem := make(chan struct{}, systemInfoApplyParallelism)
+	var wg sync.WaitGroup
+	for i, mutation := range stage {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			errs[i] = mutation.applyWithRetry(ctx)
+		}()
+	}
+	wg.Wait()
+	applied := make([]*Mutation, 0, len(stage))
+	var firstErr error
+	for i, err := range errs {
+		switch {
+		case err == nil:
+			applied = append(applied, stage[i])
+		case firstErr == nil:
+			firstErr = err
+		}
+	}
+	return applied, firstErr
+}
+
+func (m *Mutation) applyWithRetry(ctx context.Context) error {
+	attempt := 0
+	operation := func() error {
+		attempt++
+		if attempt > 1 && m.lookup != nil {
+			// A previous attempt may have created the resource before failing
+			id, err := m.lookup(ctx)
+			if err != nil {
+				return retryable(err)
+			}
+			if id != "" {
+				setResourceID(m.message, id)
+				return nil
+			}
+		}
+		id, err := m.apply(ctx)
+		if err != nil {
+			zlog.Debug().Msgf("Mutation %s %s %s failed (attempt %d): %v", m.Kind, m.Resource, m.Name, attempt, err)
+			return retryable(err)
+		}
+		if m.Kind == MutationCreate {
+			setResourceID(m.message, id)
+		}
+		return nil
+	}
+	return withRetry(ctx, operation)
+}
+
+func withRetry(ctx context.Context, operation backoff.Operation) error {
+	expBackoff := backoff.NewExponentialBackOff()
+	expBackoff.InitialInterval = mutationRetryInterval
+	return backoff.Retry(operation, backoff.WithContext(backoff.WithMaxRetries(expBackoff, mutationRetries), ctx))
+}
+
+// retryable marks as permanent the errors that another attempt cannot fix.
+func retryable(err error) error {
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return err
+	default:
+		return backoff.Permanent(err)
+	}
+}
+
+// setResourceID sets the resource ID of a created resource, so that the resources referencing it,
+// e.g. the IP addresses of a new NIC, can be created in the next stages.
+func setResourceID(message proto.Message, id string) {
+	msg := message.ProtoReflect()
+	msg.Set(msg.Descriptor().Fields().ByName("resource_id"), protoreflect.ValueOfString(id))
+}
+
+// changedFields returns the fields of the mask whose values differ.
+func changedFields[T proto.Message](current, updated T, fieldMask []string) []string {
+	var fields []string
+	for _, field := range fieldMask {
+		if !hmgr_util.ProtoEqualSubset(current, updated, field) {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+type hostDevice interface {
+	proto.Message
+	GetResourceId() string
+	GetDeviceName() string
+}
+
+// deviceOps are the Inventory operations of a kind of host device.
+type deviceOps[T hostDevice] struct {
+	resource  string
+	fieldMask []string
+	create    func(context.Context, inv_client.TenantAwareInventoryClient, string, T) (string, error)
+	update    func(context.Context, inv_client.TenantAwareInventoryClient, string, T) error
+	remove    func(context.Context, inv_client.TenantAwareInventoryClient, string, string) error
+}
+
+var (
+	hoststorageOps = deviceOps[*computev1.HoststorageResource]{
+		resource:  resourceHoststorage,
+		fieldMask: inv_mgr_cli.UpdateHoststorageFieldMask,
+		create:    inv_mgr_cli.CreateHoststorage,
+		update:    inv_mgr_cli.UpdateHoststorage,
+		remove:    inv_mgr_cli.DeleteHoststorage,
+	}
+	hostnicOps = deviceOps[*computev1.HostnicResource]{
+		resource:  resourceHostnic,
+		fieldMask: inv_mgr_cli.UpdateHostnicFieldMask,
+		create:    inv_mgr_cli.CreateHostnic,
+		update:    inv_mgr_cli.UpdateHostnic,
+		remove:    inv_mgr_cli.DeleteHostnic,
+	}
+	hostusbOps = deviceOps[*computev1.HostusbResource]{
+		resource:  resourceHostusb,
+		fieldMask: inv_mgr_cli.UpdateHostusbFieldMask,
+		create:    inv_mgr_cli.CreateHostusb,
+		update:    inv_mgr_cli.UpdateHostusb,
+		remove:    inv_mgr_cli.DeleteHostusb,
+	}
+	hostgpuOps = deviceOps[*computev1.HostgpuResource]{
+		resource:  resourceHostgpu,
+		fieldMask: inv_mgr_cli.UpdateHostgpuFieldMask,
+		create:    inv_mgr_cli.CreateHostgpu,
+		update:    inv_mgr_cli.UpdateHostgpu,
+		remove:    inv_mgr_cli.DeleteHostgpu,
+	}
+)
+
+type planBuilder struct {
+	tenantID string
+	host     *computev1.HostResource
+	// bmcIP is the reported BMC IP, linked to the BMC NIC
+	bmcIP  string
+	stages [numStages][]*Mutation
+}
+
+func (b *planBuilder) add(stage int, mutation *Mutation) {
+	b.stages[stage] = append(b.stages[stage], mutation)
+}
+
+// PlanSystemInfoUpdate computes every Inventory mutation needed to apply the system information
+// reported by the given Host. All the reported information is validated before any mutation is
+// planned. Only the IP addresses of the NICs are read from Inventory, the other devices are eager loaded.
+func PlanSystemInfoUpdate(ctx context.Context, tenantID string, hostres *computev1.HostResource,
+	systemInfo *pb.SystemInfo,
+) (*SystemInfoPlan, error) {
+	b := &planBuilder{tenantID: tenantID, host: hostres, bmcIP: systemInfo.GetBmCtlInfo().GetBmcInfo().GetBmIp()}
+	if err := b.planHost(systemInfo); err != nil {
+		return nil, err
+	}
+	hwInfo := systemInfo.GetHwInfo()
+	if err := b.planHoststorages(hwInfo); err != nil {
+		return nil, err
+	}
+	if err := b.planHostnics(ctx, hwInfo); err != nil {
+		return nil, err
+	}
+	if err := b.planHostusbs(hwInfo); err != nil {
+		return nil, err
+	}
+	if err := b.planHostgpus(hwInfo); err != nil {
+		return nil, err
+	}
+	if err := b.planPCIDevices(hwInfo); err != nil {
+		return nil, err
+	}
+
+	plan := &SystemInfoPlan{TenantID: tenantID, HostUUID: hostres.GetUuid(), Stages: make([][]*Mutation, 0, numStages)}
+	for _, stage := range b.stages {
+		if len(stage) > 0 {
+			plan.Stages = append(plan.Stages, stage)
+		}
+	}
+	return plan, nil
+}
+
+func (b *planBuilder) planHost(systemInfo *pb.SystemInfo) error {
+	updatedHostres, fieldmask, err := hmgr_util.PopulateHostResourceWithNewSystemInfo(systemInfo)
+	if err != nil {
+		return err
+	}
+	b.keepPinnedIdentity(updatedHostres)
+	if err = b.planMetadata(systemInfo); err != nil {
+		return err
+	}
+	isSame, err := hmgr_util.IsSameHost(b.host, updatedHostres, fieldmask)
+	if err != nil {
+		return err
+	}
+	if isSame {
+		zlog.Debug().Msgf("Skipping HostSystemInfo update for Host (tID=%s, UUID=%s) - no changes",
+			b.tenantID, b.host.GetUuid())
+		return nil
+	}
+	updatedHostres.ResourceId = b.host.GetResourceId()
+	b.add(stageApplyDevices, &Mutation{
+		Kind:       MutationUpdate,
+		Resource:   resourceHost,
+		ResourceID: b.host.GetResourceId(),
+		Fields:     changedFields(b.host, updatedHostres, fieldmask.GetPaths()),
+		apply: func(ctx context.Context) (string, error) {
+			return "", inv_mgr_cli.UpdateInvResourceFields(ctx, invClientInstance, b.tenantID, updatedHostres,
+				fieldmask.GetPaths())
+		},
+		undo: func(ctx context.Context) error {
+			return inv_mgr_cli.UpdateInvResourceFields(ctx, invClientInstance, b.tenantID, b.host, fieldmask.GetPaths())
+		},
+	})
+	return nil
+}
+
+// planMetadata plans the Host metadata managed by Host Manager: the references to the reported secrets,
+// the running OS and agents, the labels derived from the hardware and its compliance. The metadata is shared
+// with the users, it is not planned along with the other fields of the Host from the cached one: only the keys
+// of Host Manager are merged into the latest metadata when the plan is applied. A kubeconfig stored in clear
+// in the metadata by previous versions is removed.
+func (b *planBuilder) planMetadata(systemInfo *pb.SystemInfo) error {
+	set, err := b.planSecrets(systemInfo)
+	if err != nil {
+		return err
+	}
+	update := &hmgr_util.MetadataUpdate{Set: set, Deleted: []string{hmgr_util.KubeconfigMetadataKey}}
+	if osInfo := systemInfo.GetOsInfo(); osInfo != nil {
+		b.planOS(osInfo, update)
+	}
+	if hwInfo := systemInfo.GetHwInfo(); hwInfo != nil {
+		b.planLabels(systemInfo, update)
+		b.planCompliance(hwInfo, update)
+	}
+	if agentInfo := systemInfo.GetAgentInfo(); agentInfo != nil {
+		b.planAgents(agentInfo, update)
+	}
+	if len(set) == 0 && systemInfo.GetHwInfo() == nil && systemInfo.GetAgentInfo() == nil {
+		return nil
+	}
+
+	// The cached Host tells whether the metadata is likely to change, the update checks it again
+	metadata, err := update.Apply(b.host.GetMetadata())
+	if err != nil {
+		return err
+	}
+	if metadata == b.host.GetMetadata() {
+		return nil
+	}
+	b.add(stageApplyDevices, &Mutation{
+		Kind:       MutationUpdate,
+		Resource:   resourceHost,
+		ResourceID: b.host.GetResourceId(),
+		Fields:     []string{computev1.HostResourceFieldMetadata},
+		apply: func(ctx context.Context) (string, error) {
+			return "", inv_mgr_cli.UpdateHostMetadata(ctx, invClientInstance, b.tenantID, b.host.GetResourceId(), update)
+		},
+	})
+	return nil
+}
+
+// planOS adds the metadata of the running OS to the update, the OS drift key is deleted if there is no drift.
+func (b *planBuilder) planOS(osInfo *pb.OsInfo, update *hmgr_util.MetadataUpdate) {
+	maps.Copy(update.Set, hmgr_util.OSInfoMetadata(osInfo))
+	drift := b.osDrift(osInfo)
+	if drift == "" {
+		update.Deleted = append(update.Deleted, hmgr_util.OSDriftMetadataKey)
+		return
+	}
+	update.Set[hmgr_util.OSDriftMetadataKey] = drift
+}
+
+// osDrift returns the differences between the running OS and the OS of the Instance, if any.
+// A new drift is reported as a security event.
+func (b *planBuilder) osDrift(osInfo *pb.OsInfo) string {
+	instanceOS := b.host.GetInstance().GetOs()
+	if instanceOS == nil {
+		return ""
+	}
+	drift := hmgr_util.OSDrift(instanceOS, osInfo.GetRelease())
+	if drift == "" {
+		return ""
+	}
+	metaList, err := hmgr_util.ParseMetadata(b.host.GetMetadata())
+	if err == nil && !slices.Contains(metaList, hmgr_util.Metadata{Key: hmgr_util.OSDriftMetadataKey, Value: drift}) {
+		hrm_metrics.OSDriftEvents.Inc()
+		zlog.InfraSec().Warn().Msgf("OS drift detected on Host (tID=%s, UUID=%s): %s",
+			b.tenantID, b.host.GetUuid(), drift)
+	}
+	return drift
+}
+
+// planDevices plans the creates, updates and deletes of a kind of host device. matches holds,
+// for each reported device, the matching current device or nil.
+func planDevices[T hostDevice](b *planBuilder, ops deviceOps[T], reported, current, matches []T) {
+	tenantID := b.tenantID
+	matched := make(map[string]struct{}, len(current))
+	// The current devices, e.g. a replaced one with the same name planned for deletion, are never the created ones
+	known := make([]string, 0, len(current))
+	for _, invDevice := range current {
+		known = append(known, invDevice.GetResourceId())
+	}
+	for i, device := range reported {
+		invDevice := matches[i]
+		if invDevice.GetResourceId() == "" {
+			b.add(stageApplyDevices, &Mutation{
+				Kind:     MutationCreate,
+				Resource: ops.resource,
+				Name:     device.GetDeviceName(),
+				apply: func(ctx context.Context) (string, error) {
+					return ops.create(ctx, invClientInstance, tenantID, device)
+				},
+				lookup: func(ctx context.Context) (string, error) {
+					return inv_mgr_cli.FindCreatedResourceID(ctx, invClientInstance, tenantID, device, known)
+				},
+				undo: func(ctx context.Context) error {
+					return ops.remove(ctx, invClientInstance, tenantID, device.GetResourceId())
+				},
+				message: device,
+			})
+			continue
+		}
+		matched[invDevice.GetResourceId()] = struct{}{}
+		setResourceID(device, invDevice.GetResourceId())
+		if invDevice.GetDeviceName() != device.GetDeviceName() {
+			zlog.Info().Msgf("Host %s renamed: tenantID=%s, resourceID=%s, from %s to %s", ops.resource,
+				tenantID, invDevice.GetResourceId(), invDevice.GetDeviceName(), device.GetDeviceName())
+		}
+		fields := changedFields(invDevice, device, ops.fieldMask)
+		if len(fields) == 0 {
+			zlog.Debug().Msgf("Skip %s update: tenantID=%s, device=%v", ops.resource, tenantID, device)
+			continue
+		}
+		b.add(stageApplyDevices, &Mutation{
+			Kind:       MutationUpdate,
+			Resource:   ops.resource,
+			ResourceID: device.GetResourceId(),
+			Name:       device.GetDeviceName(),
+			Fields:     fields,
+			apply: func(ctx context.Context) (string, error) {
+				return "", ops.update(ctx, invClientInstance, tenantID, device)
+			},
+			undo: func(ctx context.Context) error {
+				return ops.update(ctx, invClientInstance, tenantID, invDevice)
+			},
+		})
+	}
+	for _, invDevice := range current {
+		if _, ok := matched[invDevice.GetResourceId()]; ok {
+			continue
+		}
+		resourceID := invDevice.GetResourceId()
+		b.add(stageDeleteDevices, &Mutation{
+			Kind:       MutationDelete,
+			Resource:   ops.resource,
+			ResourceID: resourceID,
+			Name:       invDevice.GetDeviceName(),
+			apply: func(ctx context.Context) (string, error) {
+				return "", ops.remove(ctx, invClientInstance, tenantID, resourceID)
+			},
+		})
+	}
+}
+
+func (b *planBuilder) planHoststorages(hwInfo *pb.HWInfo) error {
+	// Storages are always eager loaded. No need to query Inventory again
+	invStorages := b.host.GetHostStorages()
+	hostStorages := make([]*computev1.HoststorageResource, 0, len(hwInfo.GetStorage().GetDisk()))
+	for _, disk := range hwInfo.GetStorage().GetDisk() {
+		hostStorage, err := hmgr_util.PopulateHoststorageWithDiskInfo(disk, b.host)
+		if err != nil {
+			return err
+		}
+		hostStorages = append(hostStorages, hostStorage)
+	}
+	// Storages are matched by WWID, then serial number, then device name, so that a disk keeps its
+	// resource when its device name changes, e.g. when /dev/sda and /dev/sdb are swapped after a reboot.
+	planDevices(b, hoststorageOps, hostStorages, invStorages, hmgr_util.MatchHoststorages(hostStorages, invStorages))
+	return nil
+}
+
+func (b *planBuilder) planHostusbs(hwInfo *pb.HWInfo) error {
+	// USBs are always eager loaded. No need to query Inventory again
+	invUsbs := b.host.GetHostUsbs()
+	hostUsbs := make([]*computev1.HostusbResource, 0, len(hwInfo.GetUsb()))
+	for _, usb := range hwInfo.GetUsb() {
+		hostUsb, err := hmgr_util.PopulateHostusbWithUsbInfo(usb, b.host)
+		if err != nil {
+			return err
+		}
+		hostUsbs = append(hostUsbs, hostUsb)
+	}
+	planDevices(b, hostusbOps, hostUsbs, invUsbs, hmgr_util.MatchHostusbs(hostUsbs, invUsbs))
+	return nil
+}
+
+func (b *planBuilder) planHostgpus(hwInfo *pb.HWInfo) error {
+	// GPUs are always eager loaded. No need to query Inventory again
+	invGpus := b.host.GetHostGpus()
+	hostGpus := make([]*computev1.HostgpuResource, 0, len(hwInfo.GetGpu()))
+	for _, gpu := range hwInfo.GetGpu() {
+		hostGpu, err := hmgr_util.PopulateHostgpuWithGpuInfo(gpu, b.host)
+		if err != nil {
+			return err
+		}
+		hostGpus = append(hostGpus, hostGpu)
+	}
+	planDevices(b, hostgpuOps, hostGpus, invGpus, hmgr_util.MatchHostgpus(hostGpus, invGpus))
+	return nil
+}
+
+func (b *planBuilder) planHostnics(ctx context.Context, hwInfo *pb.HWInfo) error {
+	// Nics are always eager loaded. No need to query Inventory again
+	invNics := b.host.GetHostNics()
+	hostNics := make([]*computev1.HostnicResource, 0, len(hwInfo.GetNetwork()))
+	hostIPs := make([][]*network_v1.IPAddressResource, 0, len(hwInfo.GetNetwork()))
+	for _, network := range hwInfo.GetNetwork() {
+		hostNic, err := hmgr_util.PopulateHostnicWithNetworkInfo(network, b.host)
+		if err != nil {
+			return err
+		}
+		nicIPs := make([]*network_v1.IPAddressResource, 0, len(network.GetIpAddresses()))
+		for _, ip := range network.GetIpAddresses() {
+			// The IP addresses reference the NIC, they see its ID once it is created
+			hostIP, err := hmgr_util.PopulateIPAddressWithIPAddressInfo(ip, hostNic)
+			if err != nil {
+				return err
+			}
+			nicIPs = append(nicIPs, hostIP)
+		}
+		hostNics = append(hostNics, hostNic)
+		hostIPs = append(hostIPs, nicIPs)
+	}
+	if err := b.linkBmcIP(hostNics, hostIPs); err != nil {
+		return err
+	}
+	// NICs are matched by MAC address, then PCI identifier, then interface name: a renamed NIC,
+	// e.g. eth0 becoming enp3s0 after an OS update, is updated and keeps its resource and IP addresses.
+	matches := hmgr_util.MatchHostnics(hostNics, invNics)
+	planDevices(b, hostnicOps, hostNics, invNics, matches)
+
+	// IPs are not eager loaded
+	invIPs, err := listIPAddresses(ctx, b.tenantID, invNics)
+	if err != nil {
+		return err
+	}
+	matchedNics := make(map[string]struct{}, len(invNics))
+	for i, invNic := range matches {
+		if invNic.GetResourceId() != "" {
+			matchedNics[invNic.GetResourceId()] = struct{}{}
+		}
+		b.planIPAddresses(hostIPs[i], invIPs[invNic.GetResourceId()])
+	}
+	// NICs cannot be removed if IPs are not removed first
+	for _, invNic := range invNics {
+		if _, ok := matchedNics[invNic.GetResourceId()]; !ok {
+			b.planIPAddresses(nil, invIPs[invNic.GetResourceId()])
+		}
+	}
+	return nil
+}
+
+// linkBmcIP adds the reported BMC IP to the IP addresses of the NIC flagged as the BMC one,
+// unless the NIC already reports it.
+func (b *planBuilder) linkBmcIP(hostNics []*computev1.HostnicResource, hostIPs [][]*network_v1.IPAddressResource) error {
+	if b.bmcIP == "" {
+		return nil
+	}
+	bmcNic := slices.IndexFunc(hostNics, (*computev1.HostnicResource).GetBmcInterface)
+	if bmcNic < 0 {
+		zlog.Debug().Msgf("No BMC NIC reported by Host (tID=%s, UUID=%s), BMC IP is not linked to a NIC",
+			b.tenantID, b.host.GetUuid())
+		return nil
+	}
+	bmcIP, err := hmgr_util.BmcIPAddress(b.bmcIP, hostNics[bmcNic])
+	if err != nil {
+		return err
+	}
+	// The address has been built from a valid IP
+	bmcAddr := netip.MustParsePrefix(bmcIP.GetAddress()).Addr()
+	for _, hostIP := range hostIPs[bmcNic] {
+		if prefix, parseErr := netip.ParsePrefix(hostIP.GetAddress()); parseErr == nil && prefix.Addr() == bmcAddr {
+			return nil
+		}
+	}
+	hostIPs[bmcNic] = append(hostIPs[bmcNic], bmcIP)
+	return nil
+}
+
+// listIPAddresses returns the IP addresses of the given NICs, by NIC resource ID.
+func listIPAddresses(ctx context.Context, tenantID string, invNics []*computev1.HostnicResource) (
+	map[string][]*network_v1.IPAddressResource, error,
+) {
+	invIPs := make([][]*network_v1.IPAddressResource, len(invNics))
+	errs := make([]error, len(invNics))
+	sem := make(chan struct{}, systemInfoApplyParallelism)
+	var wg sync.WaitGroup
+	for i, invNic := range invNics {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			invIPs[i], errs[i] = inv_mgr_cli.ListIPAddresses(ctx, invClientInstance, tenantID, invNic)
+		}()
+	}
+	wg.Wait()
+	ipsByNic := make(map[string][]*network_v1.IPAddressResource, len(invNics))
+	for i, invNic := range invNics {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		ipsByNic[invNic.GetResourceId()] = invIPs[i]
+	}
+	return ipsByNic, nil
+}
+
+func (b *planBuilder) planIPAddresses(hostIPs, invIPs []*network_v1.IPAddressResource) {
+	tenantID := b.tenantID
+	matched := make(map[string]struct{}, len(invIPs))
+	known := make([]string, 0, len(invIPs))
+	for _, invIP := range invIPs {
+		known = append(known, invIP.GetResourceId())
+	}
+	for i, invIP := range hmgr_util.MatchIPAddresses(hostIPs, invIPs) {
+		hostIP := hostIPs[i]
+		if invIP.GetResourceId() == "" {
+			b.add(stageApplyIPAddresses, &Mutation{
+				Kind:     MutationCreate,
+				Resource: resourceIPAddress,
+				Name:     hostIP.GetAddress(),
+				apply: func(ctx context.Context) (string, error) {
+					return inv_mgr_cli.CreateIPAddress(ctx, invClientInstance, tenantID, hostIP)
+				},
+				lookup: func(ctx context.Context) (string, error) {
+					return inv_mgr_cli.FindCreatedResourceID(ctx, invClientInstance, tenantID, hostIP, known)
+				},
+				undo: func(ctx context.Context) error {
+					return inv_mgr_cli.DeleteIPAddress(ctx, invClientInstance, tenantID, hostIP.GetResourceId())
+				},
+				message: hostIP,
+			})
+			continue
+		}
+		matched[invIP.GetResourceId()] = struct{}{}
+		hostIP.ResourceId = invIP.GetResourceId()
+		fields := changedFields(invIP, hostIP, inv_mgr_cli.UpdateIPAddressFieldMask)
+		if len(fields) == 0 {
+			zlog.Debug().Msgf("Skip hostIP update: tenantID=%s, IPAddress=%v", tenantID, hostIP)
+			continue
+		}
+		b.add(stageApplyIPAddresses, &Mutation{
+			Kind:       MutationUpdate,
+			Resource:   resourceIPAddress,
+			ResourceID: hostIP.GetResourceId(),
+			Name:       hostIP.GetAddress(),
+			Fields:     fields,
+			apply: func(ctx context.Context) (string, error) {
+				return "", inv_mgr_cli.UpdateIPAddress(ctx, invClientInstance, tenantID, hostIP)
+			},
+			undo: func(ctx context.Context) error {
+				return inv_mgr_cli.UpdateIPAddress(ctx, invClientInstance, tenantID, invIP)
+			},
+		})
+	}
+	for _, invIP := range invIPs {
+		if _, ok := matched[invIP.GetResourceId()]; ok {
+			continue
+		}
+		resourceID := invIP.GetResourceId()
+		b.add(stageDeleteIPAddresses, &Mutation{
+			Kind:       MutationDelete,
+			Resource:   resourceIPAddress,
+			ResourceID: resourceID,
+			Name:       invIP.GetAddress(),
+			apply: func(ctx context.Context) (string, error) {
+				return "", inv_mgr_cli.DeleteIPAddress(ctx, invClientInstance, tenantID, resourceID)
+			},
+		})
+	}
+}
@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hostmgr"
+	"github.com/open-edge-platform/infra-managers/host/pkg/invclient"
+	om_status "github.com/open-edge-platform/infra-onboarding/onboarding-manager/pkg/status"
+)
+
+func createProvisionedHost(t *testing.T, opts ...inv_testing.Opt[computev1.HostResource]) *computev1.HostResource {
+	t.Helper()
+
+	dao := inv_testing.NewInvResourceDAOOrFail(t)
+	hostInv := dao.CreateHost(t, tenant1, opts...)
+	os := dao.CreateOs(t, tenant1)
+	dao.CreateInstanceWithOpts(t, tenant1, hostInv, os, true, func(inst *computev1.InstanceResource) {
+		inst.ProvisioningStatus = om_status.ProvisioningStatusDone.Status
+		inst.ProvisioningStatusIndicator = om_status.ProvisioningStatusDone.StatusIndicator
+	})
+	return hostInv
+}
+
+func testDisk() *pb.SystemDisk {
+	return &pb.SystemDisk{
+		Name:         "sda",
+		SerialNumber: "1234W45678U",
+		Vendor:       "Foobar Corp.",
+		Model:        "SUN",
+		Size:         1000000,
+		Wwid:         "0x50026b7684e50ff8",
+	}
+}
+
+func planMutations(plan *hostmgr.SystemInfoPlan) map[string][]hostmgr.MutationKind {
+	mutations := make(map[string][]hostmgr.MutationKind)
+	for _, stage := range plan.Stages {
+		for _, mutation := range stage {
+			mutations[mutation.Resource] = append(mutations[mutation.Resource], mutation.Kind)
+		}
+	}
+	return mutations
+}
+
+func TestPlanSystemInfoUpdate(t *testing.T) {
+	hostInv := createProvisionedHost(t)
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	in, ok := proto.Clone(systemInfo1).(*pb.UpdateHostSystemInfoByGUIDRequest)
+	require.True(t, ok)
+	in.HostGuid = hostInv.GetUuid()
+	in.SystemInfo.HwInfo.Storage = &pb.Storage{Disk: []*pb.SystemDisk{testDisk()}}
+	in.SystemInfo.HwInfo.Network = []*pb.SystemNetwork{{
+		Name:        "eth0",
+		Mac:         "90:49:fa:07:6c:fd",
+		PciId:       "0000:00:1f.6",
+		Mtu:         1500,
+		IpAddresses: []*pb.IPAddress{{IpAddress: "192.168.1.12", NetworkPrefixBits: 24}},
+	}}
+
+	// Every reported device is created, the IP addresses once their NIC exists
+	plan, err := hostmgr.PlanSystemInfoUpdate(ctx, tenant1, GetHostbyUUID(t, hostInv.GetUuid()), in.GetSystemInfo())
+	require.NoError(t, err)
+	assert.Equal(t, tenant1, plan.TenantID)
+	assert.Equal(t, hostInv.GetUuid(), plan.HostUUID)
+	mutations := planMutations(plan)
+	assert.Equal(t, []hostmgr.MutationKind{hostmgr.MutationUpdate}, mutations["host"])
+	assert.Equal(t, []hostmgr.MutationKind{hostmgr.MutationCreate}, mutations["hoststorage"])
+	assert.Equal(t, []hostmgr.MutationKind{hostmgr.MutationCreate}, mutations["hostnic"])
+	assert.Equal(t, []hostmgr.MutationKind{hostmgr.MutationCreate}, mutations["ipaddress"])
+	lastStage := plan.Stages[len(plan.Stages)-1]
+	require.Len(t, lastStage, 1)
+	assert.Equal(t, "ipaddress", lastStage[0].Resource)
+	assert.Contains(t, plan.String(), `"hostUuid":"`+hostInv.GetUuid()+`"`)
+
+	// Once applied, the same system information needs no change
+	_, err = HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+	plan, err = hostmgr.PlanSystemInfoUpdate(ctx, tenant1, GetHostbyUUID(t, hostInv.GetUuid()), in.GetSystemInfo())
+	require.NoError(t, err)
+	assert.Zero(t, plan.Len(), plan.String())
+
+	// The renamed NIC is updated, its IP address is kept
+	in.SystemInfo.HwInfo.Network[0].Name = "enp0s31f6"
+	plan, err = hostmgr.PlanSystemInfoUpdate(ctx, tenant1, GetHostbyUUID(t, hostInv.GetUuid()), in.GetSystemInfo())
+	require.NoError(t, err)
+	require.Equal(t, 1, plan.Len(), plan.String())
+	mutation := plan.Stages[0][0]
+	assert.Equal(t, hostmgr.MutationUpdate, mutation.Kind)
+	assert.Equal(t, "hostnic", mutation.Resource)
+	assert.Equal(t, []string{"device_name"}, mutation.Fields)
+}
+
+func TestSystemInfoPlan_Compensation(t *testing.T) {
+	hostInv := createProvisionedHost(t)
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	in, ok := proto.Clone(systemInfo1).(*pb.UpdateHostSystemInfoByGUIDRequest)
+	require.True(t, ok)
+	in.HostGuid = hostInv.GetUuid()
+	in.SystemInfo.HwInfo.Storage = &pb.Storage{Disk: []*pb.SystemDisk{testDisk()}}
+	_, err := HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+	host := GetHostbyUUID(t, hostInv.GetUuid())
+	require.Len(t, host.GetHostStorages(), 1)
+
+	// The Host, the disk and a new NIC are changed in the same stage
+	in.SystemInfo.HwInfo.Cpu.Cores = hostCPUCores + 2
+	in.SystemInfo.HwInfo.Storage.Disk[0].Size *= 2
+	in.SystemInfo.HwInfo.Network = []*pb.SystemNetwork{{
+		Name:        "eth0",
+		Mac:         "90:49:fa:07:6c:fd",
+		PciId:       "0000:00:1f.6",
+		Mtu:         1500,
+		IpAddresses: []*pb.IPAddress{{IpAddress: "192.168.1.12", NetworkPrefixBits: 24}},
+	}}
+	plan, err := hostmgr.PlanSystemInfoUpdate(ctx, tenant1, host, in.GetSystemInfo())
+	require.NoError(t, err)
+
+	// The disk is removed concurrently, its update fails
+	require.NoError(t, invclient.DeleteHoststorage(ctx,
+		inv_testing.TestClients[inv_testing.RMClient].GetTenantAwareInventoryClient(), tenant1,
+		host.GetHostStorages()[0].GetResourceId()))
+	require.Error(t, plan.Apply(ctx))
+
+	// The mutations already applied are reverted
+	host = GetHostbyUUID(t, hostInv.GetUuid())
+	assert.Equal(t, hostCPUCores, host.GetCpuCores())
+	assert.Empty(t, host.GetHostNics())
+}
+
+func TestHostManagerClient_SystemInfoDryRun(t *testing.T) {
+	hostInv := createProvisionedHost(t)
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	hostmgr.SystemInfoDryRunValue = true
+	t.Cleanup(func() { hostmgr.SystemInfoDryRunValue = false })
+
+	in, ok := proto.Clone(systemInfo1).(*pb.UpdateHostSystemInfoByGUIDRequest)
+	require.True(t, ok)
+	in.HostGuid = hostInv.GetUuid()
+	_, err := HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.NoError(t, err)
+
+	host := GetHostbyUUID(t, hostInv.GetUuid())
+	assert.Empty(t, host.GetHostStorages())
+	assert.Empty(t, host.GetHostNics())
+	assert.Empty(t, host.GetHostUsbs())
+	assert.Equal(t, hostInv.GetSerialNumber(), host.GetSerialNumber())
+}
+
+func TestHostManagerClient_SystemInfoInvalidNic(t *testing.T) {
+	hostInv := createProvisionedHost(t)
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, tenant1)
+	defer cancel()
+
+	in, ok := proto.Clone(systemInfo1).(*pb.UpdateHostSystemInfoByGUIDRequest)
+	require.True(t, ok)
+	in.HostGuid = hostInv.GetUuid()
+	in.SystemInfo.HwInfo.Storage = &pb.Storage{Disk: []*pb.SystemDisk{testDisk()}}
+	// A valid request, but not a valid IPv4 CIDR
+	in.SystemInfo.HwInfo.Network = []*pb.SystemNetwork{{
+		Name:        "eth0",
+		Mac:         "90:49:fa:07:6c:fd",
+		Mtu:         1500,
+		IpAddresses: []*pb.IPAddress{{IpAddress: "192.168.1.12", NetworkPrefixBits: 64}},
+	}}
+	_, err := HostManagerTestClient.UpdateHostSystemInfoByGUID(ctx, in)
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Nothing has been applied, the storages are not updated either
+	host := GetHostbyUUID(t, hostInv.GetUuid())
+	assert.Empty(t, host.GetHostStorages())
+	assert.Empty(t, host.GetHostNics())
+}
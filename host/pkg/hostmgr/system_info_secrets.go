@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmgr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"google.golang.org/grpc/codes"
+
+	inv_errors "github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/secretstore"
+	hmgr_util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+// bmcCredentials are the BMC credentials reported by a host, kept in the secret store.
+type bmcCredentials struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// planSecrets plans the storage of the secrets reported by the host, i.e. its kubeconfig and BMC
+// credentials, in the secret store and returns the Host metadata referencing them.
+func (b *planBuilder) planSecrets(systemInfo *pb.SystemInfo) (map[string]string, error) {
+	hostID := hmgr_util.NewTenantIDResourceIDTupleFromHost(b.host)
+	refs := make(map[string]string)
+	if kcInfo := systemInfo.GetKcInfo(); kcInfo != nil {
+		key := secretstore.KubeconfigKey(hostID)
+		if err := b.planSecret(key, []byte(kcInfo.GetKubeconfig())); err != nil {
+			return nil, err
+		}
+		refs[hmgr_util.KubeconfigRefMetadataKey] = secretstore.Ref(key)
+	}
+	if bmcInfo := systemInfo.GetBmCtlInfo().GetBmcInfo(); bmcInfo.GetBmUsername() != "" || bmcInfo.GetBmPassword() != "" {
+		credentials, err := json.Marshal(bmcCredentials{Username: bmcInfo.GetBmUsername(), Password: bmcInfo.GetBmPassword()})
+		if err != nil {
+			return nil, inv_errors.Errorfc(codes.Internal, "cannot encode BMC credentials of Host %s", hostID)
+		}
+		key := secretstore.BmcCredentialsKey(hostID)
+		if err = b.planSecret(key, credentials); err != nil {
+			return nil, err
+		}
+		refs[hmgr_util.BmcCredentialsRefMetadataKey] = secretstore.Ref(key)
+	}
+	return refs, nil
+}
+
+// planSecret plans the storage of a secret, unless it is already stored. The secret itself is
+// never part of the plan, only its key is.
+func (b *planBuilder) planSecret(key string, secret []byte) error {
+	store := secretstore.Default()
+	stored, err := store.Get(key)
+	kind := MutationUpdate
+	switch {
+	case inv_errors.IsNotFound(err):
+		kind = MutationCreate
+	case err != nil:
+		return err
+	case bytes.Equal(stored, secret):
+		return nil
+	}
+	b.add(stageStoreSecrets, &Mutation{
+		Kind:     kind,
+		Resource: resourceSecret,
+		Name:     key,
+		apply: func(context.Context) (string, error) {
+			return "", store.Put(key, secret)
+		},
+		undo: func(context.Context) error {
+			if kind == MutationCreate {
+				return store.Delete(key)
+			}
+			return store.Put(key, stored)
+		},
+	})
+	return nil
+}
@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hoststate
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+// flushRetryInterval is the delay before the states that could not be persisted are written again.
+const flushRetryInterval = 5 * time.Second
+
+// CachedStore keeps the state of the hosts in memory and persists it asynchronously in a backing store, so that
+// the status updates of the hosts are not serialized on its I/O. The state of a host is loaded from the backing
+// store when it is first used, then the changed states are written back in the background, the last change of
+// a host wins. A change is lost if Host Manager stops before it is written, Close writes the pending ones.
+type CachedStore struct {
+	backing Store
+
+	mu    sync.Mutex
+	hosts map[util.TenantIDResourceIDTuple]*cachedState
+	dirty map[util.TenantIDResourceIDTuple]struct{}
+
+	wake      chan struct{}
+	stop      chan struct{}
+	stopped   chan struct{}
+	closeOnce sync.Once
+}
+
+// cachedState is the state of a host, only used while locked.
+type cachedState struct {
+	mu     sync.Mutex
+	loaded bool
+	state  State
+	// deleted reports whether the state is to be deleted from the backing store
+	deleted bool
+	// removed reports whether the state has been removed from the cache, it is loaded again on its next use
+	removed bool
+}
+
+// NewCachedStore returns a store caching the state of the hosts persisted in backing, and starts writing
+// the changes back. It has to be closed once no longer used.
+func NewCachedStore(backing Store) *CachedStore {
+	s := &CachedStore{
+		backing: backing,
+		hosts:   make(map[util.TenantIDResourceIDTuple]*cachedState),
+		dirty:   make(map[util.TenantIDResourceIDTuple]struct{}),
+		wake:    make(chan struct{}, 1),
+		stop:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+// lock returns the locked state of the host, loaded from the backing store.
+func (s *CachedStore) lock(host util.TenantIDResourceIDTuple) (*cachedState, error) {
+	for {
+		s.mu.Lock()
+		entry, ok := s.hosts[host]
+		if !ok {
+			entry = &cachedState{}
+			s.hosts[host] = entry
+		}
+		s.mu.Unlock()
+
+		entry.mu.Lock()
+		if entry.removed {
+			// Removed meanwhile, once its deletion has been persisted
+			entry.mu.Unlock()
+			continue
+		}
+		if !entry.loaded {
+			state, err := s.backing.Get(host)
+			if err != nil {
+				entry.mu.Unlock()
+				return nil, err
+			}
+			entry.state, entry.loaded = state, true
+		}
+		return entry, nil
+	}
+}
+
+// Get implements Store.
+func (s *CachedStore) Get(host util.TenantIDResourceIDTuple) (State, error) {
+	entry, err := s.lock(host)
+	if err != nil {
+		return State{}, err
+	}
+	defer entry.mu.Unlock()
+	return entry.state, nil
+}
+
+// Update implements Store. The updated state is persisted asynchronously.
+func (s *CachedStore) Update(host util.TenantIDResourceIDTuple, update func(*State) bool) (State, error) {
+	entry, err := s.lock(host)
+	if err != nil {
+		return State{}, err
+	}
+	state := entry.state
+	changed := update(&state)
+	if changed {
+		entry.state, entry.deleted = state, false
+	}
+	entry.mu.Unlock()
+
+	if changed {
+		s.markDirty(host)
+	}
+	return state, nil
+}
+
+// Delete implements Store. The state is deleted from the backing store asynchronously.
+func (s *CachedStore) Delete(host util.TenantIDResourceIDTuple) error {
+	s.mu.Lock()
+	entry, ok := s.hosts[host]
+	if !ok {
+		entry = &cachedState{}
+		s.hosts[host] = entry
+	}
+	s.mu.Unlock()
+
+	entry.mu.Lock()
+	entry.state, entry.loaded, entry.deleted = State{}, true, true
+	entry.mu.Unlock()
+	s.markDirty(host)
+	return nil
+}
+
+func (s *CachedStore) markDirty(host util.TenantIDResourceIDTuple) {
+	s.mu.Lock()
+	s.dirty[host] = struct{}{}
+	s.mu.Unlock()
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// run writes the changed states back until the store is closed. The states that cannot be written are retried.
+func (s *CachedStore) run() {
+	defer close(s.stopped)
+	var retry <-chan time.Time
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-s.wake:
+		case <-retry:
+		}
+		retry = nil
+		if err := s.flush(); err != nil {
+			zlog.InfraSec().InfraErr(err).Msgf("Failed to persist the state of the hosts, retrying in %s",
+				flushRetryInterval)
+			retry = time.After(flushRetryInterval)
+		}
+	}
+}
+
+// flush writes the changed states back to the backing store.
+func (s *CachedStore) flush() error {
+	s.mu.Lock()
+	hosts := make([]util.TenantIDResourceIDTuple, 0, len(s.dirty))
+	for host := range s.dirty {
+		hosts = append(hosts, host)
+	}
+	clear(s.dirty)
+	s.mu.Unlock()
+
+	var errs []error
+	for _, host := range hosts {
+		if err := s.flushHost(host); err != nil {
+			errs = append(errs, err)
+			s.mu.Lock()
+			s.dirty[host] = struct{}{}
+			s.mu.Unlock()
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *CachedStore) flushHost(host util.TenantIDResourceIDTuple) error {
+	s.mu.Lock()
+	entry, ok := s.hosts[host]
+	s.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	entry.mu.Lock()
+	state, deleted := entry.state, entry.deleted
+	entry.mu.Unlock()
+
+	if !deleted {
+		_, err := s.backing.Update(host, func(stored *State) bool {
+			*stored = state
+			return true
+		})
+		return err
+	}
+	if err := s.backing.Delete(host); err != nil {
+		return err
+	}
+	// The deleted state is dropped from the cache, unless the host has been updated again meanwhile
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	if _, dirty := s.dirty[host]; entry.deleted && !dirty {
+		entry.removed = true
+		delete(s.hosts, host)
+	}
+	return nil
+}
+
+// Close stops writing the changes back in the background and writes the pending ones.
+func (s *CachedStore) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.stop)
+		<-s.stopped
+	})
+	return s.flush()
+}
@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package hoststate keeps the state tracked by Host Manager for each host, such as its pinned identity.
+// The metadata of the Host resource can be edited by the users and is updated from a cached copy of the host,
+// the state is kept in a Store only written by Host Manager instead, and updated atomically.
+package hoststate
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+var zlog = logging.GetLogger("HostManagerHostState")
+
+const (
+	// DefaultDir is the default directory where the state of the hosts is persisted.
+	DefaultDir = "/var/lib/hostmgr/hoststate"
+
+	// UnexpectedReboot is the last reboot of a host that rebooted without reason.
+	UnexpectedReboot = "unexpected"
+)
+
+var (
+	defaultStoreMu sync.RWMutex
+	defaultStore   Store = NewMemoryStore()
+)
+
+// State is the state tracked for a host.
+type State struct {
+	// TPMEKHash is the pinned SHA-256 of the TPM endorsement key of the host, lowercase
+	TPMEKHash string `json:"tpmEkHash,omitempty"`
+	// IdentityMismatch is the mismatch with the pinned identity that quarantined the host, empty if not quarantined
+	IdentityMismatch string `json:"identityMismatch,omitempty"`
+	// BootID is the boot ID of the running kernel of the host, lowercase
+	BootID string `json:"bootId,omitempty"`
+	// BootTime is the time of the last boot, computed from the reported uptime, zero if unknown
+	BootTime time.Time `json:"bootTime,omitzero"`
+	// RebootCount is the number of reboots detected
+	RebootCount uint64 `json:"rebootCount,omitempty"`
+	// UnexpectedRebootCount is the number of reboots detected that were not expected
+	UnexpectedRebootCount uint64 `json:"unexpectedRebootCount,omitempty"`
+	// LastReboot is the last reboot detected, UnexpectedReboot or the reason it was expected
+	LastReboot string `json:"lastReboot,omitempty"`
+	// ClockSkewSeconds is the last clock skew measured on the host, positive if its clock is ahead
+	ClockSkewSeconds int64 `json:"clockSkewSeconds,omitempty"`
+	// ClockSkewMeasured reports whether the clock skew of the host has been measured
+	ClockSkewMeasured bool `json:"clockSkewMeasured,omitempty"`
+}
+
+// IsQuarantined checks if the host has been quarantined after an identity mismatch.
+func (s State) IsQuarantined() bool {
+	return s.IdentityMismatch != ""
+}
+
+// RecordBoot records the boot ID reported by the host, booted at bootTime unless zero, and reports whether
+// it is a new boot ID and whether it is a reboot. A new boot ID is a reboot, unless no boot ID has been recorded
+// before, it is counted as expected if the reason is set.
+func (s *State) RecordBoot(bootID string, bootTime time.Time, reason string) (changed, rebooted bool) {
+	bootID = strings.ToLower(bootID)
+	if bootID == "" || bootID == s.BootID {
+		return false, false
+	}
+	rebooted = s.BootID != ""
+	s.BootID = bootID
+	s.BootTime = bootTime.UTC()
+	if !rebooted {
+		return true, false
+	}
+	s.RebootCount++
+	s.LastReboot = reason
+	if reason == "" {
+		s.UnexpectedRebootCount++
+		s.LastReboot = UnexpectedReboot
+	}
+	return true, true
+}
+
+// RecordClockSkew records the clock skew measured on the host and reports whether it changed. A skew within
+// a second of the recorded one is not recorded, so that the state is not written at each status.
+func (s *State) RecordClockSkew(skew time.Duration) bool {
+	seconds := int64(skew.Round(time.Second).Seconds())
+	if diff := seconds - s.ClockSkewSeconds; s.ClockSkewMeasured && diff >= -1 && diff <= 1 {
+		return false
+	}
+	s.ClockSkewSeconds = seconds
+	s.ClockSkewMeasured = true
+	return true
+}
+
+// ClockSkew returns the last clock skew measured on the host, and whether it has been measured.
+func (s State) ClockSkew() (time.Duration, bool) {
+	return time.Duration(s.ClockSkewSeconds) * time.Second, s.ClockSkewMeasured
+}
+
+// IsClockSkewed checks if the last clock skew measured on the host is above the threshold, either way. A zero
+// threshold disables the check.
+func (s State) IsClockSkewed(threshold time.Duration) bool {
+	skew, ok := s.ClockSkew()
+	return ok && threshold > 0 && skew.Abs() > threshold
+}
+
+// Store persists the state of each host.
+type Store interface {
+	// Get returns the state of the host, the zero state if there is none.
+	Get(host util.TenantIDResourceIDTuple) (State, error)
+	// Update applies update to the state of the host, atomically with respect to the other updates of the host,
+	// and stores it if update reports a change. It returns the updated state.
+	Update(host util.TenantIDResourceIDTuple, update func(*State) bool) (State, error)
+	// Delete removes the state of the host, deleting a missing state is not an error.
+	Delete(host util.TenantIDResourceIDTuple) error
+}
+
+// Open returns the store persisting the state of the hosts in dir, or an in-memory store if dir is empty.
+// The state persisted in dir is cached, the store has to be closed once no longer used.
+func Open(dir string) (Store, error) {
+	if dir == "" {
+		return NewMemoryStore(), nil
+	}
+	fileStore, err := NewFileStore(dir)
+	if err != nil {
+		return nil, err
+	}
+	return NewCachedStore(fileStore), nil
+}
+
+// Default returns the store of the Host Manager, an in-memory store until it is configured with SetDefault.
+func Default() Store {
+	defaultStoreMu.RLock()
+	defer defaultStoreMu.RUnlock()
+	return defaultStore
+}
+
+// SetDefault replaces the store of the Host Manager.
+func SetDefault(store Store) {
+	defaultStoreMu.Lock()
+	defer defaultStoreMu.Unlock()
+	defaultStore = store
+}
@@ -0,0 +1,372 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hoststate_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/infra-managers/host/pkg/hoststate"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+var (
+	host1 = util.TenantIDResourceIDTuple{
+		TenantID:   "11111111-1111-1111-1111-111111111111",
+		ResourceID: "host-12345678",
+	}
+	host2 = util.TenantIDResourceIDTuple{
+		TenantID:   "22222222-2222-2222-2222-222222222222",
+		ResourceID: "host-12345678",
+	}
+)
+
+const (
+	tpmEKHash = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	bootID1   = "0b7a1e0c-3c6e-4c1d-9a51-8a8f2b6c4d01"
+	bootID2   = "5f2d9c3e-7a14-4b0e-8e6f-1c2d3e4f5a02"
+)
+
+func TestState_RecordBoot(t *testing.T) {
+	bootTime := time.Date(2026, 10, 16, 11, 0, 0, 0, time.UTC)
+	booted := hoststate.State{BootID: bootID1, RebootCount: 2, UnexpectedRebootCount: 1}
+	testCases := map[string]struct {
+		state    hoststate.State
+		bootID   string
+		reason   string
+		changed  bool
+		rebooted bool
+		expected hoststate.State
+	}{
+		"NotReported": {
+			state:    booted,
+			expected: booted,
+		},
+		"SameBoot": {
+			state:    booted,
+			bootID:   strings.ToUpper(bootID1),
+			expected: booted,
+		},
+		"FirstBoot": {
+			bootID:   bootID1,
+			changed:  true,
+			expected: hoststate.State{BootID: bootID1, BootTime: bootTime},
+		},
+		"ExpectedReboot": {
+			state:    booted,
+			bootID:   bootID2,
+			reason:   "update",
+			changed:  true,
+			rebooted: true,
+			expected: hoststate.State{
+				BootID: bootID2, BootTime: bootTime, RebootCount: 3, UnexpectedRebootCount: 1, LastReboot: "update",
+			},
+		},
+		"UnexpectedReboot": {
+			state:    booted,
+			bootID:   bootID2,
+			changed:  true,
+			rebooted: true,
+			expected: hoststate.State{
+				BootID: bootID2, BootTime: bootTime, RebootCount: 3, UnexpectedRebootCount: 2,
+				LastReboot: hoststate.UnexpectedReboot,
+			},
+		},
+	}
+	for tcName, tc := range testCases {
+		t.Run(tcName, func(t *testing.T) {
+			state := tc.state
+			changed, rebooted := state.RecordBoot(tc.bootID, bootTime, tc.reason)
+			assert.Equal(t, tc.changed, changed)
+			assert.Equal(t, tc.rebooted, rebooted)
+			assert.Equal(t, tc.expected, state)
+		})
+	}
+}
+
+func TestState_RecordClockSkew(t *testing.T) {
+	measured := hoststate.State{ClockSkewSeconds: -120, ClockSkewMeasured: true}
+	testCases := map[string]struct {
+		state    hoststate.State
+		skew     time.Duration
+		changed  bool
+		expected hoststate.State
+	}{
+		"FirstMeasure": {
+			skew:     1400 * time.Millisecond,
+			changed:  true,
+			expected: hoststate.State{ClockSkewSeconds: 1, ClockSkewMeasured: true},
+		},
+		"NoSkew": {
+			skew:     200 * time.Millisecond,
+			changed:  true,
+			expected: hoststate.State{ClockSkewMeasured: true},
+		},
+		"Unchanged": {
+			state:    measured,
+			skew:     -120 * time.Second,
+			expected: measured,
+		},
+		"Jitter": {
+			state:    measured,
+			skew:     -121400 * time.Millisecond,
+			expected: measured,
+		},
+		"Changed": {
+			state:    measured,
+			skew:     -125 * time.Second,
+			changed:  true,
+			expected: hoststate.State{ClockSkewSeconds: -125, ClockSkewMeasured: true},
+		},
+	}
+	for tcName, tc := range testCases {
+		t.Run(tcName, func(t *testing.T) {
+			state := tc.state
+			assert.Equal(t, tc.changed, state.RecordClockSkew(tc.skew))
+			assert.Equal(t, tc.expected, state)
+		})
+	}
+}
+
+func TestState_IsClockSkewed(t *testing.T) {
+	state := hoststate.State{ClockSkewSeconds: -120, ClockSkewMeasured: true}
+	assert.True(t, state.IsClockSkewed(time.Minute))
+	assert.False(t, state.IsClockSkewed(5*time.Minute))
+	assert.False(t, state.IsClockSkewed(0))
+	assert.False(t, hoststate.State{}.IsClockSkewed(time.Minute))
+
+	skew, ok := state.ClockSkew()
+	assert.True(t, ok)
+	assert.Equal(t, -2*time.Minute, skew)
+}
+
+func TestStore(t *testing.T) {
+	fileStore, err := hoststate.NewFileStore(filepath.Join(t.TempDir(), "state"))
+	require.NoError(t, err)
+
+	cachedStore := hoststate.NewCachedStore(hoststate.NewMemoryStore())
+	t.Cleanup(func() { assert.NoError(t, cachedStore.Close()) })
+
+	stores := map[string]hoststate.Store{
+		"Memory": hoststate.NewMemoryStore(),
+		"File":   fileStore,
+		"Cached": cachedStore,
+	}
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			state, err := store.Get(host1)
+			require.NoError(t, err)
+			assert.Equal(t, hoststate.State{}, state)
+
+			state, err = store.Update(host1, func(s *hoststate.State) bool {
+				s.TPMEKHash = tpmEKHash
+				return true
+			})
+			require.NoError(t, err)
+			assert.Equal(t, hoststate.State{TPMEKHash: tpmEKHash}, state)
+
+			// An update without change is not stored
+			state, err = store.Update(host1, func(s *hoststate.State) bool {
+				s.IdentityMismatch = "serial number"
+				return false
+			})
+			require.NoError(t, err)
+			assert.Equal(t, "serial number", state.IdentityMismatch)
+			state, err = store.Get(host1)
+			require.NoError(t, err)
+			assert.Equal(t, hoststate.State{TPMEKHash: tpmEKHash}, state)
+			assert.False(t, state.IsQuarantined())
+
+			// The hosts are distinct by tenant
+			state, err = store.Get(host2)
+			require.NoError(t, err)
+			assert.Equal(t, hoststate.State{}, state)
+
+			require.NoError(t, store.Delete(host1))
+			require.NoError(t, store.Delete(host1))
+			state, err = store.Get(host1)
+			require.NoError(t, err)
+			assert.Equal(t, hoststate.State{}, state)
+		})
+	}
+}
+
+func TestFileStore_Persisted(t *testing.T) {
+	dir := t.TempDir()
+	store, err := hoststate.NewFileStore(dir)
+	require.NoError(t, err)
+	stored, err := store.Update(host1, func(s *hoststate.State) bool {
+		s.IdentityMismatch = "serial number: pinned SN-1234, reported SN-5678"
+		s.RecordBoot(bootID1, time.Date(2026, 10, 16, 11, 0, 0, 0, time.UTC), "")
+		return true
+	})
+	require.NoError(t, err)
+
+	// A new store on the same directory loads the state
+	store, err = hoststate.NewFileStore(dir)
+	require.NoError(t, err)
+	state, err := store.Get(host1)
+	require.NoError(t, err)
+	assert.True(t, state.IsQuarantined())
+	assert.Equal(t, stored, state)
+
+	// A corrupted state is not reset, the host would be released
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.NoError(t, os.WriteFile(files[0], []byte("{"), 0o600))
+	_, err = store.Get(host1)
+	assert.Error(t, err)
+	_, err = store.Update(host1, func(s *hoststate.State) bool {
+		*s = hoststate.State{}
+		return true
+	})
+	assert.Error(t, err)
+}
+
+// countingStore counts the reads of a store, and fails its operations while failing is set.
+type countingStore struct {
+	hoststate.Store
+	mu      sync.Mutex
+	gets    int
+	failing bool
+}
+
+func (s *countingStore) access(get bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failing {
+		return errors.New("backing store failure")
+	}
+	if get {
+		s.gets++
+	}
+	return nil
+}
+
+func (s *countingStore) setFailing(failing bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failing = failing
+}
+
+func (s *countingStore) Get(host util.TenantIDResourceIDTuple) (hoststate.State, error) {
+	if err := s.access(true); err != nil {
+		return hoststate.State{}, err
+	}
+	return s.Store.Get(host)
+}
+
+func (s *countingStore) Update(host util.TenantIDResourceIDTuple, update func(*hoststate.State) bool,
+) (hoststate.State, error) {
+	if err := s.access(false); err != nil {
+		return hoststate.State{}, err
+	}
+	return s.Store.Update(host, update)
+}
+
+func (s *countingStore) Delete(host util.TenantIDResourceIDTuple) error {
+	if err := s.access(false); err != nil {
+		return err
+	}
+	return s.Store.Delete(host)
+}
+
+func TestCachedStore(t *testing.T) {
+	backing := &countingStore{Store: hoststate.NewMemoryStore()}
+	_, err := backing.Update(host1, func(s *hoststate.State) bool {
+		s.TPMEKHash = tpmEKHash
+		return true
+	})
+	require.NoError(t, err)
+
+	store := hoststate.NewCachedStore(backing)
+	// A state that cannot be loaded is not cached, it is loaded again
+	backing.setFailing(true)
+	_, err = store.Get(host1)
+	require.Error(t, err)
+	_, err = store.Update(host1, func(*hoststate.State) bool { return true })
+	require.Error(t, err)
+	backing.setFailing(false)
+
+	// The state is loaded once, then read and updated in memory
+	for range 3 {
+		state, err := store.Update(host1, func(s *hoststate.State) bool {
+			return s.RecordClockSkew(time.Minute)
+		})
+		require.NoError(t, err)
+		assert.Equal(t, tpmEKHash, state.TPMEKHash)
+	}
+	state, err := store.Get(host1)
+	require.NoError(t, err)
+	assert.True(t, state.IsClockSkewed(time.Second))
+	assert.Equal(t, 1, backing.gets)
+
+	// The state is persisted asynchronously
+	assert.Eventually(t, func() bool {
+		persisted, err := backing.Store.Get(host1)
+		return err == nil && persisted == state
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// A deleted state is deleted from the backing store, then loaded again
+	require.NoError(t, store.Delete(host1))
+	state, err = store.Get(host1)
+	require.NoError(t, err)
+	assert.Equal(t, hoststate.State{}, state)
+	assert.Eventually(t, func() bool {
+		persisted, err := backing.Store.Get(host1)
+		return err == nil && persisted == hoststate.State{}
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The changes that could not be persisted are written when the store is closed
+	_, err = store.Get(host2)
+	require.NoError(t, err)
+	backing.setFailing(true)
+	_, err = store.Update(host2, func(s *hoststate.State) bool {
+		s.IdentityMismatch = "serial number"
+		return true
+	})
+	require.NoError(t, err)
+	backing.setFailing(false)
+	require.NoError(t, store.Close())
+	persisted, err := backing.Store.Get(host2)
+	require.NoError(t, err)
+	assert.True(t, persisted.IsQuarantined())
+}
+
+func TestOpen(t *testing.T) {
+	// Without directory, the state is kept in memory
+	store, err := hoststate.Open("")
+	require.NoError(t, err)
+	assert.IsType(t, &hoststate.MemoryStore{}, store)
+
+	dir := filepath.Join(t.TempDir(), "state")
+	store, err = hoststate.Open(dir)
+	require.NoError(t, err)
+	require.IsType(t, &hoststate.CachedStore{}, store)
+	_, err = store.Update(host1, func(s *hoststate.State) bool {
+		s.TPMEKHash = tpmEKHash
+		return true
+	})
+	require.NoError(t, err)
+	require.NoError(t, store.(*hoststate.CachedStore).Close())
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+
+	// A directory that cannot be created is rejected
+	file := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(file, nil, 0o600))
+	_, err = hoststate.Open(filepath.Join(file, "state"))
+	assert.Error(t, err)
+}
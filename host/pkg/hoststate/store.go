@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hoststate
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+const (
+	hostStateDirPerm  = 0o750
+	hostStateFilePerm = 0o600
+)
+
+// MemoryStore keeps the state of the hosts in memory, it is lost on restart.
+type MemoryStore struct {
+	mu     sync.Mutex
+	states map[util.TenantIDResourceIDTuple]State
+}
+
+// NewMemoryStore returns an empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{states: make(map[util.TenantIDResourceIDTuple]State)}
+}
+
+// Get implements Store.
+func (s *MemoryStore) Get(host util.TenantIDResourceIDTuple) (State, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.states[host], nil
+}
+
+// Update implements Store.
+func (s *MemoryStore) Update(host util.TenantIDResourceIDTuple, update func(*State) bool) (State, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state := s.states[host]
+	if update(&state) {
+		s.states[host] = state
+	}
+	return state, nil
+}
+
+// Delete implements Store.
+func (s *MemoryStore) Delete(host util.TenantIDResourceIDTuple) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.states, host)
+	return nil
+}
+
+// FileStore persists the state of each host in a JSON file of the given directory.
+type FileStore struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// NewFileStore returns a store persisting the state of the hosts in dir, created if missing.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, hostStateDirPerm); err != nil {
+		return nil, errors.Errorfc(codes.Internal, "cannot create host state directory %s: %v", dir, err)
+	}
+	probe, err := os.CreateTemp(dir, ".probe-*")
+	if err != nil {
+		return nil, errors.Errorfc(codes.Internal, "cannot write in host state directory %s: %v", dir, err)
+	}
+	if err = probe.Close(); err == nil {
+		err = os.Remove(probe.Name())
+	}
+	if err != nil {
+		return nil, errors.Errorfc(codes.Internal, "cannot write in host state directory %s: %v", dir, err)
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+func (s *FileStore) path(host util.TenantIDResourceIDTuple) string {
+	// Tenant IDs are UUIDs and resource IDs are alphanumeric, both are safe file names
+	return filepath.Join(s.dir, filepath.Base(host.TenantID+"_"+host.ResourceID)+".json")
+}
+
+// read returns the state of the host. Unlike the other stores, an unreadable state is not reset:
+// it would release a quarantined host.
+func (s *FileStore) read(host util.TenantIDResourceIDTuple) (State, error) {
+	var state State
+	data, err := os.ReadFile(s.path(host))
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return state, errors.Errorfc(codes.Internal, "cannot read the state of %s: %v", host, err)
+	}
+	if err = json.Unmarshal(data, &state); err != nil {
+		return state, errors.Errorfc(codes.Internal, "cannot decode the state of %s: %v", host, err)
+	}
+	return state, nil
+}
+
+func (s *FileStore) write(host util.TenantIDResourceIDTuple, state State) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return errors.Errorfc(codes.Internal, "cannot encode the state of %s: %v", host, err)
+	}
+
+	// Write then rename, the state is never left half written
+	path := s.path(host)
+	tmpPath := path + ".tmp"
+	if err = os.WriteFile(tmpPath, data, hostStateFilePerm); err != nil {
+		return errors.Errorfc(codes.Internal, "cannot write the state of %s: %v", host, err)
+	}
+	if err = os.Rename(tmpPath, path); err != nil {
+		if rmErr := os.Remove(tmpPath); rmErr != nil {
+			zlog.Warn().Err(rmErr).Msgf("cannot remove %s", tmpPath)
+		}
+		return errors.Errorfc(codes.Internal, "cannot write the state of %s: %v", host, err)
+	}
+	return nil
+}
+
+// Get implements Store.
+func (s *FileStore) Get(host util.TenantIDResourceIDTuple) (State, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.read(host)
+}
+
+// Update implements Store.
+func (s *FileStore) Update(host util.TenantIDResourceIDTuple, update func(*State) bool) (State, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, err := s.read(host)
+	if err != nil {
+		return state, err
+	}
+	if !update(&state) {
+		return state, nil
+	}
+	return state, s.write(host, state)
+}
+
+// Delete implements Store.
+func (s *FileStore) Delete(host util.TenantIDResourceIDTuple) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := os.Remove(s.path(host)); err != nil && !os.IsNotExist(err) {
+		return errors.Errorfc(codes.Internal, "cannot delete the state of %s: %v", host, err)
+	}
+	return nil
+}
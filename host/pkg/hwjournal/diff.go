@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hwjournal
+
+import (
+	"strconv"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+// ChangeKind is the kind of a hardware change.
+type ChangeKind string
+
+const (
+	// ChangeAdded is reported for a new component.
+	ChangeAdded ChangeKind = "added"
+	// ChangeRemoved is reported for a component that is not reported anymore.
+	ChangeRemoved ChangeKind = "removed"
+	// ChangeModified is reported for a component whose attribute has changed.
+	ChangeModified ChangeKind = "modified"
+)
+
+// Component kinds.
+const (
+	ComponentHost = "host"
+	ComponentDisk = "disk"
+	ComponentNic  = "nic"
+	ComponentUsb  = "usb"
+	ComponentGpu  = "gpu"
+)
+
+// Change is a single hardware change of a host.
+type Change struct {
+	Kind      ChangeKind `json:"kind"`
+	Component string     `json:"component"`
+	// ID identifies the component within the host, e.g. the device name of a disk
+	ID    string `json:"id,omitempty"`
+	Field string `json:"field,omitempty"`
+	Old   string `json:"old,omitempty"`
+	New   string `json:"new,omitempty"`
+}
+
+type field[T any] struct {
+	name  string
+	value func(T) string
+}
+
+func uintField[T any](name string, value func(T) uint64) field[T] {
+	return field[T]{name: name, value: func(r T) string { return strconv.FormatUint(value(r), 10) }}
+}
+
+var hostFields = []field[*computev1.HostResource]{
+	{name: "serial_number", value: (*computev1.HostResource).GetSerialNumber},
+	{name: "product_name", value: (*computev1.HostResource).GetProductName},
+	uintField("memory_bytes", (*computev1.HostResource).GetMemoryBytes),
+	{name: "cpu_model", value: (*computev1.HostResource).GetCpuModel},
+	{name: "cpu_architecture", value: (*computev1.HostResource).GetCpuArchitecture},
+	uintField("cpu_sockets", func(h *computev1.HostResource) uint64 { return uint64(h.GetCpuSockets()) }),
+	uintField("cpu_cores", func(h *computev1.HostResource) uint64 { return uint64(h.GetCpuCores()) }),
+	uintField("cpu_threads", func(h *computev1.HostResource) uint64 { return uint64(h.GetCpuThreads()) }),
+}
+
+var diskFields = []field[*computev1.HoststorageResource]{
+	{name: "device_name", value: (*computev1.HoststorageResource).GetDeviceName},
+	{name: "wwid", value: (*computev1.HoststorageResource).GetWwid},
+	{name: "serial", value: (*computev1.HoststorageResource).GetSerial},
+	{name: "vendor", value: (*computev1.HoststorageResource).GetVendor},
+	{name: "model", value: (*computev1.HoststorageResource).GetModel},
+	uintField("capacity_bytes", (*computev1.HoststorageResource).GetCapacityBytes),
+}
+
+var nicFields = []field[*computev1.HostnicResource]{
+	{name: "device_name", value: (*computev1.HostnicResource).GetDeviceName},
+	{name: "mac_addr", value: (*computev1.HostnicResource).GetMacAddr},
+	{name: "pci_identifier", value: (*computev1.HostnicResource).GetPciIdentifier},
+}
+
+var usbFields = []field[*computev1.HostusbResource]{
+	{name: "idvendor", value: (*computev1.HostusbResource).GetIdvendor},
+	{name: "idproduct", value: (*computev1.HostusbResource).GetIdproduct},
+	{name: "serial", value: (*computev1.HostusbResource).GetSerial},
+}
+
+var gpuFields = []field[*computev1.HostgpuResource]{
+	{name: "vendor", value: (*computev1.HostgpuResource).GetVendor},
+	{name: "product", value: (*computev1.HostgpuResource).GetProduct},
+	{name: "device_name", value: (*computev1.HostgpuResource).GetDeviceName},
+}
+
+func diskID(d *computev1.HoststorageResource) string { return d.GetDeviceName() }
+
+func nicID(n *computev1.HostnicResource) string { return n.GetDeviceName() }
+
+func usbID(u *computev1.HostusbResource) string {
+	return strconv.FormatUint(uint64(u.GetBus()), 10) + ":" + strconv.FormatUint(uint64(u.GetAddr()), 10)
+}
+
+func gpuID(g *computev1.HostgpuResource) string { return g.GetPciId() }
+
+// Diff returns the hardware changes between the host currently stored in Inventory, with its
+// storages, NICs, USBs and GPUs, and the host built from the reported system information.
+// Components are matched the same way they are reconciled, e.g. disks by WWID, serial number then
+// device name: a renamed disk or NIC is reported as a modified device name.
+// A host without any hardware recorded yet is reported for the first time, so no change is returned.
+func Diff(current, reported *computev1.HostResource) []Change {
+	if !hasHardware(current) {
+		return nil
+	}
+	changes := diffFields(ComponentHost, "", current, reported, hostFields)
+	changes = append(changes,
+		diffComponents(ComponentDisk, current.GetHostStorages(), reported.GetHostStorages(),
+			util.MatchHoststorages, diskID, diskFields)...)
+	changes = append(changes,
+		diffComponents(ComponentNic, current.GetHostNics(), reported.GetHostNics(),
+			util.MatchHostnics, nicID, nicFields)...)
+	changes = append(changes,
+		diffComponents(ComponentUsb, current.GetHostUsbs(), reported.GetHostUsbs(),
+			util.MatchHostusbs, usbID, usbFields)...)
+	changes = append(changes,
+		diffComponents(ComponentGpu, current.GetHostGpus(), reported.GetHostGpus(),
+			util.MatchHostgpus, gpuID, gpuFields)...)
+	return changes
+}
+
+func hasHardware(host *computev1.HostResource) bool {
+	return host.GetMemoryBytes() != 0 || host.GetCpuModel() != "" ||
+		len(host.GetHostStorages()) != 0 || len(host.GetHostNics()) != 0 ||
+		len(host.GetHostUsbs()) != 0 || len(host.GetHostGpus()) != 0
+}
+
+func diffFields[T any](component, id string, current, reported T, fields []field[T]) []Change {
+	var changes []Change
+	for _, f := range fields {
+		oldValue, newValue := f.value(current), f.value(reported)
+		if oldValue != newValue {
+			changes = append(changes, Change{
+				Kind:      ChangeModified,
+				Component: component,
+				ID:        id,
+				Field:     f.name,
+				Old:       oldValue,
+				New:       newValue,
+			})
+		}
+	}
+	return changes
+}
+
+// diffComponents reports the added, removed and modified components. Modified components are
+// identified by their reported identifier, removed ones by their current identifier.
+func diffComponents[T comparable](component string, current, reported []T,
+	match func(reported, current []T) []T, id func(T) string, fields []field[T],
+) []Change {
+	var changes []Change
+	var zero T
+	matched := make(map[T]struct{}, len(current))
+	for i, c := range match(reported, current) {
+		r := reported[i]
+		if c == zero {
+			changes = append(changes, Change{Kind: ChangeAdded, Component: component, ID: id(r)})
+			continue
+		}
+		matched[c] = struct{}{}
+		changes = append(changes, diffFields(component, id(r), c, r, fields)...)
+	}
+	for _, c := range current {
+		if _, ok := matched[c]; !ok {
+			changes = append(changes, Change{Kind: ChangeRemoved, Component: component, ID: id(c)})
+		}
+	}
+	return changes
+}
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hwjournal_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	"github.com/open-edge-platform/infra-managers/host/pkg/hwjournal"
+)
+
+func testHost() *computev1.HostResource {
+	return &computev1.HostResource{
+		SerialNumber: "SN1",
+		MemoryBytes:  16 * 1024 * 1024 * 1024,
+		CpuModel:     "Intel Xeon",
+		CpuCores:     8,
+		HostStorages: []*computev1.HoststorageResource{
+			{DeviceName: "sda", Wwid: "wwid-a", Serial: "disk-a", CapacityBytes: 1000},
+		},
+		HostNics: []*computev1.HostnicResource{
+			{DeviceName: "eth0", MacAddr: "aa:bb:cc:dd:ee:ff", PciIdentifier: "0000:00:1f.6"},
+		},
+		HostUsbs: []*computev1.HostusbResource{
+			{Bus: 1, Addr: 2, Idvendor: "8087", Idproduct: "0024"},
+		},
+		HostGpus: []*computev1.HostgpuResource{
+			{PciId: "0000:00:02.0", Vendor: "Intel", Product: "UHD"},
+		},
+	}
+}
+
+//nolint:funlen // it is a table-driven test
+func TestDiff(t *testing.T) {
+	testCases := map[string]struct {
+		current  *computev1.HostResource
+		reported func(h *computev1.HostResource)
+		expected []hwjournal.Change
+	}{
+		"FirstReport": {
+			current:  &computev1.HostResource{SerialNumber: "SN1"},
+			reported: func(*computev1.HostResource) {},
+			expected: nil,
+		},
+		"NoChange": {
+			current:  testHost(),
+			reported: func(*computev1.HostResource) {},
+			expected: nil,
+		},
+		"MemoryAndCPU": {
+			current: testHost(),
+			reported: func(h *computev1.HostResource) {
+				h.MemoryBytes = 32 * 1024 * 1024 * 1024
+				h.CpuCores = 16
+			},
+			expected: []hwjournal.Change{
+				{
+					Kind: hwjournal.ChangeModified, Component: hwjournal.ComponentHost,
+					Field: "memory_bytes", Old: "17179869184", New: "34359738368",
+				},
+				{
+					Kind: hwjournal.ChangeModified, Component: hwjournal.ComponentHost,
+					Field: "cpu_cores", Old: "8", New: "16",
+				},
+			},
+		},
+		"DiskAddedAndRemoved": {
+			current: testHost(),
+			reported: func(h *computev1.HostResource) {
+				h.HostStorages = []*computev1.HoststorageResource{
+					{DeviceName: "sdb", Wwid: "wwid-b", Serial: "disk-b", CapacityBytes: 2000},
+				}
+			},
+			expected: []hwjournal.Change{
+				{Kind: hwjournal.ChangeAdded, Component: hwjournal.ComponentDisk, ID: "sdb"},
+				{Kind: hwjournal.ChangeRemoved, Component: hwjournal.ComponentDisk, ID: "sda"},
+			},
+		},
+		"DiskRenamed": {
+			current: testHost(),
+			reported: func(h *computev1.HostResource) {
+				h.HostStorages[0].DeviceName = "sdb"
+			},
+			expected: []hwjournal.Change{
+				{
+					Kind: hwjournal.ChangeModified, Component: hwjournal.ComponentDisk, ID: "sdb",
+					Field: "device_name", Old: "sda", New: "sdb",
+				},
+			},
+		},
+		"NicReplaced": {
+			current: testHost(),
+			reported: func(h *computev1.HostResource) {
+				h.HostNics = []*computev1.HostnicResource{
+					{DeviceName: "eth0", MacAddr: "11:22:33:44:55:66", PciIdentifier: "0000:00:1f.6"},
+				}
+			},
+			expected: []hwjournal.Change{
+				{Kind: hwjournal.ChangeAdded, Component: hwjournal.ComponentNic, ID: "eth0"},
+				{Kind: hwjournal.ChangeRemoved, Component: hwjournal.ComponentNic, ID: "eth0"},
+			},
+		},
+		"NicRenamed": {
+			current: testHost(),
+			reported: func(h *computev1.HostResource) {
+				h.HostNics[0].DeviceName = "enp0s31f6"
+			},
+			expected: []hwjournal.Change{
+				{
+					Kind: hwjournal.ChangeModified, Component: hwjournal.ComponentNic, ID: "enp0s31f6",
+					Field: "device_name", Old: "eth0", New: "enp0s31f6",
+				},
+			},
+		},
+		"UsbAndGpuSwapped": {
+			current: testHost(),
+			reported: func(h *computev1.HostResource) {
+				h.HostUsbs = []*computev1.HostusbResource{{Bus: 1, Addr: 2, Idvendor: "046d", Idproduct: "0024"}}
+				h.HostGpus = []*computev1.HostgpuResource{{PciId: "0000:03:00.0", Vendor: "Intel", Product: "Arc"}}
+			},
+			expected: []hwjournal.Change{
+				{
+					Kind: hwjournal.ChangeModified, Component: hwjournal.ComponentUsb, ID: "1:2",
+					Field: "idvendor", Old: "8087", New: "046d",
+				},
+				{Kind: hwjournal.ChangeAdded, Component: hwjournal.ComponentGpu, ID: "0000:03:00.0"},
+				{Kind: hwjournal.ChangeRemoved, Component: hwjournal.ComponentGpu, ID: "0000:00:02.0"},
+			},
+		},
+	}
+	for tcName, tc := range testCases {
+		t.Run(tcName, func(t *testing.T) {
+			reported := testHost()
+			tc.reported(reported)
+			assert.Equal(t, tc.expected, hwjournal.Diff(tc.current, reported))
+		})
+	}
+}
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package hwjournal keeps a bounded history of the hardware changes of each host
+// and raises a hardware drift event whenever a change is recorded.
+package hwjournal
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
+	hrm_metrics "github.com/open-edge-platform/infra-managers/host/pkg/metrics"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+var zlog = logging.GetLogger("HostManagerHwJournal")
+
+const (
+	// DefaultMaxEntries is the default number of journal entries kept per host.
+	DefaultMaxEntries = 50
+	// DefaultDir is the default directory where the journal is persisted.
+	DefaultDir = "/var/lib/hostmgr/hwjournal"
+)
+
+var (
+	defaultJournalMu sync.RWMutex
+	defaultJournal   = &Journal{store: NewMemoryStore(), maxEntries: DefaultMaxEntries}
+)
+
+// Entry is a set of hardware changes reported at once by a host.
+type Entry struct {
+	Timestamp time.Time `json:"timestamp"`
+	Changes   []Change  `json:"changes"`
+}
+
+// Store persists the journal entries of each host.
+type Store interface {
+	// Append adds the entry to the history of the host, dropping the oldest entries beyond maxEntries.
+	Append(host util.TenantIDResourceIDTuple, entry Entry, maxEntries int) error
+	// History returns the entries of the host, oldest first.
+	History(host util.TenantIDResourceIDTuple) ([]Entry, error)
+	// Delete drops the entries of the host.
+	Delete(host util.TenantIDResourceIDTuple) error
+}
+
+// Journal records the hardware changes of hosts in a Store.
+type Journal struct {
+	store      Store
+	maxEntries int
+}
+
+// New returns a journal keeping up to maxEntries entries per host in the given store.
+func New(store Store, maxEntries int) (*Journal, error) {
+	if store == nil {
+		return nil, errors.Errorfc(codes.InvalidArgument, "hardware journal store is nil")
+	}
+	if maxEntries <= 0 {
+		return nil, errors.Errorfc(codes.InvalidArgument, "invalid hardware journal size: %d", maxEntries)
+	}
+	return &Journal{store: store, maxEntries: maxEntries}, nil
+}
+
+// Record appends the changes to the history of the host and raises a hardware drift event.
+// Nothing is recorded if there is no change.
+func (j *Journal) Record(host util.TenantIDResourceIDTuple, timestamp time.Time, changes []Change) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	for _, change := range changes {
+		hrm_metrics.HardwareDriftEvents.WithLabelValues(change.Component).Inc()
+	}
+	zlog.InfraSec().Warn().Msgf("Hardware drift detected on Host %s: %d changes %v", host, len(changes), changes)
+	return j.store.Append(host, Entry{Timestamp: timestamp.UTC(), Changes: changes}, j.maxEntries)
+}
+
+// History returns the recorded entries of the host, oldest first.
+func (j *Journal) History(host util.TenantIDResourceIDTuple) ([]Entry, error) {
+	return j.store.History(host)
+}
+
+// Delete drops the recorded entries of the host, e.g. once the host is deleted.
+func (j *Journal) Delete(host util.TenantIDResourceIDTuple) error {
+	return j.store.Delete(host)
+}
+
+// Open returns the journal keeping up to maxEntries entries per host in dir,
+// or in memory if dir is empty.
+func Open(dir string, maxEntries int) (*Journal, error) {
+	var store Store = NewMemoryStore()
+	if dir != "" {
+		fileStore, err := NewFileStore(dir)
+		if err != nil {
+			return nil, err
+		}
+		store = fileStore
+	}
+	return New(store, maxEntries)
+}
+
+// Default returns the journal of the Host Manager, kept in memory until it is configured with SetDefault.
+func Default() *Journal {
+	defaultJournalMu.RLock()
+	defer defaultJournalMu.RUnlock()
+	return defaultJournal
+}
+
+// SetDefault replaces the journal of the Host Manager.
+func SetDefault(journal *Journal) {
+	defaultJournalMu.Lock()
+	defer defaultJournalMu.Unlock()
+	defaultJournal = journal
+}
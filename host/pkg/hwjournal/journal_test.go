@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hwjournal_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/infra-managers/host/pkg/hwjournal"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+var testHostID = util.TenantIDResourceIDTuple{
+	TenantID:   "11111111-1111-1111-1111-111111111111",
+	ResourceID: "host-12345678",
+}
+
+func change(id string) []hwjournal.Change {
+	return []hwjournal.Change{{Kind: hwjournal.ChangeAdded, Component: hwjournal.ComponentDisk, ID: id}}
+}
+
+func TestNew(t *testing.T) {
+	_, err := hwjournal.New(nil, 1)
+	assert.Error(t, err)
+
+	_, err = hwjournal.New(hwjournal.NewMemoryStore(), 0)
+	assert.Error(t, err)
+
+	journal, err := hwjournal.New(hwjournal.NewMemoryStore(), 1)
+	require.NoError(t, err)
+	assert.NotNil(t, journal)
+}
+
+func TestOpen(t *testing.T) {
+	// Without directory, the journal is kept in memory
+	journal, err := hwjournal.Open("", 1)
+	require.NoError(t, err)
+	require.NoError(t, journal.Record(testHostID, time.Now(), change("sda")))
+
+	dir := filepath.Join(t.TempDir(), "journal")
+	journal, err = hwjournal.Open(dir, 1)
+	require.NoError(t, err)
+	require.NoError(t, journal.Record(testHostID, time.Now(), change("sda")))
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+
+	_, err = hwjournal.Open(dir, 0)
+	assert.Error(t, err)
+
+	// A directory that cannot be created is rejected
+	file := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(file, nil, 0o600))
+	_, err = hwjournal.Open(filepath.Join(file, "journal"), 1)
+	assert.Error(t, err)
+}
+
+func TestJournal_Record(t *testing.T) {
+	fileStore, err := hwjournal.NewFileStore(filepath.Join(t.TempDir(), "journal"))
+	require.NoError(t, err)
+
+	stores := map[string]hwjournal.Store{
+		"Memory": hwjournal.NewMemoryStore(),
+		"File":   fileStore,
+	}
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			journal, err := hwjournal.New(store, 2)
+			require.NoError(t, err)
+
+			now := time.Unix(1000, 0)
+			// No change, nothing is recorded
+			require.NoError(t, journal.Record(testHostID, now, nil))
+			history, err := journal.History(testHostID)
+			require.NoError(t, err)
+			assert.Empty(t, history)
+
+			// The oldest entries are dropped beyond the journal size
+			require.NoError(t, journal.Record(testHostID, now, change("sda")))
+			require.NoError(t, journal.Record(testHostID, now.Add(time.Second), change("sdb")))
+			require.NoError(t, journal.Record(testHostID, now.Add(2*time.Second), change("sdc")))
+			history, err = journal.History(testHostID)
+			require.NoError(t, err)
+			require.Len(t, history, 2)
+			assert.Equal(t, change("sdb"), history[0].Changes)
+			assert.Equal(t, change("sdc"), history[1].Changes)
+			assert.True(t, now.Add(2*time.Second).Equal(history[1].Timestamp))
+
+			// Other hosts have their own history
+			otherHost := util.TenantIDResourceIDTuple{TenantID: testHostID.TenantID, ResourceID: "host-87654321"}
+			history, err = journal.History(otherHost)
+			require.NoError(t, err)
+			assert.Empty(t, history)
+
+			// The history of a deleted host is dropped, deleting it again is not an error
+			require.NoError(t, journal.Delete(testHostID))
+			history, err = journal.History(testHostID)
+			require.NoError(t, err)
+			assert.Empty(t, history)
+			require.NoError(t, journal.Delete(testHostID))
+		})
+	}
+}
+
+func TestFileStore_Persisted(t *testing.T) {
+	dir := t.TempDir()
+	store, err := hwjournal.NewFileStore(dir)
+	require.NoError(t, err)
+	require.NoError(t, store.Append(testHostID, hwjournal.Entry{Changes: change("sda")}, 10))
+
+	// A new store on the same directory loads the history
+	store, err = hwjournal.NewFileStore(dir)
+	require.NoError(t, err)
+	history, err := store.History(testHostID)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, change("sda"), history[0].Changes)
+
+	// A corrupted journal is reset on the next entry
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.NoError(t, os.WriteFile(files[0], []byte("{"), 0o600))
+	_, err = store.History(testHostID)
+	assert.Error(t, err)
+	require.NoError(t, store.Append(testHostID, hwjournal.Entry{Changes: change("sdb")}, 10))
+	history, err = store.History(testHostID)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, change("sdb"), history[0].Changes)
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hwjournal
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+const (
+	journalDirPerm  = 0o750
+	journalFilePerm = 0o600
+)
+
+func appendBounded(entries []Entry, entry Entry, maxEntries int) []Entry {
+	entries = append(entries, entry)
+	if len(entries) > maxEntries {
+		entries = append([]Entry(nil), entries[len(entries)-maxEntries:]...)
+	}
+	return entries
+}
+
+// MemoryStore keeps the journal in memory, the history is lost on restart.
+type MemoryStore struct {
+	mu      sync.Mutex
+	entries map[util.TenantIDResourceIDTuple][]Entry
+}
+
+// NewMemoryStore returns an empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: make(map[util.TenantIDResourceIDTuple][]Entry)}
+}
+
+// Append implements Store.
+func (s *MemoryStore) Append(host util.TenantIDResourceIDTuple, entry Entry, maxEntries int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[host] = appendBounded(s.entries[host], entry, maxEntries)
+	return nil
+}
+
+// History implements Store.
+func (s *MemoryStore) History(host util.TenantIDResourceIDTuple) ([]Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Entry(nil), s.entries[host]...), nil
+}
+
+// Delete implements Store.
+func (s *MemoryStore) Delete(host util.TenantIDResourceIDTuple) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, host)
+	return nil
+}
+
+// FileStore persists the journal of each host in a JSON file of the given directory.
+type FileStore struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// NewFileStore returns a store persisting the journal in dir, created if missing.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, journalDirPerm); err != nil {
+		return nil, errors.Errorfc(codes.Internal, "cannot create hardware journal directory %s: %v", dir, err)
+	}
+	probe, err := os.CreateTemp(dir, ".probe-*")
+	if err != nil {
+		return nil, errors.Errorfc(codes.Internal, "cannot write in hardware journal directory %s: %v", dir, err)
+	}
+	if err = probe.Close(); err == nil {
+		err = os.Remove(probe.Name())
+	}
+	if err != nil {
+		return nil, errors.Errorfc(codes.Internal, "cannot write in hardware journal directory %s: %v", dir, err)
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+func (s *FileStore) path(host util.TenantIDResourceIDTuple) string {
+	// Tenant IDs are UUIDs and resource IDs are alphanumeric, both are safe file names
+	return filepath.Join(s.dir, filepath.Base(host.TenantID+"_"+host.ResourceID)+".json")
+}
+
+func (s *FileStore) read(host util.TenantIDResourceIDTuple) ([]Entry, error) {
+	data, err := os.ReadFile(s.path(host))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Errorfc(codes.Internal, "cannot read hardware journal of %s: %v", host, err)
+	}
+	var entries []Entry
+	if err = json.Unmarshal(data, &entries); err != nil {
+		return nil, errors.Errorfc(codes.Internal, "cannot decode hardware journal of %s: %v", host, err)
+	}
+	return entries, nil
+}
+
+// Append implements Store.
+func (s *FileStore) Append(host util.TenantIDResourceIDTuple, entry Entry, maxEntries int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entries, err := s.read(host)
+	if err != nil {
+		// A corrupted journal must not block the new entries
+		zlog.InfraSec().InfraErr(err).Msgf("resetting hardware journal of %s", host)
+		entries = nil
+	}
+	data, err := json.Marshal(appendBounded(entries, entry, maxEntries))
+	if err != nil {
+		return errors.Errorfc(codes.Internal, "cannot encode hardware journal of %s: %v", host, err)
+	}
+
+	// Write then rename, the journal is never left half written
+	tmpPath := s.path(host) + ".tmp"
+	if err = os.WriteFile(tmpPath, data, journalFilePerm); err != nil {
+		return errors.Errorfc(codes.Internal, "cannot write hardware journal of %s: %v", host, err)
+	}
+	if err = os.Rename(tmpPath, s.path(host)); err != nil {
+		if rmErr := os.Remove(tmpPath); rmErr != nil {
+			zlog.Warn().Err(rmErr).Msgf("cannot remove %s", tmpPath)
+		}
+		return errors.Errorfc(codes.Internal, "cannot write hardware journal of %s: %v", host, err)
+	}
+	return nil
+}
+
+// History implements Store.
+func (s *FileStore) History(host util.TenantIDResourceIDTuple) ([]Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.read(host)
+}
+
+// Delete implements Store.
+func (s *FileStore) Delete(host util.TenantIDResourceIDTuple) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := os.Remove(s.path(host)); err != nil && !os.IsNotExist(err) {
+		return errors.Errorfc(codes.Internal, "cannot delete hardware journal of %s: %v", host, err)
+	}
+	return nil
+}
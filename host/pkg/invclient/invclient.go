@@ -8,6 +8,7 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"slices"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -225,6 +226,27 @@ func GetHostResourceByResourceID(ctx context.Context, c inv_client.TenantAwareIn
 	return hostres, nil
 }
 
+// CreateHost creates a new Host resource in Inventory and returns the created resource.
+func CreateHost(
+	ctx context.Context, c inv_client.TenantAwareInventoryClient, tenantID string, host *computev1.HostResource,
+) (*computev1.HostResource, error) {
+	zlog.Debug().Msgf("Create Host: tenantID=%s, UUID=%s", tenantID, host.GetUuid())
+
+	ctx, cancel := context.WithTimeout(ctx, *InventoryTimeout)
+	defer cancel()
+	resource := &inv_v1.Resource{
+		Resource: &inv_v1.Resource_Host{
+			Host: host,
+		},
+	}
+	resp, err := c.Create(ctx, tenantID, resource)
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Failed create host resource: tenantID=%s, UUID=%s", tenantID, host.GetUuid())
+		return nil, err
+	}
+	return resp.GetHost(), nil
+}
+
 // CreateHostusb creates a new Hoststusb resource in Inventory.
 func CreateHostusb(
 	ctx context.Context, c inv_client.TenantAwareInventoryClient, tenantID string, hostusb *computev1.HostusbResource,
@@ -510,6 +532,103 @@ func ListIPAddresses(
 	return inv_util.GetSpecificResourceList[*network_v1.IPAddressResource](resources)
 }
 
+// FindCreatedResourceID returns the ID of the host storage, NIC, USB, GPU or IP address stored in Inventory
+// with the same identity as the given one, or an empty string if there is none. It allows retrying a create
+// whose outcome is unknown without duplicating the resource. The whole identity is compared, e.g. the WWID of
+// a disk or the MAC address of a NIC, so that a replaced device with the same name is not taken for the new one.
+// The known resources, e.g. the ones planned for deletion, are not created by a previous attempt and are skipped.
+func FindCreatedResourceID(ctx context.Context, c inv_client.TenantAwareInventoryClient, tenantID string,
+	resource proto.Message, known []string,
+) (string, error) {
+	filter, identity, err := createdResourceFilter(tenantID, resource)
+	if err != nil {
+		return "", err
+	}
+	resources, err := listAllResources(ctx, c, filter)
+	if err != nil {
+		return "", err
+	}
+	for _, res := range resources {
+		resourceID, err := inv_util.GetResourceIDFromResource(res)
+		if err != nil {
+			return "", err
+		}
+		if slices.Contains(known, resourceID) {
+			continue
+		}
+		created, err := inv_util.GetSetResource(res)
+		if err != nil {
+			return "", err
+		}
+		if util.ProtoEqualSubset(created, resource, identity...) {
+			return resourceID, nil
+		}
+	}
+	return "", nil
+}
+
+// createdResourceFilter returns the filter of the resources that may have been created for the given one,
+// together with the fields identifying it.
+func createdResourceFilter(tenantID string, resource proto.Message,
+) (resFilter *inv_v1.ResourceFilter, identity []string, err error) {
+	var invResource *inv_v1.Resource
+	var filter, tenantField string
+	switch res := resource.(type) {
+	case *computev1.HoststorageResource:
+		tenantField = computev1.HoststorageResourceFieldTenantId
+		invResource = &inv_v1.Resource{Resource: &inv_v1.Resource_Hoststorage{}}
+		filter = fmt.Sprintf("%s.%s = %q AND %s = %q",
+			computev1.HoststorageResourceEdgeHost, computev1.HostResourceFieldResourceId, res.GetHost().GetResourceId(),
+			computev1.HoststorageResourceFieldDeviceName, res.GetDeviceName())
+		identity = []string{
+			computev1.HoststorageResourceFieldDeviceName, computev1.HoststorageResourceFieldWwid,
+			computev1.HoststorageResourceFieldSerial,
+		}
+	case *computev1.HostnicResource:
+		tenantField = computev1.HostnicResourceFieldTenantId
+		invResource = &inv_v1.Resource{Resource: &inv_v1.Resource_Hostnic{}}
+		filter = fmt.Sprintf("%s.%s = %q AND %s = %q",
+			computev1.HostnicResourceEdgeHost, computev1.HostResourceFieldResourceId, res.GetHost().GetResourceId(),
+			computev1.HostnicResourceFieldDeviceName, res.GetDeviceName())
+		identity = []string{computev1.HostnicResourceFieldDeviceName, computev1.HostnicResourceFieldMacAddr}
+	case *computev1.HostusbResource:
+		tenantField = computev1.HostusbResourceFieldTenantId
+		invResource = &inv_v1.Resource{Resource: &inv_v1.Resource_Hostusb{}}
+		filter = fmt.Sprintf("%s.%s = %q AND %s = %d AND %s = %d",
+			computev1.HostusbResourceEdgeHost, computev1.HostResourceFieldResourceId, res.GetHost().GetResourceId(),
+			computev1.HostusbResourceFieldBus, res.GetBus(), computev1.HostusbResourceFieldAddr, res.GetAddr())
+		identity = []string{
+			computev1.HostusbResourceFieldBus, computev1.HostusbResourceFieldAddr, computev1.HostusbResourceFieldIdvendor,
+			computev1.HostusbResourceFieldIdproduct, computev1.HostusbResourceFieldSerial,
+		}
+	case *computev1.HostgpuResource:
+		tenantField = computev1.HostgpuResourceFieldTenantId
+		invResource = &inv_v1.Resource{Resource: &inv_v1.Resource_Hostgpu{}}
+		filter = fmt.Sprintf("%s.%s = %q AND %s = %q",
+			computev1.HostgpuResourceEdgeHost, computev1.HostResourceFieldResourceId, res.GetHost().GetResourceId(),
+			computev1.HostgpuResourceFieldPciId, res.GetPciId())
+		identity = []string{
+			computev1.HostgpuResourceFieldPciId, computev1.HostgpuResourceFieldVendor,
+			computev1.HostgpuResourceFieldProduct, computev1.HostgpuResourceFieldDeviceName,
+		}
+	case *network_v1.IPAddressResource:
+		tenantField = network_v1.IPAddressResourceFieldTenantId
+		invResource = &inv_v1.Resource{Resource: &inv_v1.Resource_Ipaddress{}}
+		filter = fmt.Sprintf("%s.%s = %q AND %s = %q",
+			network_v1.IPAddressResourceEdgeNic, computev1.HostnicResourceFieldResourceId, res.GetNic().GetResourceId(),
+			network_v1.IPAddressResourceFieldAddress, res.GetAddress())
+		identity = []string{network_v1.IPAddressResourceFieldAddress}
+	default:
+		err = inv_errors.Errorfc(codes.InvalidArgument, "unsupported resource type: %T", resource)
+		zlog.InfraSec().InfraErr(err).Msg("createdResourceFilter")
+		return nil, nil, err
+	}
+	return &inv_v1.ResourceFilter{
+		Resource: invResource,
+		Filter:   fmt.Sprintf("%s AND %s = %q", filter, tenantField, tenantID),
+	}, identity, nil
+}
+
 // CreateIPAddress creates a new IP address resource in Inventory.
 //
 //nolint:dupl // Protobuf oneOf-driven separation
@@ -617,6 +736,68 @@ func SetHostStatus(
 	return UpdateHostStatus(ctx, c, tenantID, updateHost)
 }
 
+// SetHostStatusDetail sets the status of the host along with the status details, kept in its metadata,
+// in one shot. The metadata is updated only if the details changed, see latestHostMetadata.
+func SetHostStatusDetail(
+	ctx context.Context, c inv_client.TenantAwareInventoryClient,
+	tenantID, resourceID string, hostStatus inv_status.ResourceStatus, update *util.MetadataUpdate,
+) error {
+	metadata, changed, err := latestHostMetadata(ctx, c, tenantID, resourceID, update)
+	if err != nil {
+		return err
+	}
+	updateHost := &computev1.HostResource{
+		ResourceId:          resourceID,
+		HostStatus:          hostStatus.Status,
+		HostStatusIndicator: hostStatus.StatusIndicator,
+		HostStatusTimestamp: uint64(time.Now().Unix()),
+		Metadata:            metadata,
+	}
+	fields := []string{
+		computev1.HostResourceFieldHostStatus,
+		computev1.HostResourceFieldHostStatusIndicator,
+		computev1.HostResourceFieldHostStatusTimestamp,
+	}
+	if changed {
+		fields = append(fields, computev1.HostResourceFieldMetadata)
+	}
+	return UpdateInvResourceFields(ctx, c, tenantID, updateHost, fields)
+}
+
+// UpdateHostMetadata updates the metadata keys of the host owned by Host Manager, only if they changed,
+// see latestHostMetadata.
+func UpdateHostMetadata(ctx context.Context, c inv_client.TenantAwareInventoryClient, tenantID, resourceID string,
+	update *util.MetadataUpdate,
+) error {
+	metadata, changed, err := latestHostMetadata(ctx, c, tenantID, resourceID, update)
+	if err != nil || !changed {
+		return err
+	}
+	updateHost := &computev1.HostResource{ResourceId: resourceID, Metadata: metadata}
+	return UpdateInvResourceFields(ctx, c, tenantID, updateHost, []string{computev1.HostResourceFieldMetadata})
+}
+
+// latestHostMetadata applies the update on the metadata of the host read from Inventory, never on a cached
+// Host, and returns whether it changed. The metadata is shared with the users: only the keys of the update
+// are changed, the ones edited by the users meanwhile are kept. Inventory has no conditional update, an edit
+// made between the read and the update of the metadata is still lost.
+func latestHostMetadata(ctx context.Context, c inv_client.TenantAwareInventoryClient, tenantID, resourceID string,
+	update *util.MetadataUpdate,
+) (string, bool, error) {
+	if update.IsEmpty() {
+		return "", false, nil
+	}
+	hostres, err := GetHostResourceByResourceID(ctx, c, tenantID, resourceID)
+	if err != nil {
+		return "", false, err
+	}
+	metadata, err := update.Apply(hostres.GetMetadata())
+	if err != nil {
+		return "", false, err
+	}
+	return metadata, metadata != hostres.GetMetadata(), nil
+}
+
 // GetHostResources Non-tenant specific function,should be used in bootstrap process of HRM only.
 func GetHostResources(ctx context.Context, c inv_client.TenantAwareInventoryClient) (
 	hostres []*computev1.HostResource, err error,
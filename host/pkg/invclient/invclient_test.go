@@ -107,6 +107,38 @@ func TestInvClient_GetHostResourceByResourceId(t *testing.T) {
 	assert.Equal(t, host.GetResourceId(), getHost.GetResourceId())
 }
 
+func TestInvClient_CreateHost(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	client := inv_testing.TestClients[inv_testing.RMClient].GetTenantAwareInventoryClient()
+	dao := inv_testing.NewInvResourceDAOOrFail(t)
+
+	// Error - create with resourceId is not allowed
+	h, err := invclient.CreateHost(ctx, client, tenant1, &computev1.HostResource{
+		ResourceId: "host-12345678",
+		TenantId:   tenant1,
+		Uuid:       "BFD3B398-9A4B-480D-AB53-4050ED108F5E",
+	})
+	require.Error(t, err)
+	assert.Nil(t, h)
+
+	// OK
+	h, err = invclient.CreateHost(ctx, client, tenant1, &computev1.HostResource{
+		TenantId:     tenant1,
+		Name:         "discovered host",
+		Uuid:         "BFD3B398-9A4B-480D-AB53-4050ED108F5E",
+		DesiredState: computev1.HostState_HOST_STATE_REGISTERED,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, h)
+	t.Cleanup(func() { dao.HardDeleteHost(t, tenant1, h.GetResourceId()) })
+	assert.NotEmpty(t, h.GetResourceId())
+
+	getHost, err := invclient.GetHostResourceByGUID(ctx, client, tenant1, h.GetUuid())
+	require.NoError(t, err)
+	assert.Equal(t, h.GetResourceId(), getHost.GetResourceId())
+}
+
 func TestInvClient_CreateHostusb(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
@@ -295,6 +327,51 @@ func TestInvClient_DeleteHoststorage(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestInvClient_FindCreatedResourceID(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	client := inv_testing.TestClients[inv_testing.RMClient].GetTenantAwareInventoryClient()
+	dao := inv_testing.NewInvResourceDAOOrFail(t)
+
+	host := dao.CreateHost(t, tenant1)
+	replaced := &computev1.HoststorageResource{
+		TenantId:   tenant1,
+		DeviceName: "sda",
+		Host:       host,
+		Wwid:       "0x50026b7684e50ff8",
+	}
+	replacedID, err := invclient.CreateHoststorage(ctx, client, tenant1, replaced)
+	require.NoError(t, err)
+	t.Cleanup(func() { dao.DeleteResource(t, tenant1, replacedID) })
+
+	// A new disk with the same name is not the replaced one
+	created := &computev1.HoststorageResource{
+		TenantId:   tenant1,
+		DeviceName: "sda",
+		Host:       host,
+		Wwid:       "eui.01000000010000005cd2e43cf16e5451",
+	}
+	id, err := invclient.FindCreatedResourceID(ctx, client, tenant1, created, nil)
+	require.NoError(t, err)
+	assert.Empty(t, id)
+
+	createdID, err := invclient.CreateHoststorage(ctx, client, tenant1, created)
+	require.NoError(t, err)
+	t.Cleanup(func() { dao.DeleteResource(t, tenant1, createdID) })
+	id, err = invclient.FindCreatedResourceID(ctx, client, tenant1, created, []string{replacedID})
+	require.NoError(t, err)
+	assert.Equal(t, createdID, id)
+
+	// The known resources, e.g. planned for deletion, are never the created ones
+	id, err = invclient.FindCreatedResourceID(ctx, client, tenant1, replaced, []string{replacedID})
+	require.NoError(t, err)
+	assert.Empty(t, id)
+
+	_, err = invclient.FindCreatedResourceID(ctx, client, tenant1, host, nil)
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc_status.Code(err))
+}
+
 func TestInvClient_SetHostAsConnectionLost(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
@@ -418,6 +495,63 @@ func TestInvClient_SetHostStatus(t *testing.T) {
 	assert.LessOrEqual(t, uint64(timeBeforeUpdate), getHost.GetHostStatusTimestamp())
 }
 
+func TestInvClient_SetHostStatusDetail(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	client := inv_testing.TestClients[inv_testing.RMClient].GetTenantAwareInventoryClient()
+	dao := inv_testing.NewInvResourceDAOOrFail(t)
+
+	host := dao.CreateHost(t, tenant1)
+	update := &util.MetadataUpdate{Set: map[string]string{"host-status-details": "disk full"}}
+	err := invclient.SetHostStatusDetail(ctx, client, tenant1, host.GetResourceId(), hrm_status.HostStatusError, update)
+	require.NoError(t, err)
+
+	getHost, err := invclient.GetHostResourceByGUID(ctx, client, tenant1, host.GetUuid())
+	require.NoError(t, err)
+	assert.Equal(t, hrm_status.HostStatusError.Status, getHost.GetHostStatus())
+	assert.Equal(t, hrm_status.HostStatusError.StatusIndicator, getHost.GetHostStatusIndicator())
+	assert.JSONEq(t, `[{"key":"host-status-details","value":"disk full"}]`, getHost.GetMetadata())
+
+	// A key set by the user after the Host was read is kept
+	getHost.Metadata = `[{"key":"host-status-details","value":"disk full"},{"key":"site","value":"lab"}]`
+	err = invclient.UpdateInvResourceFields(ctx, client, tenant1, getHost, []string{computev1.HostResourceFieldMetadata})
+	require.NoError(t, err)
+	update = &util.MetadataUpdate{Deleted: []string{"host-status-details"}}
+	err = invclient.SetHostStatusDetail(ctx, client, tenant1, host.GetResourceId(), hrm_status.HostStatusRunning, update)
+	require.NoError(t, err)
+
+	getHost, err = invclient.GetHostResourceByGUID(ctx, client, tenant1, host.GetUuid())
+	require.NoError(t, err)
+	assert.Equal(t, hrm_status.HostStatusRunning.Status, getHost.GetHostStatus())
+	assert.JSONEq(t, `[{"key":"site","value":"lab"}]`, getHost.GetMetadata())
+}
+
+func TestInvClient_UpdateHostMetadata(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	client := inv_testing.TestClients[inv_testing.RMClient].GetTenantAwareInventoryClient()
+	dao := inv_testing.NewInvResourceDAOOrFail(t)
+
+	host := dao.CreateHost(t, tenant1)
+	host.Metadata = `[{"key":"site","value":"lab"},{"key":"label/gpu","value":"true"}]`
+	err := invclient.UpdateInvResourceFields(ctx, client, tenant1, host, []string{computev1.HostResourceFieldMetadata})
+	require.NoError(t, err)
+
+	// Only the keys of Host Manager change, the stale labels are deleted
+	update := &util.MetadataUpdate{
+		Set:      map[string]string{"label/cpu.avx512": "true", "os-release": "Ubuntu 24.04"},
+		Prefixes: []string{"label/"},
+	}
+	err = invclient.UpdateHostMetadata(ctx, client, tenant1, host.GetResourceId(), update)
+	require.NoError(t, err)
+	getHost, err := invclient.GetHostResourceByGUID(ctx, client, tenant1, host.GetUuid())
+	require.NoError(t, err)
+	assert.JSONEq(t,
+		`[{"key":"site","value":"lab"},{"key":"label/cpu.avx512","value":"true"},{"key":"os-release","value":"Ubuntu 24.04"}]`,
+		getHost.GetMetadata())
+
+}
+
 func TestInvClient_GetHostResources(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
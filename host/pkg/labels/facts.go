@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package labels
+
+import (
+	"math"
+	"strconv"
+
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+)
+
+// Facts extracted from the system information, to be used by the rules.
+const (
+	FactCPUArch      = "cpu.arch"
+	FactCPUVendor    = "cpu.vendor"
+	FactCPUModel     = "cpu.model"
+	FactCPUSockets   = "cpu.sockets"
+	FactCPUCores     = "cpu.cores"
+	FactCPUThreads   = "cpu.threads"
+	FactCPUFeatures  = "cpu.features"
+	FactCPUCoreTypes = "cpu.core_types"
+	// FactMemGB is the memory size rounded to the nearest GiB
+	FactMemGB       = "mem.gb"
+	FactGPUCount    = "gpu.count"
+	FactGPUVendor   = "gpu.vendor"
+	FactGPUProduct  = "gpu.product"
+	FactGPUFeatures = "gpu.features"
+	FactNICCount    = "nic.count"
+	FactNICFeatures = "nic.features"
+	// FactNICSriov has a true or false value per NIC, whether SR-IOV is enabled
+	FactNICSriov = "nic.sriov"
+	// FactNICSpeedGbps has the current speed of each NIC in Gbps
+	FactNICSpeedGbps = "nic.speed_gbps"
+)
+
+// KnownFacts are the facts that can be used by the rules.
+var KnownFacts = []string{
+	FactCPUArch, FactCPUVendor, FactCPUModel, FactCPUSockets, FactCPUCores, FactCPUThreads, FactCPUFeatures,
+	FactCPUCoreTypes, FactMemGB, FactGPUCount, FactGPUVendor, FactGPUProduct, FactGPUFeatures, FactNICCount,
+	FactNICFeatures, FactNICSriov, FactNICSpeedGbps,
+}
+
+const (
+	bytesPerGiB = 1 << 30
+	bpsPerGbps  = 1e9
+)
+
+// Facts extracts the facts from the hardware information of the system information. The counts of
+// devices are always set, the other facts are set only when reported.
+func Facts(systemInfo *pb.SystemInfo) map[string][]string {
+	hwInfo := systemInfo.GetHwInfo()
+	facts := make(map[string][]string)
+	if hwInfo == nil {
+		return facts
+	}
+	add := func(fact string, values ...string) {
+		for _, v := range values {
+			if v != "" {
+				facts[fact] = append(facts[fact], v)
+			}
+		}
+	}
+
+	if cpu := hwInfo.GetCpu(); cpu != nil {
+		add(FactCPUArch, cpu.GetArch())
+		add(FactCPUVendor, cpu.GetVendor())
+		add(FactCPUModel, cpu.GetModel())
+		add(FactCPUSockets, formatUint(uint64(cpu.GetSockets())))
+		add(FactCPUCores, formatUint(uint64(cpu.GetCores())))
+		add(FactCPUThreads, formatUint(uint64(cpu.GetThreads())))
+		add(FactCPUFeatures, cpu.GetFeatures()...)
+		for _, socket := range cpu.GetCpuTopology().GetSockets() {
+			for _, group := range socket.GetCoreGroups() {
+				add(FactCPUCoreTypes, group.GetCoreType())
+			}
+		}
+	}
+	if size := hwInfo.GetMemory().GetSize(); size > 0 {
+		add(FactMemGB, formatUint(uint64(math.Round(float64(size)/bytesPerGiB))))
+	}
+
+	gpus := 0
+	for _, gpu := range hwInfo.GetGpu() {
+		// Older agents report an empty GPU
+		if gpu.GetPciId() == "" && gpu.GetName() == "" {
+			continue
+		}
+		gpus++
+		add(FactGPUVendor, gpu.GetVendor())
+		add(FactGPUProduct, gpu.GetProduct())
+		add(FactGPUFeatures, gpu.GetFeatures()...)
+	}
+	add(FactGPUCount, strconv.Itoa(gpus))
+
+	add(FactNICCount, strconv.Itoa(len(hwInfo.GetNetwork())))
+	for _, nic := range hwInfo.GetNetwork() {
+		add(FactNICFeatures, nic.GetFeatures()...)
+		add(FactNICSriov, strconv.FormatBool(nic.GetSriovenabled()))
+		if speed := nic.GetCurrentSpeed(); speed > 0 {
+			add(FactNICSpeedGbps, strconv.FormatFloat(float64(speed)/bpsPerGbps, 'f', -1, 64))
+		}
+	}
+	return facts
+}
+
+func formatUint(n uint64) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.FormatUint(n, 10)
+}
@@ -0,0 +1,261 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package labels derives normalized labels from the system information reported by the hosts, such as
+// cpu.avx512=true or gpu.vendor=intel, so that the hosts can be selected by capability for workload placement.
+// The labels are derived by rules, loaded from a JSON file, from the facts extracted from the system information.
+package labels
+
+import (
+	"bytes"
+	_ "embed" // default rules
+	"encoding/json"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+var zlog = logging.GetLogger("HostManagerLabels")
+
+// MetadataKeyPrefix is the prefix of the keys of the labels in the Host metadata, e.g. label/cpu.avx512.
+const MetadataKeyPrefix = "label/"
+
+const (
+	maxLabelLength  = 63
+	valuesSeparator = ","
+)
+
+// Operators of the rules. The comparisons are true if any value of the fact matches.
+const (
+	// OpValue sets the label to the normalized values of the fact.
+	OpValue = ""
+	// OpContains is true if a value of the fact is the rule value, ignoring the case.
+	OpContains = "contains"
+	// OpEqual is an alias of OpContains.
+	OpEqual = "=="
+	// OpNotEqual is true if no value of the fact is the rule value, ignoring the case.
+	OpNotEqual = "!="
+	// OpGreater, OpGreaterOrEqual, OpLess and OpLessOrEqual compare the numeric values of the fact.
+	OpGreater        = ">"
+	OpGreaterOrEqual = ">="
+	OpLess           = "<"
+	OpLessOrEqual    = "<="
+)
+
+var (
+	defaultRuleSetMu sync.RWMutex
+	defaultRuleSet   = DefaultRules()
+
+	//go:embed default_rules.json
+	defaultRulesJSON []byte
+
+	labelPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_.<>=-]*$`)
+)
+
+// Rule derives a label from a fact of the system information.
+type Rule struct {
+	Label string `json:"label"`
+	Fact  string `json:"fact"`
+	Op    string `json:"op,omitempty"`
+	Value string `json:"value,omitempty"`
+	// Map replaces the reported values of the fact, e.g. "GenuineIntel" by "intel", before Lower
+	Map map[string]string `json:"map,omitempty"`
+	// Lower lowercases the values of the fact
+	Lower bool `json:"lower,omitempty"`
+}
+
+// RuleSet is the set of rules deriving the labels of the hosts.
+type RuleSet struct {
+	Rules []Rule `json:"rules"`
+}
+
+// ParseRules parses and validates a JSON rule set.
+func ParseRules(data []byte) (*RuleSet, error) {
+	var rs RuleSet
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&rs); err != nil {
+		return nil, errors.Errorfc(codes.InvalidArgument, "invalid label rules: %v", err)
+	}
+	if err := rs.Validate(); err != nil {
+		return nil, err
+	}
+	return &rs, nil
+}
+
+// LoadRules loads a JSON rule set from a file, or returns the built-in rule set if path is empty.
+func LoadRules(path string) (*RuleSet, error) {
+	if path == "" {
+		return DefaultRules(), nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Errorfc(codes.InvalidArgument, "cannot read the label rules %s: %v", path, err)
+	}
+	return ParseRules(data)
+}
+
+// DefaultRules returns the built-in rule set.
+func DefaultRules() *RuleSet {
+	rs, err := ParseRules(defaultRulesJSON)
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("invalid built-in label rules")
+		return &RuleSet{}
+	}
+	return rs
+}
+
+// Default returns the rule set of the Host Manager, the built-in rule set until it is configured with SetDefault.
+func Default() *RuleSet {
+	defaultRuleSetMu.RLock()
+	defer defaultRuleSetMu.RUnlock()
+	return defaultRuleSet
+}
+
+// SetDefault replaces the rule set of the Host Manager.
+func SetDefault(rs *RuleSet) {
+	defaultRuleSetMu.Lock()
+	defer defaultRuleSetMu.Unlock()
+	defaultRuleSet = rs
+}
+
+// Validate checks that the rules derive distinct and valid labels from known facts.
+func (rs *RuleSet) Validate() error {
+	seen := make(map[string]struct{}, len(rs.Rules))
+	for _, rule := range rs.Rules {
+		if len(rule.Label) > maxLabelLength || !labelPattern.MatchString(rule.Label) {
+			return errors.Errorfc(codes.InvalidArgument, "invalid label %q", rule.Label)
+		}
+		if _, ok := seen[rule.Label]; ok {
+			return errors.Errorfc(codes.InvalidArgument, "duplicated label %q", rule.Label)
+		}
+		seen[rule.Label] = struct{}{}
+		if !slices.Contains(KnownFacts, rule.Fact) {
+			return errors.Errorfc(codes.InvalidArgument, "unknown fact %q of label %q", rule.Fact, rule.Label)
+		}
+		if err := rule.validateOp(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r Rule) validateOp() error {
+	switch r.Op {
+	case OpValue:
+		if r.Value != "" {
+			return errors.Errorfc(codes.InvalidArgument, "unexpected value of label %q", r.Label)
+		}
+	case OpContains, OpEqual, OpNotEqual:
+		if r.Value == "" {
+			return errors.Errorfc(codes.InvalidArgument, "missing value of label %q", r.Label)
+		}
+	case OpGreater, OpGreaterOrEqual, OpLess, OpLessOrEqual:
+		if _, err := strconv.ParseFloat(r.Value, 64); err != nil {
+			return errors.Errorfc(codes.InvalidArgument, "invalid numeric value %q of label %q", r.Value, r.Label)
+		}
+	default:
+		return errors.Errorfc(codes.InvalidArgument, "unknown operator %q of label %q", r.Op, r.Label)
+	}
+	return nil
+}
+
+// normalize applies the mapping and the lowercasing of the rule to a value.
+func (r Rule) normalize(value string) string {
+	value = strings.TrimSpace(value)
+	if mapped, ok := r.Map[value]; ok {
+		value = mapped
+	}
+	if r.Lower {
+		value = strings.ToLower(value)
+	}
+	return value
+}
+
+// compare returns true if a value of the fact matches the rule.
+func (r Rule) compare(values []string) bool {
+	switch r.Op {
+	case OpContains, OpEqual:
+		return slices.ContainsFunc(values, func(v string) bool { return strings.EqualFold(v, r.Value) })
+	case OpNotEqual:
+		return !slices.ContainsFunc(values, func(v string) bool { return strings.EqualFold(v, r.Value) })
+	}
+	// The operator and the rule value have been validated
+	threshold, _ := strconv.ParseFloat(r.Value, 64)
+	return slices.ContainsFunc(values, func(v string) bool {
+		n, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return false
+		}
+		switch r.Op {
+		case OpGreater:
+			return n > threshold
+		case OpGreaterOrEqual:
+			return n >= threshold
+		case OpLess:
+			return n < threshold
+		default:
+			return n <= threshold
+		}
+	})
+}
+
+// Derive returns the labels derived from the facts. The facts that are not reported derive no label.
+func (rs *RuleSet) Derive(facts map[string][]string) map[string]string {
+	labels := make(map[string]string, len(rs.Rules))
+	for _, rule := range rs.Rules {
+		reported := facts[rule.Fact]
+		if len(reported) == 0 {
+			continue
+		}
+		values := make([]string, 0, len(reported))
+		for _, v := range reported {
+			if v = rule.normalize(v); v != "" {
+				values = append(values, v)
+			}
+		}
+		if rule.Op != OpValue {
+			labels[rule.Label] = strconv.FormatBool(rule.compare(values))
+			continue
+		}
+		slices.Sort(values)
+		if values = slices.Compact(values); len(values) > 0 {
+			labels[rule.Label] = strings.Join(values, valuesSeparator)
+		}
+	}
+	return labels
+}
+
+// Metadata returns the Host metadata of the labels.
+func Metadata(labels map[string]string) map[string]string {
+	metadata := make(map[string]string, len(labels))
+	for label, value := range labels {
+		metadata[MetadataKeyPrefix+label] = value
+	}
+	return metadata
+}
+
+// FromMetadata returns the labels stored in the Host metadata, to select the hosts by capability.
+func FromMetadata(metadata string) (map[string]string, error) {
+	metaList, err := util.ParseMetadata(metadata)
+	if err != nil {
+		return nil, err
+	}
+	labels := make(map[string]string)
+	for _, m := range metaList {
+		if label, ok := strings.CutPrefix(m.Key, MetadataKeyPrefix); ok {
+			labels[label] = m.Value
+		}
+	}
+	return labels, nil
+}
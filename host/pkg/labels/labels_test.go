@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package labels_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/labels"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+var systemInfo = &pb.SystemInfo{
+	HwInfo: &pb.HWInfo{
+		Cpu: &pb.SystemCPU{
+			Arch:     "x86_64",
+			Vendor:   "GenuineIntel",
+			Sockets:  1,
+			Cores:    16,
+			Threads:  24,
+			Features: []string{"sse4_2", "avx2", "avx512f", "sgx"},
+			CpuTopology: &pb.CPUTopology{Sockets: []*pb.Socket{{CoreGroups: []*pb.CoreGroup{
+				{CoreType: "P-Core", CoreList: []uint32{0, 1}},
+				{CoreType: "E-Core", CoreList: []uint32{2, 3}},
+			}}}},
+		},
+		Memory: &pb.SystemMemory{Size: 63 << 30},
+		Gpu: []*pb.SystemGPU{
+			{},
+			{PciId: "0000:00:02.0", Name: "gpu0", Vendor: "Intel Corporation", Features: []string{"fb"}},
+		},
+		Network: []*pb.SystemNetwork{
+			{Name: "eth0", CurrentSpeed: 1_000_000_000},
+			{Name: "eth1", CurrentSpeed: 25_000_000_000, Sriovenabled: true},
+		},
+	},
+}
+
+func TestFacts(t *testing.T) {
+	facts := labels.Facts(systemInfo)
+	assert.Equal(t, []string{"x86_64"}, facts[labels.FactCPUArch])
+	assert.Equal(t, []string{"16"}, facts[labels.FactCPUCores])
+	assert.Equal(t, []string{"P-Core", "E-Core"}, facts[labels.FactCPUCoreTypes])
+	assert.Equal(t, []string{"63"}, facts[labels.FactMemGB])
+	assert.Equal(t, []string{"1"}, facts[labels.FactGPUCount])
+	assert.Equal(t, []string{"Intel Corporation"}, facts[labels.FactGPUVendor])
+	assert.Equal(t, []string{"2"}, facts[labels.FactNICCount])
+	assert.Equal(t, []string{"false", "true"}, facts[labels.FactNICSriov])
+	assert.Equal(t, []string{"1", "25"}, facts[labels.FactNICSpeedGbps])
+	assert.NotContains(t, facts, labels.FactCPUModel)
+
+	assert.Empty(t, labels.Facts(&pb.SystemInfo{}))
+}
+
+func TestDefaultRules(t *testing.T) {
+	derived := labels.DefaultRules().Derive(labels.Facts(systemInfo))
+	assert.Equal(t, map[string]string{
+		"cpu.arch":       "x86_64",
+		"cpu.vendor":     "intel",
+		"cpu.avx512":     "true",
+		"cpu.amx":        "false",
+		"cpu.sgx":        "true",
+		"core_type":      "E-Core,P-Core",
+		"mem.gb>=16":     "true",
+		"mem.gb>=64":     "false",
+		"mem.gb>=256":    "false",
+		"gpu.vendor":     "intel",
+		"gpu.present":    "true",
+		"nic.sriov":      "true",
+		"nic.speed>=10g": "true",
+	}, derived)
+}
+
+func TestParseRules(t *testing.T) {
+	testCases := map[string]struct {
+		rules string
+		valid bool
+	}{
+		"Valid": {
+			rules: `{"rules":[{"label":"cpu.model","fact":"cpu.model"},` +
+				`{"label":"cpu.cores>=8","fact":"cpu.cores","op":">=","value":"8"},` +
+				`{"label":"gpu.fb","fact":"gpu.features","op":"contains","value":"fb"}]}`,
+			valid: true,
+		},
+		"UnknownField":    {rules: `{"rules":[{"label":"cpu.model","fact":"cpu.model","lowercase":true}]}`},
+		"UnknownFact":     {rules: `{"rules":[{"label":"cpu.model","fact":"cpu.name"}]}`},
+		"InvalidLabel":    {rules: `{"rules":[{"label":"CPU Model","fact":"cpu.model"}]}`},
+		"DuplicatedLabel": {rules: `{"rules":[{"label":"cpu","fact":"cpu.model"},{"label":"cpu","fact":"cpu.arch"}]}`},
+		"UnknownOperator": {rules: `{"rules":[{"label":"cpu","fact":"cpu.cores","op":"~","value":"8"}]}`},
+		"NonNumericValue": {rules: `{"rules":[{"label":"cpu","fact":"cpu.cores","op":">","value":"many"}]}`},
+		"MissingValue":    {rules: `{"rules":[{"label":"cpu","fact":"cpu.features","op":"contains"}]}`},
+		"UnexpectedValue": {rules: `{"rules":[{"label":"cpu","fact":"cpu.model","value":"x"}]}`},
+		"InvalidJSON":     {rules: `{"rules":`},
+		"LabelTooLong":    {rules: `{"rules":[{"label":"` + strings.Repeat("a", 64) + `","fact":"cpu.model"}]}`},
+	}
+	for tcName, tc := range testCases {
+		t.Run(tcName, func(t *testing.T) {
+			rs, err := labels.ParseRules([]byte(tc.rules))
+			if !tc.valid {
+				require.Error(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, rs.Rules)
+		})
+	}
+}
+
+func TestLoadRules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"rules":[
+		{"label":"cpu.arch","fact":"cpu.arch","map":{"x86_64":"amd64"}},
+		{"label":"arm","fact":"cpu.arch","op":"!=","value":"x86_64"},
+		{"label":"threads<32","fact":"cpu.threads","op":"<","value":"32"},
+		{"label":"gpu.product","fact":"gpu.product"}
+	]}`), 0o600))
+	rs, err := labels.LoadRules(path)
+	require.NoError(t, err)
+	// The facts that are not reported derive no label
+	assert.Equal(t, map[string]string{"cpu.arch": "amd64", "arm": "false", "threads<32": "true"},
+		rs.Derive(labels.Facts(systemInfo)))
+
+	_, err = labels.LoadRules(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+
+	// Without file, the built-in rules are used
+	rs, err = labels.LoadRules("")
+	require.NoError(t, err)
+	assert.Equal(t, labels.DefaultRules(), rs)
+}
+
+func TestMetadata(t *testing.T) {
+	set := labels.Metadata(map[string]string{"cpu.avx512": "true", "gpu.vendor": "intel"})
+	assert.Equal(t, map[string]string{"label/cpu.avx512": "true", "label/gpu.vendor": "intel"}, set)
+
+	old := `[{"key":"site","value":"lab"},{"key":"label/nic.sriov","value":"true"}]`
+	metaList, err := util.ParseMetadata(old)
+	require.NoError(t, err)
+	stale := util.StaleMetadataKeys(metaList, labels.MetadataKeyPrefix, set)
+	assert.Equal(t, []string{"label/nic.sriov"}, stale)
+	metadata, err := util.MergeMetadata(old, set, stale...)
+	require.NoError(t, err)
+
+	derived, err := labels.FromMetadata(metadata)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"cpu.avx512": "true", "gpu.vendor": "intel"}, derived)
+}
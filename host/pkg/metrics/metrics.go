@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package metrics defines the Prometheus metrics exported by the Host Manager.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "hostmgr"
+
+// HardwareDriftEvents counts the hardware changes detected on hosts, by component kind.
+var HardwareDriftEvents = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "hardware_drift_events_total",
+	Help:      "Number of hardware changes detected on hosts, by component.",
+}, []string{"component"})
+
+// OSDriftEvents counts the hosts found running another OS than the one of their Instance in Inventory.
+var OSDriftEvents = prometheus.NewCounter(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "os_drift_events_total",
+	Help:      "Number of hosts detected running another OS than the one of their Instance.",
+})
+
+// OutdatedAgentEvents counts the hosts found running agents below their minimum version.
+var OutdatedAgentEvents = prometheus.NewCounter(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "outdated_agent_events_total",
+	Help:      "Number of hosts detected running agents below their minimum version.",
+})
+
+// ComplianceViolationEvents counts the hosts found with hardware violating their compliance profile.
+var ComplianceViolationEvents = prometheus.NewCounter(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "compliance_violation_events_total",
+	Help:      "Number of hosts detected with hardware violating their compliance profile.",
+})
+
+// IdentityMismatchReports counts the system information reports not matching the pinned identity of their host.
+var IdentityMismatchReports = prometheus.NewCounter(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "identity_mismatch_reports_total",
+	Help:      "Number of system information reports not matching the pinned identity of their host.",
+})
+
+// RebootEvents counts the reboots detected from the boot ID reported by the hosts, expected or not.
+var RebootEvents = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "reboot_events_total",
+	Help:      "Number of host reboots detected, by whether they were expected.",
+}, []string{"expected"})
+
+// ClockSkewSeconds observes the clock skew of the hosts, measured on the agent time reported with their status.
+var ClockSkewSeconds = prometheus.NewHistogram(prometheus.HistogramOpts{
+	Namespace: namespace,
+	Name:      "clock_skew_seconds",
+	Help:      "Absolute skew between the clock of the hosts and the one of the host manager, in seconds.",
+	Buckets:   []float64{1, 5, 30, 60, 300, 3600, 86400},
+})
+
+// IllegalStatusTransitions counts the host and Instance statuses and Instance states reported by agents that
+// cannot follow the previous ones.
+var IllegalStatusTransitions = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "illegal_status_transitions_total",
+	Help:      "Number of illegal host and instance status transitions reported by agents, by previous and reported status.",
+}, []string{"from", "to"})
+
+// StaleStatusUpdates counts the late or replayed status updates ignored, by stream of updates.
+var StaleStatusUpdates = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "stale_status_updates_total",
+	Help:      "Number of late or replayed status updates ignored, by stream of updates.",
+}, []string{"stream"})
+
+// HostCacheLookups counts the lookups of Hosts in the local cache, by result (hit or miss).
+var HostCacheLookups = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "host_cache_lookups_total",
+	Help:      "Number of lookups of Hosts in the local cache, by result.",
+}, []string{"result"})
+
+// ConnectionLossStorms is the number of tenants and sites in a connection-loss storm, by scope (tenant or site).
+var ConnectionLossStorms = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: namespace,
+	Name:      "connection_loss_storms",
+	Help:      "Number of tenants and sites in which a large share of the hosts lost their heartbeat together, by scope.",
+}, []string{"scope"})
+
+// PausedConnectionLostUpdates is the number of "No Connection" updates paused by connection-loss storms.
+var PausedConnectionLostUpdates = prometheus.NewGauge(prometheus.GaugeOpts{
+	Namespace: namespace,
+	Name:      "paused_connection_lost_updates",
+	Help:      "Number of hosts that lost their heartbeat during a connection-loss storm, not updated yet in Inventory.",
+})
+
+// Collectors returns all the Host Manager collectors, to be registered in the metrics exporter.
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		HardwareDriftEvents,
+		OSDriftEvents,
+		OutdatedAgentEvents,
+		ComplianceViolationEvents,
+		IdentityMismatchReports,
+		RebootEvents,
+		ClockSkewSeconds,
+		IllegalStatusTransitions,
+		StaleStatusUpdates,
+		HostCacheLookups,
+		ConnectionLossStorms,
+		PausedConnectionLostUpdates,
+	}
+}
@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ordering_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-edge-platform/infra-managers/host/pkg/ordering"
+)
+
+const (
+	tenant1 = "11111111-1111-1111-1111-111111111111"
+	guid1   = "BFD3B398-9A4B-480D-AB53-4050ED108F5C"
+	guid2   = "0F2C4B6E-3F7A-4C1B-9F7E-2B8D5C3A1E90"
+	stream  = "status"
+)
+
+func TestStamp_After(t *testing.T) {
+	testCases := map[string]struct {
+		stamp    ordering.Stamp
+		other    ordering.Stamp
+		expected bool
+	}{
+		"LaterSequence": {
+			stamp:    ordering.Stamp{Timestamp: 2, Sequence: 2},
+			other:    ordering.Stamp{Timestamp: 1, Sequence: 1},
+			expected: true,
+		},
+		"LaterSequenceEarlierTimestamp": {
+			// The clock of the agent has been stepped back
+			stamp:    ordering.Stamp{Timestamp: 1, Sequence: 2},
+			other:    ordering.Stamp{Timestamp: 2, Sequence: 1},
+			expected: true,
+		},
+		"EarlierSequenceLaterTimestamp": {
+			stamp:    ordering.Stamp{Timestamp: 2, Sequence: 1},
+			other:    ordering.Stamp{Timestamp: 1, Sequence: 2},
+			expected: false,
+		},
+		"SameSequenceLaterTimestamp": {
+			stamp:    ordering.Stamp{Timestamp: 2, Sequence: 1},
+			other:    ordering.Stamp{Timestamp: 1, Sequence: 1},
+			expected: true,
+		},
+		"LaterSequenceOnly": {
+			stamp:    ordering.Stamp{Sequence: 2},
+			other:    ordering.Stamp{Sequence: 1},
+			expected: true,
+		},
+		"EarlierSequenceOnly": {
+			stamp:    ordering.Stamp{Sequence: 1},
+			other:    ordering.Stamp{Sequence: 2},
+			expected: false,
+		},
+		"LaterTimestampWithoutSequence": {
+			stamp:    ordering.Stamp{Timestamp: 2},
+			other:    ordering.Stamp{Timestamp: 1, Sequence: 9},
+			expected: true,
+		},
+		"EarlierTimestampWithoutSequence": {
+			stamp:    ordering.Stamp{Timestamp: 1, Sequence: 9},
+			other:    ordering.Stamp{Timestamp: 2},
+			expected: false,
+		},
+		"Replay": {
+			stamp:    ordering.Stamp{Timestamp: 1, Sequence: 1},
+			other:    ordering.Stamp{Timestamp: 1, Sequence: 1},
+			expected: false,
+		},
+	}
+	for tcName, tc := range testCases {
+		t.Run(tcName, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.stamp.After(tc.other))
+		})
+	}
+}
+
+func TestTracker(t *testing.T) {
+	tracker := ordering.NewTracker()
+	first := ordering.Stamp{Timestamp: 100, Sequence: 1}
+	second := ordering.Stamp{Timestamp: 200, Sequence: 2}
+
+	updates := tracker.Lock(tenant1, guid1)
+	assert.False(t, updates.IsStale(stream, first))
+	updates.Record(stream, second)
+	updates.Unlock()
+
+	// Late and replayed updates are stale, whatever the case of the GUID
+	updates = tracker.Lock(tenant1, "bfd3b398-9a4b-480d-ab53-4050ed108f5c")
+	assert.True(t, updates.IsStale(stream, first))
+	assert.True(t, updates.IsStale(stream, second))
+	// Updates without a stamp are never stale
+	assert.False(t, updates.IsStale(stream, ordering.Stamp{}))
+	// Streams are independent
+	assert.False(t, updates.IsStale("instance", first))
+
+	// A late update recorded afterwards does not move the last update back
+	updates.Record(stream, first)
+	assert.True(t, updates.IsStale(stream, first))
+	// A later sequence number is applied even if the clock of the agent has been stepped back
+	assert.False(t, updates.IsStale(stream, ordering.Stamp{Timestamp: 50, Sequence: 3}))
+	updates.Unlock()
+
+	// Hosts are independent
+	updates = tracker.Lock(tenant1, guid2)
+	assert.False(t, updates.IsStale(stream, first))
+	updates.Unlock()
+
+	// A deleted host starts from scratch
+	tracker.Forget(tenant1, guid1)
+	updates = tracker.Lock(tenant1, guid1)
+	assert.False(t, updates.IsStale(stream, first))
+	updates.Unlock()
+}
+
+func TestTracker_Lock(t *testing.T) {
+	tracker := ordering.NewTracker()
+
+	// Concurrent updates of a host are checked, applied and recorded one at a time
+	var wg sync.WaitGroup
+	var applied atomic.Int32
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			updates := tracker.Lock(tenant1, guid1)
+			defer updates.Unlock()
+			stamp := ordering.Stamp{Sequence: 1}
+			if updates.IsStale(stream, stamp) {
+				return
+			}
+			applied.Add(1)
+			updates.Record(stream, stamp)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), applied.Load())
+}
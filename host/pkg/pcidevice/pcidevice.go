@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pcidevice keeps the PCI devices reported by each host. Inventory has no PCI device
+// resource, the devices are kept in a Store by Host Manager and can be searched, e.g. to find
+// the hosts having a given accelerator through the admin service of Host Manager.
+package pcidevice
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+var zlog = logging.GetLogger("HostManagerPCIDevice")
+
+// DefaultDir is the default directory where the PCI devices are persisted.
+const DefaultDir = "/var/lib/hostmgr/pcidevices"
+
+var (
+	defaultStoreMu sync.RWMutex
+	defaultStore   Store = NewMemoryStore()
+)
+
+// Device is a PCI device of a host, identified by its slot.
+type Device struct {
+	// Slot is the PCI address of the device, such as "0000:03:00.0"
+	Slot     string `json:"slot"`
+	Class    string `json:"class,omitempty"`
+	VendorID string `json:"vendorId,omitempty"`
+	DeviceID string `json:"deviceId,omitempty"`
+	Driver   string `json:"driver,omitempty"`
+	// NumaNode is -1 if the platform has no NUMA node
+	NumaNode int32 `json:"numaNode"`
+}
+
+// Store persists the PCI devices of each host.
+type Store interface {
+	// Devices returns the devices of the host, sorted by slot.
+	Devices(host util.TenantIDResourceIDTuple) ([]Device, error)
+	// Put creates or replaces the device with the same slot.
+	Put(host util.TenantIDResourceIDTuple, device Device) error
+	// Delete removes the device in the given slot, deleting a missing device is not an error.
+	Delete(host util.TenantIDResourceIDTuple, slot string) error
+	// DeleteHost removes every device of the host.
+	DeleteHost(host util.TenantIDResourceIDTuple) error
+	// Hosts returns the hosts having at least one device.
+	Hosts() ([]util.TenantIDResourceIDTuple, error)
+}
+
+// NormalizeID returns the lowercase hexadecimal form of a vendor or device ID, without any 0x prefix.
+func NormalizeID(id string) string {
+	id = strings.ToLower(strings.TrimSpace(id))
+	return strings.TrimPrefix(id, "0x")
+}
+
+// Find returns the devices of the hosts of the tenant matching the given filter, by host.
+// Hosts with no matching device are omitted.
+func Find(store Store, tenantID string, match func(Device) bool) (map[util.TenantIDResourceIDTuple][]Device, error) {
+	hosts, err := store.Hosts()
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[util.TenantIDResourceIDTuple][]Device)
+	for _, host := range hosts {
+		if host.TenantID != tenantID {
+			continue
+		}
+		devices, devicesErr := store.Devices(host)
+		if devicesErr != nil {
+			return nil, devicesErr
+		}
+		for _, device := range devices {
+			if match(device) {
+				found[host] = append(found[host], device)
+			}
+		}
+	}
+	return found, nil
+}
+
+// ByID matches the devices with the given vendor and device IDs. An empty device ID matches any device of the vendor.
+func ByID(vendorID, deviceID string) func(Device) bool {
+	vendorID, deviceID = NormalizeID(vendorID), NormalizeID(deviceID)
+	return func(device Device) bool {
+		return device.VendorID == vendorID && (deviceID == "" || device.DeviceID == deviceID)
+	}
+}
+
+// Open returns the store persisting the PCI devices in dir, or an in-memory store if dir is empty.
+func Open(dir string) (Store, error) {
+	if dir == "" {
+		return NewMemoryStore(), nil
+	}
+	fileStore, err := NewFileStore(dir)
+	if err != nil {
+		return nil, err
+	}
+	return fileStore, nil
+}
+
+// Default returns the store of the Host Manager, an in-memory store until it is configured with SetDefault.
+func Default() Store {
+	defaultStoreMu.RLock()
+	defer defaultStoreMu.RUnlock()
+	return defaultStore
+}
+
+// SetDefault replaces the store of the Host Manager.
+func SetDefault(store Store) {
+	defaultStoreMu.Lock()
+	defer defaultStoreMu.Unlock()
+	defaultStore = store
+}
+
+// FromSystemPCI returns the device reported by a host.
+func FromSystemPCI(pci *pb.SystemPCI) Device {
+	return Device{
+		Slot:     strings.ToLower(strings.TrimSpace(pci.GetSlot())),
+		Class:    pci.GetDevClass(),
+		VendorID: NormalizeID(pci.GetVendorId()),
+		DeviceID: NormalizeID(pci.GetDeviceId()),
+		Driver:   pci.GetDriver(),
+		NumaNode: pci.GetNumaNode(),
+	}
+}
+
+// ChangedFields returns the names of the fields of the device that differ in updated.
+func ChangedFields(current, updated Device) []string {
+	var fields []string
+	for _, field := range []struct {
+		name             string
+		current, updated any
+	}{
+		{"class", current.Class, updated.Class},
+		{"vendor_id", current.VendorID, updated.VendorID},
+		{"device_id", current.DeviceID, updated.DeviceID},
+		{"driver", current.Driver, updated.Driver},
+		{"numa_node", current.NumaNode, updated.NumaNode},
+	} {
+		if field.current != field.updated {
+			fields = append(fields, field.name)
+		}
+	}
+	return fields
+}
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pcidevice_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	"github.com/open-edge-platform/infra-managers/host/pkg/pcidevice"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+var (
+	host1 = util.TenantIDResourceIDTuple{
+		TenantID:   "11111111-1111-1111-1111-111111111111",
+		ResourceID: "host-12345678",
+	}
+	host2 = util.TenantIDResourceIDTuple{
+		TenantID:   "11111111-1111-1111-1111-111111111111",
+		ResourceID: "host-87654321",
+	}
+	host3 = util.TenantIDResourceIDTuple{
+		TenantID:   "22222222-2222-2222-2222-222222222222",
+		ResourceID: "host-12345678",
+	}
+	gpu  = pcidevice.Device{Slot: "0000:03:00.0", Class: "0300", VendorID: "8086", DeviceID: "56a0", Driver: "i915"}
+	nic  = pcidevice.Device{Slot: "0000:00:1f.6", Class: "0200", VendorID: "8086", DeviceID: "15bc", Driver: "e1000e"}
+	fpga = pcidevice.Device{Slot: "0000:04:00.0", Class: "1200", VendorID: "1172", DeviceID: "5052", NumaNode: 1}
+)
+
+func TestStore(t *testing.T) {
+	fileStore, err := pcidevice.NewFileStore(filepath.Join(t.TempDir(), "pci"))
+	require.NoError(t, err)
+
+	stores := map[string]pcidevice.Store{
+		"Memory": pcidevice.NewMemoryStore(),
+		"File":   fileStore,
+	}
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			devices, err := store.Devices(host1)
+			require.NoError(t, err)
+			assert.Empty(t, devices)
+
+			require.NoError(t, store.Put(host1, gpu))
+			require.NoError(t, store.Put(host1, nic))
+			require.NoError(t, store.Put(host2, fpga))
+			devices, err = store.Devices(host1)
+			require.NoError(t, err)
+			// Devices are sorted by slot
+			assert.Equal(t, []pcidevice.Device{nic, gpu}, devices)
+
+			// A device is replaced by the one in the same slot
+			updated := gpu
+			updated.Driver = "xe"
+			require.NoError(t, store.Put(host1, updated))
+			devices, err = store.Devices(host1)
+			require.NoError(t, err)
+			assert.Equal(t, []pcidevice.Device{nic, updated}, devices)
+
+			hosts, err := store.Hosts()
+			require.NoError(t, err)
+			assert.ElementsMatch(t, []util.TenantIDResourceIDTuple{host1, host2}, hosts)
+
+			require.NoError(t, store.Delete(host2, fpga.Slot))
+			require.NoError(t, store.Delete(host2, fpga.Slot))
+			hosts, err = store.Hosts()
+			require.NoError(t, err)
+			assert.Equal(t, []util.TenantIDResourceIDTuple{host1}, hosts)
+
+			// Every device of a deleted host is removed
+			require.NoError(t, store.DeleteHost(host1))
+			require.NoError(t, store.DeleteHost(host1))
+			devices, err = store.Devices(host1)
+			require.NoError(t, err)
+			assert.Empty(t, devices)
+			hosts, err = store.Hosts()
+			require.NoError(t, err)
+			assert.Empty(t, hosts)
+		})
+	}
+}
+
+func TestOpen(t *testing.T) {
+	// Without directory, the devices are kept in memory
+	store, err := pcidevice.Open("")
+	require.NoError(t, err)
+	assert.IsType(t, &pcidevice.MemoryStore{}, store)
+
+	dir := filepath.Join(t.TempDir(), "pci")
+	store, err = pcidevice.Open(dir)
+	require.NoError(t, err)
+	require.NoError(t, store.Put(host1, gpu))
+	_, err = os.Stat(dir)
+	require.NoError(t, err)
+
+	// A directory that cannot be created is rejected
+	file := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(file, nil, 0o600))
+	_, err = pcidevice.Open(filepath.Join(file, "pci"))
+	assert.Error(t, err)
+}
+
+func TestFind(t *testing.T) {
+	store := pcidevice.NewMemoryStore()
+	require.NoError(t, store.Put(host1, gpu))
+	require.NoError(t, store.Put(host1, nic))
+	require.NoError(t, store.Put(host2, fpga))
+	require.NoError(t, store.Put(host3, fpga))
+
+	found, err := pcidevice.Find(store, host1.TenantID, pcidevice.ByID("0x8086", "56A0"))
+	require.NoError(t, err)
+	assert.Equal(t, map[util.TenantIDResourceIDTuple][]pcidevice.Device{host1: {gpu}}, found)
+
+	found, err = pcidevice.Find(store, host1.TenantID, pcidevice.ByID("8086", ""))
+	require.NoError(t, err)
+	assert.Equal(t, map[util.TenantIDResourceIDTuple][]pcidevice.Device{host1: {nic, gpu}}, found)
+
+	// The hosts of other tenants are not found
+	found, err = pcidevice.Find(store, host1.TenantID, func(d pcidevice.Device) bool { return d.Class == "1200" })
+	require.NoError(t, err)
+	assert.Equal(t, map[util.TenantIDResourceIDTuple][]pcidevice.Device{host2: {fpga}}, found)
+}
+
+func TestFromSystemPCI(t *testing.T) {
+	device := pcidevice.FromSystemPCI(&pb.SystemPCI{
+		DevClass: "0300",
+		VendorId: "0x8086",
+		DeviceId: "56A0",
+		Slot:     " 0000:03:00.0",
+		Driver:   "i915",
+		NumaNode: -1,
+	})
+	expected := gpu
+	expected.NumaNode = -1
+	assert.Equal(t, expected, device)
+	assert.Equal(t, []string{"driver", "numa_node"}, pcidevice.ChangedFields(gpu, pcidevice.Device{
+		Slot: gpu.Slot, Class: gpu.Class, VendorID: gpu.VendorID, DeviceID: gpu.DeviceID, Driver: "xe", NumaNode: 1,
+	}))
+	assert.Empty(t, pcidevice.ChangedFields(gpu, gpu))
+}
@@ -0,0 +1,224 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pcidevice
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+const (
+	pciDevicesDirPerm  = 0o750
+	pciDevicesFilePerm = 0o600
+)
+
+func putDevice(devices []Device, device Device) []Device {
+	devices = slices.DeleteFunc(devices, func(d Device) bool { return d.Slot == device.Slot })
+	devices = append(devices, device)
+	slices.SortFunc(devices, func(a, b Device) int { return strings.Compare(a.Slot, b.Slot) })
+	return devices
+}
+
+// MemoryStore keeps the PCI devices in memory, they are lost on restart.
+type MemoryStore struct {
+	mu      sync.Mutex
+	devices map[util.TenantIDResourceIDTuple][]Device
+}
+
+// NewMemoryStore returns an empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{devices: make(map[util.TenantIDResourceIDTuple][]Device)}
+}
+
+// Devices implements Store.
+func (s *MemoryStore) Devices(host util.TenantIDResourceIDTuple) ([]Device, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.devices[host]), nil
+}
+
+// Put implements Store.
+func (s *MemoryStore) Put(host util.TenantIDResourceIDTuple, device Device) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.devices[host] = putDevice(slices.Clone(s.devices[host]), device)
+	return nil
+}
+
+// Delete implements Store.
+func (s *MemoryStore) Delete(host util.TenantIDResourceIDTuple, slot string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	devices := slices.DeleteFunc(slices.Clone(s.devices[host]), func(d Device) bool { return d.Slot == slot })
+	if len(devices) == 0 {
+		delete(s.devices, host)
+	} else {
+		s.devices[host] = devices
+	}
+	return nil
+}
+
+// DeleteHost implements Store.
+func (s *MemoryStore) DeleteHost(host util.TenantIDResourceIDTuple) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.devices, host)
+	return nil
+}
+
+// Hosts implements Store.
+func (s *MemoryStore) Hosts() ([]util.TenantIDResourceIDTuple, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	hosts := make([]util.TenantIDResourceIDTuple, 0, len(s.devices))
+	for host := range s.devices {
+		hosts = append(hosts, host)
+	}
+	return hosts, nil
+}
+
+// hostDevices is the content of the file of a host.
+type hostDevices struct {
+	Host    util.TenantIDResourceIDTuple `json:"host"`
+	Devices []Device                     `json:"devices"`
+}
+
+// FileStore persists the PCI devices of each host in a JSON file of the given directory.
+type FileStore struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// NewFileStore returns a store persisting the PCI devices in dir, created if missing.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, pciDevicesDirPerm); err != nil {
+		return nil, errors.Errorfc(codes.Internal, "cannot create PCI device directory %s: %v", dir, err)
+	}
+	probe, err := os.CreateTemp(dir, ".probe-*")
+	if err != nil {
+		return nil, errors.Errorfc(codes.Internal, "cannot write in PCI device directory %s: %v", dir, err)
+	}
+	if err = probe.Close(); err == nil {
+		err = os.Remove(probe.Name())
+	}
+	if err != nil {
+		return nil, errors.Errorfc(codes.Internal, "cannot write in PCI device directory %s: %v", dir, err)
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+func (s *FileStore) path(host util.TenantIDResourceIDTuple) string {
+	// Tenant IDs are UUIDs and resource IDs are alphanumeric, both are safe file names
+	return filepath.Join(s.dir, filepath.Base(host.TenantID+"_"+host.ResourceID)+".json")
+}
+
+func (s *FileStore) read(path string) (hostDevices, error) {
+	var content hostDevices
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return content, nil
+	}
+	if err != nil {
+		return content, errors.Errorfc(codes.Internal, "cannot read PCI devices %s: %v", path, err)
+	}
+	if err = json.Unmarshal(data, &content); err != nil {
+		return content, errors.Errorfc(codes.Internal, "cannot decode PCI devices %s: %v", path, err)
+	}
+	return content, nil
+}
+
+func (s *FileStore) write(host util.TenantIDResourceIDTuple, devices []Device) error {
+	path := s.path(host)
+	if len(devices) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return errors.Errorfc(codes.Internal, "cannot delete PCI devices of %s: %v", host, err)
+		}
+		return nil
+	}
+	data, err := json.Marshal(hostDevices{Host: host, Devices: devices})
+	if err != nil {
+		return errors.Errorfc(codes.Internal, "cannot encode PCI devices of %s: %v", host, err)
+	}
+
+	// Write then rename, the devices are never left half written
+	tmpPath := path + ".tmp"
+	if err = os.WriteFile(tmpPath, data, pciDevicesFilePerm); err != nil {
+		return errors.Errorfc(codes.Internal, "cannot write PCI devices of %s: %v", host, err)
+	}
+	if err = os.Rename(tmpPath, path); err != nil {
+		if rmErr := os.Remove(tmpPath); rmErr != nil {
+			zlog.Warn().Err(rmErr).Msgf("cannot remove %s", tmpPath)
+		}
+		return errors.Errorfc(codes.Internal, "cannot write PCI devices of %s: %v", host, err)
+	}
+	return nil
+}
+
+// Devices implements Store.
+func (s *FileStore) Devices(host util.TenantIDResourceIDTuple) ([]Device, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	content, err := s.read(s.path(host))
+	return content.Devices, err
+}
+
+// Put implements Store.
+func (s *FileStore) Put(host util.TenantIDResourceIDTuple, device Device) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	content, err := s.read(s.path(host))
+	if err != nil {
+		// The device list is rebuilt by the next reports of the host
+		zlog.InfraSec().InfraErr(err).Msgf("resetting PCI devices of %s", host)
+	}
+	return s.write(host, putDevice(content.Devices, device))
+}
+
+// Delete implements Store.
+func (s *FileStore) Delete(host util.TenantIDResourceIDTuple, slot string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	content, err := s.read(s.path(host))
+	if err != nil {
+		return err
+	}
+	return s.write(host, slices.DeleteFunc(content.Devices, func(d Device) bool { return d.Slot == slot }))
+}
+
+// DeleteHost implements Store.
+func (s *FileStore) DeleteHost(host util.TenantIDResourceIDTuple) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.write(host, nil)
+}
+
+// Hosts implements Store.
+func (s *FileStore) Hosts() ([]util.TenantIDResourceIDTuple, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	paths, err := filepath.Glob(filepath.Join(s.dir, "*.json"))
+	if err != nil {
+		return nil, errors.Errorfc(codes.Internal, "cannot list PCI devices: %v", err)
+	}
+	hosts := make([]util.TenantIDResourceIDTuple, 0, len(paths))
+	for _, path := range paths {
+		content, readErr := s.read(path)
+		if readErr != nil {
+			zlog.InfraSec().InfraErr(readErr).Msgf("skipping PCI devices %s", path)
+			continue
+		}
+		hosts = append(hosts, content.Host)
+	}
+	return hosts, nil
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ratelimit provides a per-tenant token bucket rate limiter.
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Option configures a TenantLimiter.
+type Option func(*TenantLimiter)
+
+// WithClock sets the function used to read the current time, mostly used in tests.
+func WithClock(now func() time.Time) Option {
+	return func(l *TenantLimiter) {
+		l.now = now
+	}
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// TenantLimiter limits the rate of events of each tenant independently. Every tenant owns
+// a token bucket of the given burst size, refilled at the given rate.
+type TenantLimiter struct {
+	mu      sync.Mutex
+	rate    float64 // tokens per second
+	burst   float64
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+// NewTenantLimiter returns a limiter allowing up to burst events at once and ratePerMinute
+// events per minute in the long run, per tenant. A zero rate allows only the initial burst.
+func NewTenantLimiter(ratePerMinute float64, burst int, opts ...Option) *TenantLimiter {
+	l := &TenantLimiter{
+		rate:    ratePerMinute / time.Minute.Seconds(),
+		burst:   float64(burst),
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// Allow reports whether an event of the tenant may happen now, consuming a token if so.
+func (l *TenantLimiter) Allow(tenantID string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	b, ok := l.buckets[tenantID]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[tenantID] = b
+	}
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = min(l.burst, b.tokens+elapsed.Seconds()*l.rate)
+		b.last = now
+	}
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-edge-platform/infra-managers/host/pkg/ratelimit"
+)
+
+const (
+	tenant1 = "11111111-1111-1111-1111-111111111111"
+	tenant2 = "22222222-2222-2222-2222-222222222222"
+)
+
+func TestTenantLimiter(t *testing.T) {
+	now := time.Unix(0, 0)
+	limiter := ratelimit.NewTenantLimiter(60, 2, ratelimit.WithClock(func() time.Time { return now }))
+
+	// Burst is consumed, then the tenant is limited
+	assert.True(t, limiter.Allow(tenant1))
+	assert.True(t, limiter.Allow(tenant1))
+	assert.False(t, limiter.Allow(tenant1))
+
+	// Other tenants are not affected
+	assert.True(t, limiter.Allow(tenant2))
+
+	// One token per second is refilled
+	now = now.Add(500 * time.Millisecond)
+	assert.False(t, limiter.Allow(tenant1))
+	now = now.Add(500 * time.Millisecond)
+	assert.True(t, limiter.Allow(tenant1))
+	assert.False(t, limiter.Allow(tenant1))
+
+	// Refill never exceeds the burst
+	now = now.Add(time.Hour)
+	assert.True(t, limiter.Allow(tenant1))
+	assert.True(t, limiter.Allow(tenant1))
+	assert.False(t, limiter.Allow(tenant1))
+}
+
+func TestTenantLimiter_ZeroRate(t *testing.T) {
+	now := time.Unix(0, 0)
+	limiter := ratelimit.NewTenantLimiter(0, 1, ratelimit.WithClock(func() time.Time { return now }))
+
+	assert.True(t, limiter.Allow(tenant1))
+	now = now.Add(time.Hour)
+	assert.False(t, limiter.Allow(tenant1))
+
+	limiter = ratelimit.NewTenantLimiter(10, 0)
+	assert.False(t, limiter.Allow(tenant1))
+}
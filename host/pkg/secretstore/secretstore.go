@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package secretstore keeps the secrets reported by hosts, e.g. their kubeconfig, in Vault rather than in Inventory.
+// Only a reference to a secret is stored on the Host resource.
+package secretstore
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+var zlog = logging.GetLogger("HostManagerSecretStore")
+
+// RefPrefix prefixes the references to the secrets of the store.
+const RefPrefix = "secretstore:"
+
+var (
+	defaultStoreMu sync.RWMutex
+	defaultStore   Store = NewVaultStore()
+
+	// Keys are slash-separated paths of tenant IDs, resource IDs and secret names
+	keyRegexp = regexp.MustCompile(`^[a-zA-Z0-9.-]+(/[a-zA-Z0-9.-]+)*$`)
+)
+
+// Store keeps secrets by key.
+type Store interface {
+	// Put stores the secret, replacing any previous value.
+	Put(key string, secret []byte) error
+	// Get returns the secret, or a NotFound error if there is none.
+	Get(key string) ([]byte, error)
+	// Delete removes the secret, deleting a missing secret is not an error.
+	Delete(key string) error
+}
+
+func validateKey(key string) error {
+	if !keyRegexp.MatchString(key) || strings.Contains(key, "..") {
+		return errors.Errorfc(codes.InvalidArgument, "invalid secret key: %q", key)
+	}
+	return nil
+}
+
+// KubeconfigKey returns the key of the kubeconfig of the given host.
+func KubeconfigKey(host util.TenantIDResourceIDTuple) string {
+	return host.TenantID + "/" + host.ResourceID + "/kubeconfig"
+}
+
+// BmcCredentialsKey returns the key of the BMC credentials of the given host.
+func BmcCredentialsKey(host util.TenantIDResourceIDTuple) string {
+	return host.TenantID + "/" + host.ResourceID + "/bmc-credentials"
+}
+
+// Ref returns the reference to the secret stored under key.
+func Ref(key string) string {
+	return RefPrefix + key
+}
+
+// KeyFromRef returns the key of a secret from its reference.
+func KeyFromRef(ref string) (string, error) {
+	key, ok := strings.CutPrefix(ref, RefPrefix)
+	if !ok {
+		return "", errors.Errorfc(codes.InvalidArgument, "invalid secret reference: %q", ref)
+	}
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+// Open returns the store of the secrets, kept in Vault unless the credentials management is disabled,
+// for testing only, in which case they are kept in memory.
+func Open(disableCredentialsManagement bool) Store {
+	if disableCredentialsManagement {
+		zlog.InfraSec().Warn().Msg("Credentials management is disabled, the secrets are kept in memory")
+		return NewMemoryStore()
+	}
+	return NewVaultStore()
+}
+
+// Default returns the store of the Host Manager, kept in Vault unless it is replaced with SetDefault.
+func Default() Store {
+	defaultStoreMu.RLock()
+	defer defaultStoreMu.RUnlock()
+	return defaultStore
+}
+
+// SetDefault replaces the store of the Host Manager.
+func SetDefault(store Store) {
+	defaultStoreMu.Lock()
+	defer defaultStoreMu.Unlock()
+	defaultStore = store
+}
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secretstore_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	grpc_status "google.golang.org/grpc/status"
+
+	inv_errors "github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/secrets"
+	"github.com/open-edge-platform/infra-managers/host/pkg/secretstore"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+var testKey = secretstore.KubeconfigKey(util.TenantIDResourceIDTuple{
+	TenantID:   "11111111-1111-1111-1111-111111111111",
+	ResourceID: "host-12345678",
+})
+
+// fakeVault is a KV v2 secrets engine, it keeps the data of the latest version of each path.
+type fakeVault struct {
+	mu       sync.Mutex
+	paths    map[string]map[string]any
+	sessions int
+}
+
+// newFakeVault replaces the secrets service for the duration of the test.
+func newFakeVault(t *testing.T) *fakeVault {
+	t.Helper()
+	vault := &fakeVault{paths: make(map[string]map[string]any)}
+	previous := secrets.SecretServiceFactory
+	secrets.SecretServiceFactory = func(context.Context) (secrets.SecretsService, error) {
+		vault.mu.Lock()
+		defer vault.mu.Unlock()
+		vault.sessions++
+		return vault, nil
+	}
+	t.Cleanup(func() { secrets.SecretServiceFactory = previous })
+	return vault
+}
+
+func (v *fakeVault) ReadSecret(_ context.Context, path string) (map[string]any, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	data, ok := v.paths[path]
+	if !ok {
+		return nil, inv_errors.Errorfc(codes.NotFound, "Secret %s not found", path)
+	}
+	return map[string]any{"data": data, "metadata": map[string]any{}}, nil
+}
+
+func (v *fakeVault) WriteSecret(_ context.Context, path string, secret map[string]any) (map[string]any, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	data, ok := secret["data"].(map[string]any)
+	if !ok {
+		return nil, inv_errors.Errorfc(codes.InvalidArgument, "no data")
+	}
+	v.paths[path] = data
+	return map[string]any{}, nil
+}
+
+func (v *fakeVault) Logout(context.Context) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.sessions--
+}
+
+func TestStore(t *testing.T) {
+	vault := newFakeVault(t)
+	stores := map[string]secretstore.Store{
+		"Memory": secretstore.NewMemoryStore(),
+		"Vault":  secretstore.NewVaultStore(),
+	}
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			_, err := store.Get(testKey)
+			assert.True(t, inv_errors.IsNotFound(err))
+
+			require.NoError(t, store.Put(testKey, []byte("kubeconfig")))
+			secret, err := store.Get(testKey)
+			require.NoError(t, err)
+			assert.Equal(t, []byte("kubeconfig"), secret)
+
+			require.NoError(t, store.Put(testKey, []byte("new-kubeconfig")))
+			secret, err = store.Get(testKey)
+			require.NoError(t, err)
+			assert.Equal(t, []byte("new-kubeconfig"), secret)
+
+			require.NoError(t, store.Delete(testKey))
+			_, err = store.Get(testKey)
+			assert.True(t, inv_errors.IsNotFound(err))
+			// Deleting a missing secret is not an error
+			require.NoError(t, store.Delete(testKey))
+
+			// Keys cannot escape the store
+			for _, key := range []string{"", "../secret", "tenant/../../secret", "/secret", "tenant_host"} {
+				assert.Error(t, store.Put(key, []byte("secret")), key)
+			}
+		})
+	}
+	// Each session is closed
+	assert.Zero(t, vault.sessions)
+}
+
+func TestVaultStore_Path(t *testing.T) {
+	vault := newFakeVault(t)
+	store := secretstore.NewVaultStore()
+	require.NoError(t, store.Put(testKey, []byte("kubeconfig")))
+	// The secrets of the Host Manager are kept apart from the other secrets of Vault
+	assert.Equal(t, map[string]any{"value": "kubeconfig"}, vault.paths["hostmgr/"+testKey])
+}
+
+func TestVaultStore_Unavailable(t *testing.T) {
+	previous := secrets.SecretServiceFactory
+	secrets.SecretServiceFactory = func(context.Context) (secrets.SecretsService, error) {
+		return nil, inv_errors.Errorf("Failed to login to Vault")
+	}
+	t.Cleanup(func() { secrets.SecretServiceFactory = previous })
+
+	store := secretstore.NewVaultStore()
+	err := store.Put(testKey, []byte("kubeconfig"))
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, grpc_status.Code(err))
+	_, err = store.Get(testKey)
+	require.Error(t, err)
+	assert.False(t, inv_errors.IsNotFound(err))
+}
+
+func TestOpen(t *testing.T) {
+	assert.IsType(t, &secretstore.VaultStore{}, secretstore.Open(false))
+	// For testing only, the secrets are kept in memory
+	assert.IsType(t, &secretstore.MemoryStore{}, secretstore.Open(true))
+}
+
+func TestKeyFromRef(t *testing.T) {
+	key, err := secretstore.KeyFromRef(secretstore.Ref(testKey))
+	require.NoError(t, err)
+	assert.Equal(t, testKey, key)
+
+	_, err = secretstore.KeyFromRef(testKey)
+	assert.Error(t, err)
+	_, err = secretstore.KeyFromRef(secretstore.Ref("../secret"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secretstore
+
+import (
+	"sync"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+)
+
+// MemoryStore keeps the secrets in memory, they are lost on restart. It is only meant for testing, when the
+// credentials management is disabled.
+type MemoryStore struct {
+	mu      sync.Mutex
+	secrets map[string][]byte
+}
+
+// NewMemoryStore returns an empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{secrets: make(map[string][]byte)}
+}
+
+// Put implements Store.
+func (s *MemoryStore) Put(key string, secret []byte) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.secrets[key] = append([]byte(nil), secret...)
+	return nil
+}
+
+// Get implements Store.
+func (s *MemoryStore) Get(key string) ([]byte, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	secret, ok := s.secrets[key]
+	if !ok {
+		return nil, errors.Errorfc(codes.NotFound, "secret %s not found", key)
+	}
+	return append([]byte(nil), secret...), nil
+}
+
+// Delete implements Store.
+func (s *MemoryStore) Delete(key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.secrets, key)
+	return nil
+}
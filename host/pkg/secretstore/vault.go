@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secretstore
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/secrets"
+)
+
+const (
+	// vaultPathPrefix prefixes the Vault paths of the secrets of the Host Manager
+	vaultPathPrefix = "hostmgr/"
+	// vaultValueKey is the key of the secret in the data of its Vault path
+	vaultValueKey = "value"
+)
+
+// VaultStore keeps the secrets in Vault, through the secrets service of Inventory. The Vault address and role
+// are read from the environment, see secrets.EnvNameVaultURL and secrets.EnvNameVaultPKIRole.
+// As for the other users of the service, a session is opened for each operation.
+type VaultStore struct{}
+
+// NewVaultStore returns a store keeping the secrets in Vault.
+func NewVaultStore() *VaultStore {
+	return &VaultStore{}
+}
+
+func vaultPath(key string) string {
+	return vaultPathPrefix + key
+}
+
+// withVault runs the operation in a new Vault session.
+func withVault(operation func(ctx context.Context, vault secrets.SecretsService) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), secrets.DefaultTimeout)
+	defer cancel()
+	vault, err := secrets.SecretServiceFactory(ctx)
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Msg("Cannot log in to Vault")
+		return errors.Errorfc(codes.Unavailable, "secret store is not available")
+	}
+	defer vault.Logout(ctx)
+	return operation(ctx, vault)
+}
+
+// Put implements Store.
+func (s *VaultStore) Put(key string, secret []byte) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	return withVault(func(ctx context.Context, vault secrets.SecretsService) error {
+		_, err := vault.WriteSecret(ctx, vaultPath(key), map[string]any{
+			"data": map[string]any{vaultValueKey: string(secret)},
+		})
+		return err
+	})
+}
+
+// Get implements Store.
+func (s *VaultStore) Get(key string) ([]byte, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+	var secret []byte
+	err := withVault(func(ctx context.Context, vault secrets.SecretsService) error {
+		var err error
+		secret, err = readVaultSecret(ctx, vault, key)
+		return err
+	})
+	return secret, err
+}
+
+// Delete implements Store. The secrets service cannot delete a path, the secret is replaced by an empty version.
+func (s *VaultStore) Delete(key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	return withVault(func(ctx context.Context, vault secrets.SecretsService) error {
+		if _, err := readVaultSecret(ctx, vault, key); err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		_, err := vault.WriteSecret(ctx, vaultPath(key), map[string]any{"data": map[string]any{}})
+		return err
+	})
+}
+
+// readVaultSecret reads the latest version of the secret, a deleted secret is not found.
+func readVaultSecret(ctx context.Context, vault secrets.SecretsService, key string) ([]byte, error) {
+	data, err := vault.ReadSecret(ctx, vaultPath(key))
+	if err != nil {
+		return nil, err
+	}
+	values, ok := data["data"].(map[string]any)
+	if !ok {
+		return nil, errors.Errorfc(codes.NotFound, "secret %s not found", key)
+	}
+	value, ok := values[vaultValueKey].(string)
+	if !ok {
+		return nil, errors.Errorfc(codes.NotFound, "secret %s not found", key)
+	}
+	return []byte(value), nil
+}
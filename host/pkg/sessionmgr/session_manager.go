@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sessionmgr keeps track of the HostSession streams opened by the agents.
+package sessionmgr
+
+import (
+	"sync"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+var zlog = logging.GetLogger("HostManagerSessionMgr")
+
+var sessMgr = sessionMgr{
+	sessions: make(map[util.TenantIDResourceIDTuple]*Session),
+}
+
+type sessionMgr struct {
+	lock     sync.Mutex
+	sessions map[util.TenantIDResourceIDTuple]*Session
+}
+
+// Session represents a single HostSession stream opened by an agent.
+// At most one Session per host is active, a newer one supersedes the older.
+type Session struct {
+	key util.TenantIDResourceIDTuple
+	// changed is signaled (without blocking) when the Host has changed in Inventory,
+	// a buffer of one is enough as the session always reloads the latest Host.
+	changed   chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// Changed returns a channel that is signaled when the Host has been changed in Inventory.
+func (s *Session) Changed() <-chan struct{} {
+	return s.changed
+}
+
+// Done returns a channel that is closed when the Session has been superseded or closed.
+func (s *Session) Done() <-chan struct{} {
+	return s.done
+}
+
+func (s *Session) close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+	})
+}
+
+// OpenSession registers a new Session for the given host. Any Session already open
+// for the same host is closed.
+func OpenSession(host *computev1.HostResource) *Session {
+	key := util.NewTenantIDResourceIDTupleFromHost(host)
+	sess := &Session{
+		key:     key,
+		changed: make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+
+	sessMgr.lock.Lock()
+	defer sessMgr.lock.Unlock()
+	if old, ok := sessMgr.sessions[key]; ok {
+		zlog.Debug().Msgf("Session of %s has been superseded by a new one", key)
+		old.close()
+	}
+	sessMgr.sessions[key] = sess
+	zlog.Debug().Msgf("Session of %s has been opened", key)
+	return sess
+}
+
+// CloseSession closes the Session and unregisters it, if it is still the active one for its host.
+func CloseSession(sess *Session) {
+	if sess == nil {
+		return
+	}
+	sessMgr.lock.Lock()
+	defer sessMgr.lock.Unlock()
+	if cur, ok := sessMgr.sessions[sess.key]; ok && cur == sess {
+		delete(sessMgr.sessions, sess.key)
+	}
+	sess.close()
+	zlog.Debug().Msgf("Session of %s has been closed", sess.key)
+}
+
+// NotifyHostChanged signals the Session of the given host, if any, that the Host has changed.
+// Returns true if a Session is open for the host.
+func NotifyHostChanged(host *computev1.HostResource) bool {
+	key := util.NewTenantIDResourceIDTupleFromHost(host)
+	sessMgr.lock.Lock()
+	sess, ok := sessMgr.sessions[key]
+	sessMgr.lock.Unlock()
+	if !ok {
+		return false
+	}
+	select {
+	case sess.changed <- struct{}{}:
+	default:
+		// a notification is already pending
+	}
+	return true
+}
+
+// IsSessionOpen checks if a Session is open for the given host.
+func IsSessionOpen(host *computev1.HostResource) bool {
+	sessMgr.lock.Lock()
+	defer sessMgr.lock.Unlock()
+	_, ok := sessMgr.sessions[util.NewTenantIDResourceIDTupleFromHost(host)]
+	return ok
+}
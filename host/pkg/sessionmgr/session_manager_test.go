@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sessionmgr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	"github.com/open-edge-platform/infra-managers/host/pkg/sessionmgr"
+)
+
+func TestOpenCloseSession(t *testing.T) {
+	host := &computev1.HostResource{ResourceId: "host-12345678", TenantId: "11111111-1111-1111-1111-111111111111"}
+
+	assert.False(t, sessionmgr.IsSessionOpen(host))
+	assert.False(t, sessionmgr.NotifyHostChanged(host))
+
+	sess := sessionmgr.OpenSession(host)
+	require.NotNil(t, sess)
+	assert.True(t, sessionmgr.IsSessionOpen(host))
+
+	sessionmgr.CloseSession(sess)
+	assert.False(t, sessionmgr.IsSessionOpen(host))
+	select {
+	case <-sess.Done():
+	default:
+		t.Errorf("Session should be done after close")
+	}
+
+	// closing twice must not panic
+	sessionmgr.CloseSession(sess)
+	sessionmgr.CloseSession(nil)
+}
+
+func TestSessionSuperseded(t *testing.T) {
+	host := &computev1.HostResource{ResourceId: "host-87654321", TenantId: "11111111-1111-1111-1111-111111111111"}
+
+	oldSess := sessionmgr.OpenSession(host)
+	newSess := sessionmgr.OpenSession(host)
+	defer sessionmgr.CloseSession(newSess)
+
+	select {
+	case <-oldSess.Done():
+	default:
+		t.Errorf("Old session should be done once superseded")
+	}
+
+	// closing the superseded session must not unregister the active one
+	sessionmgr.CloseSession(oldSess)
+	assert.True(t, sessionmgr.IsSessionOpen(host))
+}
+
+func TestNotifyHostChanged(t *testing.T) {
+	host := &computev1.HostResource{ResourceId: "host-abcdef12", TenantId: "11111111-1111-1111-1111-111111111111"}
+	otherTenantHost := &computev1.HostResource{ResourceId: "host-abcdef12", TenantId: "22222222-2222-2222-2222-222222222222"}
+
+	sess := sessionmgr.OpenSession(host)
+	defer sessionmgr.CloseSession(sess)
+
+	assert.False(t, sessionmgr.NotifyHostChanged(otherTenantHost))
+	// notifications are coalesced, notifying twice must not block
+	assert.True(t, sessionmgr.NotifyHostChanged(host))
+	assert.True(t, sessionmgr.NotifyHostChanged(host))
+
+	select {
+	case <-sess.Changed():
+	default:
+		t.Errorf("Session should have been notified")
+	}
+	select {
+	case <-sess.Changed():
+		t.Errorf("Notifications should have been coalesced")
+	default:
+	}
+}
@@ -29,6 +29,13 @@ var (
 	HostStatusInvalidated = inv_status.New("Invalidated", statusv1.StatusIndication_STATUS_INDICATION_IDLE)
 	// HostStatusDeleting represents a host being deleted.
 	HostStatusDeleting = inv_status.New("Deleting", statusv1.StatusIndication_STATUS_INDICATION_IN_PROGRESS)
+	// HostStatusDiscovered represents a host automatically registered by the host manager, waiting to be onboarded.
+	HostStatusDiscovered = inv_status.New("Discovered", statusv1.StatusIndication_STATUS_INDICATION_IN_PROGRESS)
+	// HostStatusQuarantined represents a host quarantined after reporting another identity than its pinned one.
+	HostStatusQuarantined = inv_status.New("Quarantined", statusv1.StatusIndication_STATUS_INDICATION_ERROR)
+	// HostStatusClockSkewed represents a running host whose clock is skewed beyond the threshold. Its status is
+	// the one of a running host, only its indication is degraded.
+	HostStatusClockSkewed = inv_status.New("Running", statusv1.StatusIndication_STATUS_INDICATION_ERROR)
 
 	// InstanceStatusEmpty represents an empty instance status (for testing).
 	InstanceStatusEmpty = inv_status.New("", statusv1.StatusIndication_STATUS_INDICATION_UNSPECIFIED)
@@ -0,0 +1,241 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+)
+
+// Keys of the Host metadata describing the agents running on the node. Each agent reported in the AgentInfo
+// has a version key and a features key, e.g. agent/node-agent/version and agent/node-agent/features.
+const (
+	// AgentMetadataKeyPrefix is the prefix of the keys of the agents reported in the AgentInfo.
+	AgentMetadataKeyPrefix = "agent/"
+	// AgentOutdatedMetadataKey is the key of the agents running below their minimum version, if any.
+	AgentOutdatedMetadataKey = "agent-outdated"
+
+	agentVersionKeySuffix  = "/version"
+	agentFeaturesKeySuffix = "/features"
+	agentFeaturesSeparator = ","
+	minAgentVersionsSep    = ","
+	minAgentVersionSplit   = "="
+)
+
+// AgentVersionMetadataKey returns the key of the version of the given agent.
+func AgentVersionMetadataKey(name string) string {
+	return AgentMetadataKeyPrefix + name + agentVersionKeySuffix
+}
+
+// AgentFeaturesMetadataKey returns the key of the comma separated feature flags of the given agent.
+func AgentFeaturesMetadataKey(name string) string {
+	return AgentMetadataKeyPrefix + name + agentFeaturesKeySuffix
+}
+
+// AgentInfoMetadata returns the Host metadata describing the reported agents. The feature flags are sorted,
+// so that the metadata does not change when an agent reports them in another order.
+func AgentInfoMetadata(agentInfo *pb.AgentInfo) map[string]string {
+	metadata := make(map[string]string, 2*len(agentInfo.GetAgents()))
+	for _, agent := range agentInfo.GetAgents() {
+		features := slices.Clone(agent.GetFeatures())
+		slices.Sort(features)
+		metadata[AgentVersionMetadataKey(agent.GetName())] = agent.GetVersion()
+		metadata[AgentFeaturesMetadataKey(agent.GetName())] = strings.Join(features, agentFeaturesSeparator)
+	}
+	return metadata
+}
+
+// AgentsFromMetadata returns the agents stored in the Host metadata, sorted by name. It lets the other
+// managers know which agents run on a node, to gate their behaviour on the capabilities of the agents.
+func AgentsFromMetadata(metadata string) ([]*pb.Agent, error) {
+	metaList, err := ParseMetadata(metadata)
+	if err != nil {
+		return nil, err
+	}
+	agents := make(map[string]*pb.Agent)
+	getAgent := func(name string) *pb.Agent {
+		if _, ok := agents[name]; !ok {
+			agents[name] = &pb.Agent{Name: name}
+		}
+		return agents[name]
+	}
+	for _, m := range metaList {
+		key, ok := strings.CutPrefix(m.Key, AgentMetadataKeyPrefix)
+		if !ok {
+			continue
+		}
+		if name, ok := strings.CutSuffix(key, agentVersionKeySuffix); ok {
+			getAgent(name).Version = m.Value
+		} else if name, ok := strings.CutSuffix(key, agentFeaturesKeySuffix); ok && m.Value != "" {
+			getAgent(name).Features = strings.Split(m.Value, agentFeaturesSeparator)
+		}
+	}
+	sorted := make([]*pb.Agent, 0, len(agents))
+	for _, agent := range agents {
+		sorted = append(sorted, agent)
+	}
+	slices.SortFunc(sorted, func(a, b *pb.Agent) int { return strings.Compare(a.GetName(), b.GetName()) })
+	return sorted, nil
+}
+
+// hostAgent returns the given agent of the Host, or nil if the agent has not been reported.
+func hostAgent(host *computev1.HostResource, name string) *pb.Agent {
+	agents, err := AgentsFromMetadata(host.GetMetadata())
+	if err != nil {
+		return nil
+	}
+	for _, agent := range agents {
+		if agent.GetName() == name {
+			return agent
+		}
+	}
+	return nil
+}
+
+// HasAgentFeature returns true if the given agent of the Host supports the feature flag.
+// It returns false for agents that do not report the AgentInfo.
+func HasAgentFeature(host *computev1.HostResource, agentName, feature string) bool {
+	return slices.Contains(hostAgent(host, agentName).GetFeatures(), feature)
+}
+
+// AgentVersionAtLeast returns true if the given agent of the Host runs at least the given version.
+// It returns false for agents that do not report the AgentInfo.
+func AgentVersionAtLeast(host *computev1.HostResource, agentName, minVersion string) bool {
+	agent := hostAgent(host, agentName)
+	if agent == nil {
+		return false
+	}
+	c, err := CompareAgentVersions(agent.GetVersion(), minVersion)
+	return err == nil && c >= 0
+}
+
+type agentVersion struct {
+	core       [3]uint64
+	prerelease []string
+}
+
+func parseAgentVersion(version string) (agentVersion, error) {
+	var parsed agentVersion
+	v := strings.TrimPrefix(version, "v")
+	// Build metadata is ignored by the precedence
+	v, _, _ = strings.Cut(v, "+")
+	v, prerelease, hasPrerelease := strings.Cut(v, "-")
+	if hasPrerelease {
+		if prerelease == "" {
+			return parsed, errors.Errorfc(codes.InvalidArgument, "invalid agent version: %s", version)
+		}
+		parsed.prerelease = strings.Split(prerelease, ".")
+	}
+	core := strings.Split(v, ".")
+	if len(core) > len(parsed.core) {
+		return parsed, errors.Errorfc(codes.InvalidArgument, "invalid agent version: %s", version)
+	}
+	for i, number := range core {
+		n, err := strconv.ParseUint(number, 10, 64)
+		if err != nil {
+			return parsed, errors.Errorfc(codes.InvalidArgument, "invalid agent version: %s", version)
+		}
+		parsed.core[i] = n
+	}
+	return parsed, nil
+}
+
+// comparePrerelease compares two pre-release versions with the semantic versioning precedence:
+// a version without pre-release is greater, numeric identifiers are lower than alphanumeric ones.
+func comparePrerelease(a, b []string) int {
+	switch {
+	case len(a) == 0 && len(b) == 0:
+		return 0
+	case len(a) == 0:
+		return 1
+	case len(b) == 0:
+		return -1
+	}
+	for i := range min(len(a), len(b)) {
+		na, errA := strconv.ParseUint(a[i], 10, 64)
+		nb, errB := strconv.ParseUint(b[i], 10, 64)
+		var c int
+		switch {
+		case errA == nil && errB == nil:
+			c = cmp.Compare(na, nb)
+		case errA == nil:
+			c = -1
+		case errB == nil:
+			c = 1
+		default:
+			c = strings.Compare(a[i], b[i])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(a), len(b))
+}
+
+// CompareAgentVersions compares two agent versions with the semantic versioning precedence, missing minor
+// and patch numbers are 0 and the leading "v" is optional. It returns -1, 0 or +1 as a is lower, equal
+// or greater than b.
+func CompareAgentVersions(a, b string) (int, error) {
+	va, err := parseAgentVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := parseAgentVersion(b)
+	if err != nil {
+		return 0, err
+	}
+	if c := slices.Compare(va.core[:], vb.core[:]); c != 0 {
+		return c, nil
+	}
+	return comparePrerelease(va.prerelease, vb.prerelease), nil
+}
+
+// ParseMinAgentVersions parses a comma separated list of agent=version pairs.
+func ParseMinAgentVersions(minVersions string) (map[string]string, error) {
+	parsed := make(map[string]string)
+	for _, pair := range strings.Split(minVersions, minAgentVersionsSep) {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		name, version, found := strings.Cut(pair, minAgentVersionSplit)
+		name, version = strings.TrimSpace(name), strings.TrimSpace(version)
+		if !found || name == "" {
+			return nil, errors.Errorfc(codes.InvalidArgument, "invalid minimum agent version: %s", pair)
+		}
+		if _, err := parseAgentVersion(version); err != nil {
+			return nil, err
+		}
+		parsed[name] = version
+	}
+	return parsed, nil
+}
+
+// OutdatedAgents returns the reported agents running below their minimum version, or an empty string
+// if there is none. Agents without a minimum version are never outdated.
+func OutdatedAgents(agentInfo *pb.AgentInfo, minVersions map[string]string) string {
+	var outdated []string
+	for _, agent := range agentInfo.GetAgents() {
+		minVersion, ok := minVersions[agent.GetName()]
+		if !ok {
+			continue
+		}
+		if c, err := CompareAgentVersions(agent.GetVersion(), minVersion); err == nil && c < 0 {
+			outdated = append(outdated, fmt.Sprintf("%s: running %s, minimum %s",
+				agent.GetName(), agent.GetVersion(), minVersion))
+		}
+	}
+	slices.Sort(outdated)
+	return strings.Join(outdated, "; ")
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+func TestCompareAgentVersions(t *testing.T) {
+	testCases := map[string]struct {
+		a, b     string
+		expected int
+		valid    bool
+	}{
+		"Equal":             {a: "1.2.3", b: "1.2.3", expected: 0, valid: true},
+		"LeadingV":          {a: "v1.2.3", b: "1.2.3", expected: 0, valid: true},
+		"MissingPatch":      {a: "1.2", b: "1.2.0", expected: 0, valid: true},
+		"NumericOrder":      {a: "1.10.0", b: "1.9.0", expected: 1, valid: true},
+		"Major":             {a: "1.99.99", b: "2.0.0", expected: -1, valid: true},
+		"PrereleaseIsLower": {a: "1.3.0-dev", b: "1.3.0", expected: -1, valid: true},
+		"PrereleaseNumeric": {a: "1.3.0-rc.2", b: "1.3.0-rc.10", expected: -1, valid: true},
+		"PrereleaseAlpha":   {a: "1.3.0-rc.1", b: "1.3.0-beta.1", expected: 1, valid: true},
+		"PrereleaseLonger":  {a: "1.3.0-rc.1", b: "1.3.0-rc", expected: 1, valid: true},
+		"BuildIgnored":      {a: "1.3.0+build.7", b: "1.3.0+build.8", expected: 0, valid: true},
+		"BuildAndPre":       {a: "1.3.0-rc.1+abc", b: "1.3.0", expected: -1, valid: true},
+		"Invalid":           {a: "latest", b: "1.3.0"},
+		"TooManyNumbers":    {a: "1.3.0.1", b: "1.3.0"},
+		"EmptyPrerelease":   {a: "1.3.0-", b: "1.3.0"},
+	}
+	for tcName, tc := range testCases {
+		t.Run(tcName, func(t *testing.T) {
+			c, err := util.CompareAgentVersions(tc.a, tc.b)
+			if !tc.valid {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, c)
+		})
+	}
+}
+
+func TestParseMinAgentVersions(t *testing.T) {
+	parsed, err := util.ParseMinAgentVersions(" node-agent=1.2.0, platform-update-agent = v0.9 ,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"node-agent": "1.2.0", "platform-update-agent": "v0.9"}, parsed)
+
+	parsed, err = util.ParseMinAgentVersions("")
+	require.NoError(t, err)
+	assert.Empty(t, parsed)
+
+	_, err = util.ParseMinAgentVersions("node-agent")
+	assert.Error(t, err)
+	_, err = util.ParseMinAgentVersions("=1.2.0")
+	assert.Error(t, err)
+	_, err = util.ParseMinAgentVersions("node-agent=latest")
+	assert.Error(t, err)
+}
+
+func TestAgentInfoMetadata(t *testing.T) {
+	agentInfo := &pb.AgentInfo{Agents: []*pb.Agent{
+		{Name: "node-agent", Version: "1.2.3", Features: []string{"status-sequence", "agent-info"}},
+		{Name: "hd-agent", Version: "1.0.0"},
+	}}
+	set := util.AgentInfoMetadata(agentInfo)
+	assert.Equal(t, map[string]string{
+		"agent/node-agent/version":  "1.2.3",
+		"agent/node-agent/features": "agent-info,status-sequence",
+		"agent/hd-agent/version":    "1.0.0",
+		"agent/hd-agent/features":   "",
+	}, set)
+
+	// The agents that are not reported anymore are removed, the other keys are kept
+	old := `[{"key":"site","value":"lab"},{"key":"agent/tm-agent/version","value":"0.1.0"},` +
+		`{"key":"agent/tm-agent/features","value":""},{"key":"agent/node-agent/version","value":"1.2.0"}]`
+	metaList, err := util.ParseMetadata(old)
+	require.NoError(t, err)
+	stale := util.StaleMetadataKeys(metaList, util.AgentMetadataKeyPrefix, set)
+	assert.ElementsMatch(t, []string{"agent/tm-agent/version", "agent/tm-agent/features"}, stale)
+	metadata, err := util.MergeMetadata(old, set, stale...)
+	require.NoError(t, err)
+
+	agents, err := util.AgentsFromMetadata(metadata)
+	require.NoError(t, err)
+	require.Len(t, agents, 2)
+	assert.True(t, proto.Equal(&pb.Agent{Name: "hd-agent", Version: "1.0.0"}, agents[0]))
+	assert.True(t, proto.Equal(&pb.Agent{
+		Name: "node-agent", Version: "1.2.3", Features: []string{"agent-info", "status-sequence"},
+	}, agents[1]))
+
+	host := &computev1.HostResource{Metadata: metadata}
+	assert.True(t, util.HasAgentFeature(host, "node-agent", "status-sequence"))
+	assert.False(t, util.HasAgentFeature(host, "hd-agent", "status-sequence"))
+	assert.False(t, util.HasAgentFeature(host, "tm-agent", "status-sequence"))
+	assert.True(t, util.AgentVersionAtLeast(host, "node-agent", "1.2"))
+	assert.False(t, util.AgentVersionAtLeast(host, "node-agent", "1.3.0-dev"))
+	assert.False(t, util.AgentVersionAtLeast(host, "tm-agent", "0.1.0"))
+}
+
+func TestOutdatedAgents(t *testing.T) {
+	agentInfo := &pb.AgentInfo{Agents: []*pb.Agent{
+		{Name: "node-agent", Version: "1.2.3"},
+		{Name: "hd-agent", Version: "0.9.0"},
+		{Name: "tm-agent", Version: "0.1.0"},
+	}}
+	assert.Empty(t, util.OutdatedAgents(agentInfo, nil))
+	assert.Empty(t, util.OutdatedAgents(agentInfo, map[string]string{"node-agent": "1.2.3"}))
+	assert.Equal(t, "hd-agent: running 0.9.0, minimum 1.0.0; node-agent: running 1.2.3, minimum 1.3.0",
+		util.OutdatedAgents(agentInfo, map[string]string{"node-agent": "1.3.0", "hd-agent": "1.0.0"}))
+}
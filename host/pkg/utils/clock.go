@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"math"
+	"time"
+
+	inv_status "github.com/open-edge-platform/infra-core/inventory/v2/pkg/status"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	hrm_status "github.com/open-edge-platform/infra-managers/host/pkg/status"
+)
+
+// ClockSkew returns the skew of the agent clock, positive if it is ahead, and whether the agent time is reported.
+// The agent time is the agent_timestamp_ns of the request carrying the status, the skew includes the
+// transmission delay of the request, negligible compared to the skews to detect.
+func ClockSkew(agentTimeNs uint64, now time.Time) (time.Duration, bool) {
+	if agentTimeNs == 0 || agentTimeNs > math.MaxInt64 {
+		return 0, false
+	}
+	return time.Unix(0, int64(agentTimeNs)).Sub(now), true
+}
+
+// HostStatusWithClockSkew returns the Host status of the status reported by the agent, with a degraded
+// indication if the host is running with a skewed clock. The status of the running host is unchanged.
+func HostStatusWithClockSkew(status pb.HostStatus_HostStatus, clockSkewed bool) inv_status.ResourceStatus {
+	if status == pb.HostStatus_RUNNING && clockSkewed {
+		return hrm_status.HostStatusClockSkewed
+	}
+	return GetHostStatus(status)
+}
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package util_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	hrm_status "github.com/open-edge-platform/infra-managers/host/pkg/status"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+func TestClockSkew(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	_, ok := util.ClockSkew(0, now)
+	assert.False(t, ok)
+
+	skew, ok := util.ClockSkew(uint64(now.Add(90*time.Second).UnixNano()), now)
+	assert.True(t, ok)
+	assert.Equal(t, 90*time.Second, skew)
+
+	skew, ok = util.ClockSkew(uint64(now.Add(-time.Hour).UnixNano()), now)
+	assert.True(t, ok)
+	assert.Equal(t, -time.Hour, skew)
+}
+
+func TestHostStatusWithClockSkew(t *testing.T) {
+	assert.Equal(t, hrm_status.HostStatusClockSkewed, util.HostStatusWithClockSkew(pb.HostStatus_RUNNING, true))
+	assert.Equal(t, hrm_status.HostStatusRunning, util.HostStatusWithClockSkew(pb.HostStatus_RUNNING, false))
+	assert.Equal(t, hrm_status.HostStatusError, util.HostStatusWithClockSkew(pb.HostStatus_ERROR, true))
+
+	// A running host with a skewed clock is still running, only its indication is degraded
+	assert.Equal(t, hrm_status.HostStatusRunning.Status, hrm_status.HostStatusClockSkewed.Status)
+	assert.NotEqual(t, hrm_status.HostStatusRunning.StatusIndicator, hrm_status.HostStatusClockSkewed.StatusIndicator)
+	host := &computev1.HostResource{
+		HostStatus:          hrm_status.HostStatusClockSkewed.Status,
+		HostStatusIndicator: hrm_status.HostStatusClockSkewed.StatusIndicator,
+	}
+	assert.Equal(t, pb.HostStatus_RUNNING, util.ReportedHostStatus(host))
+	assert.True(t, util.IsSameHostStatus(host, &pb.HostStatus{HostStatus: pb.HostStatus_RUNNING}))
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+)
+
+// The identity of a host is pinned when first seen: its serial number and product name are the ones of the
+// Host resource, its TPM endorsement key hash is kept in the state of the host. A report for the same GUID
+// with another identity, e.g. from a cloned disk image, is not accepted.
+const identityMismatchSeparator = "; "
+
+// IdentityMismatchPolicy is the handling of the reports that do not match the pinned identity of a host.
+type IdentityMismatchPolicy string
+
+const (
+	// IdentityMismatchReject rejects the mismatching reports.
+	IdentityMismatchReject IdentityMismatchPolicy = "reject"
+	// IdentityMismatchQuarantine rejects the mismatching reports and quarantines the host, every later
+	// report is rejected until the host is released.
+	IdentityMismatchQuarantine IdentityMismatchPolicy = "quarantine"
+)
+
+// ParseIdentityMismatchPolicy parses an identity mismatch policy, reject or quarantine, reject if empty.
+func ParseIdentityMismatchPolicy(policy string) (IdentityMismatchPolicy, error) {
+	switch p := IdentityMismatchPolicy(policy); p {
+	case "":
+		return IdentityMismatchReject, nil
+	case IdentityMismatchReject, IdentityMismatchQuarantine:
+		return p, nil
+	default:
+		return "", errors.Errorfc(codes.InvalidArgument, "invalid identity mismatch policy %q, expected %s or %s",
+			policy, IdentityMismatchReject, IdentityMismatchQuarantine)
+	}
+}
+
+// IdentityMismatch returns the differences between the identity pinned on the host, with the given TPM
+// endorsement key hash, and the reported one, or an empty string if they match. Identifiers that are not
+// pinned yet, or not reported, are not compared.
+func IdentityMismatch(hostres *computev1.HostResource, tpmEKHash string, hwInfo *pb.HWInfo) string {
+	var mismatches []string
+	compare := func(identifier, pinned, reported string) {
+		pinned, reported = strings.TrimSpace(pinned), strings.TrimSpace(reported)
+		if pinned != "" && reported != "" && !strings.EqualFold(pinned, reported) {
+			mismatches = append(mismatches, fmt.Sprintf("%s: pinned %s, reported %s", identifier, pinned, reported))
+		}
+	}
+	compare("serial number", hostres.GetSerialNumber(), hwInfo.GetSerialNum())
+	compare("product name", hostres.GetProductName(), hwInfo.GetProductName())
+	compare("TPM EK hash", tpmEKHash, hwInfo.GetTpmEkHash())
+	return strings.Join(mismatches, identityMismatchSeparator)
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package util_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+)
+
+func TestIdentityMismatch(t *testing.T) {
+	tpmEKHash := strings.Repeat("ab", 32)
+	host := &computev1.HostResource{
+		SerialNumber: "SN-1234",
+		ProductName:  "NUC13ANHi7",
+	}
+	testCases := map[string]struct {
+		hwInfo   *pb.HWInfo
+		expected string
+	}{
+		"Same": {
+			hwInfo: &pb.HWInfo{SerialNum: "SN-1234", ProductName: "NUC13ANHi7", TpmEkHash: tpmEKHash},
+		},
+		"CaseAndSpaces": {
+			hwInfo: &pb.HWInfo{SerialNum: " sn-1234 ", ProductName: "nuc13anhi7", TpmEkHash: strings.ToUpper(tpmEKHash)},
+		},
+		"NotReported": {
+			hwInfo: &pb.HWInfo{},
+		},
+		"Serial": {
+			hwInfo:   &pb.HWInfo{SerialNum: "SN-5678", ProductName: "NUC13ANHi7"},
+			expected: "serial number: pinned SN-1234, reported SN-5678",
+		},
+		"All": {
+			hwInfo: &pb.HWInfo{SerialNum: "SN-5678", ProductName: "NUC12WSHi7", TpmEkHash: strings.Repeat("cd", 32)},
+			expected: "serial number: pinned SN-1234, reported SN-5678; product name: pinned NUC13ANHi7, " +
+				"reported NUC12WSHi7; TPM EK hash: pinned " + tpmEKHash + ", reported " + strings.Repeat("cd", 32),
+		},
+	}
+	for tcName, tc := range testCases {
+		t.Run(tcName, func(t *testing.T) {
+			assert.Equal(t, tc.expected, util.IdentityMismatch(host, tpmEKHash, tc.hwInfo))
+		})
+	}
+
+	// Nothing is pinned yet
+	assert.Empty(t, util.IdentityMismatch(&computev1.HostResource{}, "", &pb.HWInfo{SerialNum: "SN-5678"}))
+}
+
+func TestParseIdentityMismatchPolicy(t *testing.T) {
+	policy, err := util.ParseIdentityMismatchPolicy("")
+	require.NoError(t, err)
+	assert.Equal(t, util.IdentityMismatchReject, policy)
+	policy, err = util.ParseIdentityMismatchPolicy("quarantine")
+	require.NoError(t, err)
+	assert.Equal(t, util.IdentityMismatchQuarantine, policy)
+	_, err = util.ParseIdentityMismatchPolicy("accept")
+	assert.Error(t, err)
+}
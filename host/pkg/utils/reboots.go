@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"time"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+)
+
+// Reasons of the expected reboots.
+const (
+	RebootReasonMaintenance  = "maintenance"
+	RebootReasonRestart      = "restart requested"
+	RebootReasonProvisioning = "provisioning"
+)
+
+// ExpectedRebootReason returns why a reboot of the host is expected in its current state, or an empty string
+// if it is not: the host is under maintenance, i.e. updating, a restart has been requested or it is being provisioned.
+func ExpectedRebootReason(hostres *computev1.HostResource) string {
+	switch {
+	case IsHostUnderMaintain(hostres):
+		return RebootReasonMaintenance
+	case GetHostAction(hostres) == pb.HostStatusResp_RESTART:
+		return RebootReasonRestart
+	case IsHostNotProvisioned(hostres):
+		return RebootReasonProvisioning
+	default:
+		return ""
+	}
+}
+
+// BootTime returns the time of the last boot of the host, derived from the reported uptime, or the zero time
+// if the uptime is not reported.
+func BootTime(status *pb.HostStatus, now time.Time) time.Time {
+	uptime := status.GetUptimeSeconds()
+	if uptime == 0 {
+		return time.Time{}
+	}
+	// The uptime is validated to be at most 100 years, the conversion to a duration cannot overflow
+	return now.Add(-time.Duration(uptime) * time.Second)
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package util_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+	mm_status "github.com/open-edge-platform/infra-managers/maintenance/pkg/status"
+	om_status "github.com/open-edge-platform/infra-onboarding/onboarding-manager/pkg/status"
+)
+
+func TestBootTime(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, 10, 16, 11, 0, 0, 0, time.UTC),
+		util.BootTime(&pb.HostStatus{UptimeSeconds: 3600}, now))
+	assert.True(t, util.BootTime(&pb.HostStatus{}, now).IsZero())
+}
+
+func TestExpectedRebootReason(t *testing.T) {
+	provisioned := &computev1.InstanceResource{
+		ProvisioningStatusIndicator: om_status.ProvisioningStatusDone.StatusIndicator,
+		ProvisioningStatus:          om_status.ProvisioningStatusDone.Status,
+	}
+	testCases := map[string]struct {
+		host     *computev1.HostResource
+		expected string
+	}{
+		"Running": {
+			host: &computev1.HostResource{Instance: provisioned},
+		},
+		"Maintenance": {
+			host: &computev1.HostResource{
+				Instance: &computev1.InstanceResource{
+					UpdateStatusIndicator: mm_status.UpdateStatusInProgress.StatusIndicator,
+					UpdateStatus:          mm_status.UpdateStatusInProgress.Status,
+				},
+			},
+			expected: util.RebootReasonMaintenance,
+		},
+		"RestartRequested": {
+			host: &computev1.HostResource{
+				Instance:          provisioned,
+				DesiredPowerState: computev1.PowerState_POWER_STATE_RESET,
+				CurrentPowerState: computev1.PowerState_POWER_STATE_ON,
+			},
+			expected: util.RebootReasonRestart,
+		},
+		"Provisioning": {
+			host:     &computev1.HostResource{},
+			expected: util.RebootReasonProvisioning,
+		},
+	}
+	for tcName, tc := range testCases {
+		t.Run(tcName, func(t *testing.T) {
+			assert.Equal(t, tc.expected, util.ExpectedRebootReason(tc.host))
+		})
+	}
+}
@@ -0,0 +1,189 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"slices"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	statusv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/status/v1"
+	inv_status "github.com/open-edge-platform/infra-core/inventory/v2/pkg/status"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	hrm_status "github.com/open-edge-platform/infra-managers/host/pkg/status"
+	mm_status "github.com/open-edge-platform/infra-managers/maintenance/pkg/status"
+	om_status "github.com/open-edge-platform/infra-onboarding/onboarding-manager/pkg/status"
+)
+
+// hostStatusTransitions lists the statuses an agent can report after each status, besides the same one.
+// UNSPECIFIED carries no information: it can be reported at any time and can be followed by any status.
+// ERROR can be followed by any status, the agent recovers from wherever it failed.
+var hostStatusTransitions = map[pb.HostStatus_HostStatus][]pb.HostStatus_HostStatus{
+	pb.HostStatus_REGISTERING: {
+		pb.HostStatus_BOOTING, pb.HostStatus_PROVISIONING, pb.HostStatus_RUNNING,
+	},
+	pb.HostStatus_BOOTING: {
+		pb.HostStatus_BOOTFAILED, pb.HostStatus_PROVISIONING, pb.HostStatus_RUNNING,
+	},
+	pb.HostStatus_BOOTFAILED: {
+		pb.HostStatus_REGISTERING, pb.HostStatus_BOOTING,
+	},
+	pb.HostStatus_PROVISIONING: {
+		pb.HostStatus_BOOTING, pb.HostStatus_PROVISIONED, pb.HostStatus_PROVISIONFAILED,
+	},
+	pb.HostStatus_PROVISIONED: {
+		pb.HostStatus_BOOTING, pb.HostStatus_RUNNING,
+	},
+	pb.HostStatus_PROVISIONFAILED: {
+		pb.HostStatus_REGISTERING, pb.HostStatus_BOOTING, pb.HostStatus_PROVISIONING,
+	},
+	pb.HostStatus_RUNNING: {
+		pb.HostStatus_BOOTING, pb.HostStatus_UPDATING,
+	},
+	pb.HostStatus_UPDATING: {
+		pb.HostStatus_BOOTING, pb.HostStatus_RUNNING, pb.HostStatus_UPDATEFAILED,
+	},
+	pb.HostStatus_UPDATEFAILED: {
+		pb.HostStatus_BOOTING, pb.HostStatus_RUNNING, pb.HostStatus_UPDATING,
+	},
+}
+
+// instanceStatusTransitions lists the Instance statuses an agent can report after each status, besides the same
+// one. As for the host statuses, UNSPECIFIED and ERROR can be reported at any time and followed by any status.
+var instanceStatusTransitions = map[pb.InstanceStatus][]pb.InstanceStatus{
+	pb.InstanceStatus_INSTANCE_STATUS_BOOTING: {
+		pb.InstanceStatus_INSTANCE_STATUS_BOOT_FAILED, pb.InstanceStatus_INSTANCE_STATUS_PROVISIONING,
+		pb.InstanceStatus_INSTANCE_STATUS_INITIALIZING, pb.InstanceStatus_INSTANCE_STATUS_RUNNING,
+	},
+	pb.InstanceStatus_INSTANCE_STATUS_BOOT_FAILED: {
+		pb.InstanceStatus_INSTANCE_STATUS_BOOTING,
+	},
+	pb.InstanceStatus_INSTANCE_STATUS_PROVISIONING: {
+		pb.InstanceStatus_INSTANCE_STATUS_BOOTING, pb.InstanceStatus_INSTANCE_STATUS_PROVISIONED,
+		pb.InstanceStatus_INSTANCE_STATUS_PROVISION_FAILED,
+	},
+	pb.InstanceStatus_INSTANCE_STATUS_PROVISIONED: {
+		pb.InstanceStatus_INSTANCE_STATUS_BOOTING, pb.InstanceStatus_INSTANCE_STATUS_INITIALIZING,
+		pb.InstanceStatus_INSTANCE_STATUS_RUNNING,
+	},
+	pb.InstanceStatus_INSTANCE_STATUS_PROVISION_FAILED: {
+		pb.InstanceStatus_INSTANCE_STATUS_BOOTING, pb.InstanceStatus_INSTANCE_STATUS_PROVISIONING,
+	},
+	pb.InstanceStatus_INSTANCE_STATUS_INITIALIZING: {
+		pb.InstanceStatus_INSTANCE_STATUS_BOOTING, pb.InstanceStatus_INSTANCE_STATUS_RUNNING,
+	},
+	pb.InstanceStatus_INSTANCE_STATUS_RUNNING: {
+		pb.InstanceStatus_INSTANCE_STATUS_BOOTING, pb.InstanceStatus_INSTANCE_STATUS_UPDATING,
+	},
+	pb.InstanceStatus_INSTANCE_STATUS_UPDATING: {
+		pb.InstanceStatus_INSTANCE_STATUS_BOOTING, pb.InstanceStatus_INSTANCE_STATUS_INITIALIZING,
+		pb.InstanceStatus_INSTANCE_STATUS_RUNNING, pb.InstanceStatus_INSTANCE_STATUS_UPDATE_FAILED,
+	},
+	pb.InstanceStatus_INSTANCE_STATUS_UPDATE_FAILED: {
+		pb.InstanceStatus_INSTANCE_STATUS_BOOTING, pb.InstanceStatus_INSTANCE_STATUS_RUNNING,
+		pb.InstanceStatus_INSTANCE_STATUS_UPDATING,
+	},
+}
+
+// instanceStateTransitions lists the Instance states an agent can report after each current state of the
+// Instance, besides the same one. UNSPECIFIED carries no information, it can be reported at any time and
+// can be followed by any state. A deleted or untrusted Instance cannot run again.
+var instanceStateTransitions = map[computev1.InstanceState][]computev1.InstanceState{
+	computev1.InstanceState_INSTANCE_STATE_RUNNING:   {computev1.InstanceState_INSTANCE_STATE_DELETED},
+	computev1.InstanceState_INSTANCE_STATE_UNTRUSTED: {computev1.InstanceState_INSTANCE_STATE_DELETED},
+}
+
+// IsValidHostStatusTransition checks if an agent can report the status to after the status from.
+func IsValidHostStatusTransition(from, to pb.HostStatus_HostStatus) bool {
+	switch {
+	case from == to, from == pb.HostStatus_UNSPECIFIED, from == pb.HostStatus_ERROR,
+		to == pb.HostStatus_UNSPECIFIED, to == pb.HostStatus_ERROR:
+		return true
+	default:
+		return slices.Contains(hostStatusTransitions[from], to)
+	}
+}
+
+// IsValidInstanceStatusTransition checks if an agent can report the Instance status to after the status from.
+func IsValidInstanceStatusTransition(from, to pb.InstanceStatus) bool {
+	switch {
+	case from == to, from == pb.InstanceStatus_INSTANCE_STATUS_UNSPECIFIED, from == pb.InstanceStatus_INSTANCE_STATUS_ERROR,
+		to == pb.InstanceStatus_INSTANCE_STATUS_UNSPECIFIED, to == pb.InstanceStatus_INSTANCE_STATUS_ERROR:
+		return true
+	default:
+		return slices.Contains(instanceStatusTransitions[from], to)
+	}
+}
+
+// IsValidInstanceStateTransition checks if an agent can report the Instance state to when the Instance is
+// in the state from.
+func IsValidInstanceStateTransition(from computev1.InstanceState, to pb.InstanceState) bool {
+	toState := instanceStateToInstanceResourceState[to]
+	switch {
+	case from == toState, from == computev1.InstanceState_INSTANCE_STATE_UNSPECIFIED,
+		to == pb.InstanceState_INSTANCE_STATE_UNSPECIFIED:
+		return true
+	default:
+		return slices.Contains(instanceStateTransitions[from], toState)
+	}
+}
+
+// ReportedHostStatus returns the last status reported by the agent of the host, as far as the fields of the Host
+// and of its Instance tell: the Host status is set for RUNNING and ERROR only, the update and provisioning
+// statuses of the Instance tell the others. It is UNSPECIFIED if it is unknown, or if the host status has been
+// changed since then, e.g. when the connection was lost.
+func ReportedHostStatus(hostres *computev1.HostResource) pb.HostStatus_HostStatus {
+	switch {
+	case hostres.GetHostStatus() == hrm_status.HostStatusRunning.Status:
+		return pb.HostStatus_RUNNING
+	case hostres.GetHostStatus() == hrm_status.HostStatusError.Status:
+		return pb.HostStatus_ERROR
+	case hostres.GetHostStatus() == hrm_status.HostStatusUnknown.Status:
+		return instanceStatusToHostStatus[instanceProgressStatus(hostres.GetInstance())]
+	default:
+		return pb.HostStatus_UNSPECIFIED
+	}
+}
+
+// ReportedInstanceStatus returns the last Instance status reported by the agent, as far as the fields of the
+// Instance tell: its status is set for INITIALIZING, RUNNING and ERROR only, its update and provisioning
+// statuses tell the others. It is UNSPECIFIED if it is unknown.
+func ReportedInstanceStatus(instance *computev1.InstanceResource) pb.InstanceStatus {
+	for _, status := range []pb.InstanceStatus{
+		pb.InstanceStatus_INSTANCE_STATUS_INITIALIZING,
+		pb.InstanceStatus_INSTANCE_STATUS_RUNNING,
+		pb.InstanceStatus_INSTANCE_STATUS_ERROR,
+	} {
+		if instance.GetInstanceStatus() == GetInstanceStatus(status).Status {
+			return status
+		}
+	}
+	if instance.GetInstanceStatus() == hrm_status.InstanceStatusUnknown.Status {
+		return instanceProgressStatus(instance)
+	}
+	return pb.InstanceStatus_INSTANCE_STATUS_UNSPECIFIED
+}
+
+// instanceProgressStatus returns the Instance status of an update or provisioning in progress or failed,
+// UNSPECIFIED if there is none.
+func instanceProgressStatus(instance *computev1.InstanceResource) pb.InstanceStatus {
+	switch {
+	case isStatus(instance.GetUpdateStatus(), instance.GetUpdateStatusIndicator(), mm_status.UpdateStatusInProgress):
+		return pb.InstanceStatus_INSTANCE_STATUS_UPDATING
+	case isStatus(instance.GetUpdateStatus(), instance.GetUpdateStatusIndicator(), mm_status.UpdateStatusFailed):
+		return pb.InstanceStatus_INSTANCE_STATUS_UPDATE_FAILED
+	case isStatus(instance.GetProvisioningStatus(), instance.GetProvisioningStatusIndicator(),
+		om_status.ProvisioningStatusInProgress):
+		return pb.InstanceStatus_INSTANCE_STATUS_PROVISIONING
+	case isStatus(instance.GetProvisioningStatus(), instance.GetProvisioningStatusIndicator(),
+		om_status.ProvisioningStatusFailed):
+		return pb.InstanceStatus_INSTANCE_STATUS_PROVISION_FAILED
+	default:
+		return pb.InstanceStatus_INSTANCE_STATUS_UNSPECIFIED
+	}
+}
+
+func isStatus(status string, indicator statusv1.StatusIndication, expected inv_status.ResourceStatus) bool {
+	return status == expected.Status && indicator == expected.StatusIndicator
+}
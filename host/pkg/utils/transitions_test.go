@@ -0,0 +1,264 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package util_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
+	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
+	hrm_status "github.com/open-edge-platform/infra-managers/host/pkg/status"
+	util "github.com/open-edge-platform/infra-managers/host/pkg/utils"
+	mm_status "github.com/open-edge-platform/infra-managers/maintenance/pkg/status"
+	om_status "github.com/open-edge-platform/infra-onboarding/onboarding-manager/pkg/status"
+)
+
+func TestIsValidHostStatusTransition(t *testing.T) {
+	const (
+		unspecified     = pb.HostStatus_UNSPECIFIED
+		registering     = pb.HostStatus_REGISTERING
+		booting         = pb.HostStatus_BOOTING
+		bootFailed      = pb.HostStatus_BOOTFAILED
+		provisioning    = pb.HostStatus_PROVISIONING
+		provisioned     = pb.HostStatus_PROVISIONED
+		provisionFailed = pb.HostStatus_PROVISIONFAILED
+		running         = pb.HostStatus_RUNNING
+		updating        = pb.HostStatus_UPDATING
+		updateFailed    = pb.HostStatus_UPDATEFAILED
+		hostError       = pb.HostStatus_ERROR
+	)
+	all := []pb.HostStatus_HostStatus{
+		unspecified, registering, booting, bootFailed, provisioning, provisioned,
+		provisionFailed, running, updating, updateFailed, hostError,
+	}
+	// Legal statuses after each status, besides the same one, UNSPECIFIED and ERROR
+	legal := map[pb.HostStatus_HostStatus][]pb.HostStatus_HostStatus{
+		unspecified:     all,
+		registering:     {booting, provisioning, running},
+		booting:         {bootFailed, provisioning, running},
+		bootFailed:      {registering, booting},
+		provisioning:    {booting, provisioned, provisionFailed},
+		provisioned:     {booting, running},
+		provisionFailed: {registering, booting, provisioning},
+		running:         {booting, updating},
+		updating:        {booting, running, updateFailed},
+		updateFailed:    {booting, running, updating},
+		hostError:       all,
+	}
+	// The table covers every status of the API
+	assert.Len(t, all, len(pb.HostStatus_HostStatus_name))
+
+	for _, from := range all {
+		for _, to := range all {
+			expected := from == to || to == unspecified || to == hostError
+			for _, s := range legal[from] {
+				expected = expected || s == to
+			}
+			assert.Equal(t, expected, util.IsValidHostStatusTransition(from, to), "%s -> %s", from, to)
+		}
+	}
+}
+
+//nolint:funlen // it is a table-driven test
+func TestIsValidInstanceStatusTransition(t *testing.T) {
+	const (
+		unspecified     = pb.InstanceStatus_INSTANCE_STATUS_UNSPECIFIED
+		booting         = pb.InstanceStatus_INSTANCE_STATUS_BOOTING
+		bootFailed      = pb.InstanceStatus_INSTANCE_STATUS_BOOT_FAILED
+		provisioning    = pb.InstanceStatus_INSTANCE_STATUS_PROVISIONING
+		provisioned     = pb.InstanceStatus_INSTANCE_STATUS_PROVISIONED
+		provisionFailed = pb.InstanceStatus_INSTANCE_STATUS_PROVISION_FAILED
+		initializing    = pb.InstanceStatus_INSTANCE_STATUS_INITIALIZING
+		running         = pb.InstanceStatus_INSTANCE_STATUS_RUNNING
+		updating        = pb.InstanceStatus_INSTANCE_STATUS_UPDATING
+		updateFailed    = pb.InstanceStatus_INSTANCE_STATUS_UPDATE_FAILED
+		instanceError   = pb.InstanceStatus_INSTANCE_STATUS_ERROR
+	)
+	all := []pb.InstanceStatus{
+		unspecified, booting, bootFailed, provisioning, provisioned, provisionFailed,
+		initializing, running, updating, updateFailed, instanceError,
+	}
+	// Legal statuses after each status, besides the same one, UNSPECIFIED and ERROR
+	legal := map[pb.InstanceStatus][]pb.InstanceStatus{
+		unspecified:     all,
+		booting:         {bootFailed, provisioning, initializing, running},
+		bootFailed:      {booting},
+		provisioning:    {booting, provisioned, provisionFailed},
+		provisioned:     {booting, initializing, running},
+		provisionFailed: {booting, provisioning},
+		initializing:    {booting, running},
+		running:         {booting, updating},
+		updating:        {booting, initializing, running, updateFailed},
+		updateFailed:    {booting, running, updating},
+		instanceError:   all,
+	}
+	// The table covers every status of the API
+	assert.Len(t, all, len(pb.InstanceStatus_name))
+
+	for _, from := range all {
+		for _, to := range all {
+			expected := from == to || to == unspecified || to == instanceError
+			for _, s := range legal[from] {
+				expected = expected || s == to
+			}
+			assert.Equal(t, expected, util.IsValidInstanceStatusTransition(from, to), "%s -> %s", from, to)
+		}
+	}
+}
+
+func TestIsValidInstanceStateTransition(t *testing.T) {
+	const (
+		unspecified = computev1.InstanceState_INSTANCE_STATE_UNSPECIFIED
+		running     = computev1.InstanceState_INSTANCE_STATE_RUNNING
+		deleted     = computev1.InstanceState_INSTANCE_STATE_DELETED
+		untrusted   = computev1.InstanceState_INSTANCE_STATE_UNTRUSTED
+	)
+	// Legal states reported by the agent in each state of the Instance
+	legal := map[computev1.InstanceState][]pb.InstanceState{
+		unspecified: {
+			pb.InstanceState_INSTANCE_STATE_UNSPECIFIED, pb.InstanceState_INSTANCE_STATE_INSTALLED,
+			pb.InstanceState_INSTANCE_STATE_RUNNING, pb.InstanceState_INSTANCE_STATE_STOPPED,
+			pb.InstanceState_INSTANCE_STATE_DELETED,
+		},
+		running: {
+			pb.InstanceState_INSTANCE_STATE_UNSPECIFIED, pb.InstanceState_INSTANCE_STATE_INSTALLED,
+			pb.InstanceState_INSTANCE_STATE_RUNNING, pb.InstanceState_INSTANCE_STATE_STOPPED,
+			pb.InstanceState_INSTANCE_STATE_DELETED,
+		},
+		deleted:   {pb.InstanceState_INSTANCE_STATE_UNSPECIFIED, pb.InstanceState_INSTANCE_STATE_DELETED},
+		untrusted: {pb.InstanceState_INSTANCE_STATE_UNSPECIFIED, pb.InstanceState_INSTANCE_STATE_DELETED},
+	}
+	// The table covers every state of the Instance
+	assert.Len(t, legal, len(computev1.InstanceState_name))
+
+	for from, states := range legal {
+		for value := range pb.InstanceState_name {
+			to := pb.InstanceState(value)
+			expected := slices.Contains(states, to)
+			assert.Equal(t, expected, util.IsValidInstanceStateTransition(from, to), "%s -> %s", from, to)
+		}
+	}
+}
+
+func TestReportedHostStatus(t *testing.T) {
+	updating := &computev1.InstanceResource{
+		UpdateStatus:          mm_status.UpdateStatusInProgress.Status,
+		UpdateStatusIndicator: mm_status.UpdateStatusInProgress.StatusIndicator,
+	}
+	testCases := map[string]struct {
+		host     *computev1.HostResource
+		expected pb.HostStatus_HostStatus
+	}{
+		"NoStatus": {
+			host:     &computev1.HostResource{},
+			expected: pb.HostStatus_UNSPECIFIED,
+		},
+		"Running": {
+			host:     &computev1.HostResource{HostStatus: hrm_status.HostStatusRunning.Status},
+			expected: pb.HostStatus_RUNNING,
+		},
+		"Error": {
+			host:     &computev1.HostResource{HostStatus: hrm_status.HostStatusError.Status},
+			expected: pb.HostStatus_ERROR,
+		},
+		"UnknownStatus": {
+			host:     &computev1.HostResource{HostStatus: hrm_status.HostStatusUnknown.Status},
+			expected: pb.HostStatus_UNSPECIFIED,
+		},
+		"Updating": {
+			host:     &computev1.HostResource{HostStatus: hrm_status.HostStatusUnknown.Status, Instance: updating},
+			expected: pb.HostStatus_UPDATING,
+		},
+		"UpdateFailed": {
+			host: &computev1.HostResource{
+				HostStatus: hrm_status.HostStatusUnknown.Status,
+				Instance: &computev1.InstanceResource{
+					UpdateStatus:          mm_status.UpdateStatusFailed.Status,
+					UpdateStatusIndicator: mm_status.UpdateStatusFailed.StatusIndicator,
+				},
+			},
+			expected: pb.HostStatus_UPDATEFAILED,
+		},
+		"Provisioning": {
+			host: &computev1.HostResource{
+				HostStatus: hrm_status.HostStatusUnknown.Status,
+				Instance: &computev1.InstanceResource{
+					ProvisioningStatus:          om_status.ProvisioningStatusInProgress.Status,
+					ProvisioningStatusIndicator: om_status.ProvisioningStatusInProgress.StatusIndicator,
+				},
+			},
+			expected: pb.HostStatus_PROVISIONING,
+		},
+		"ConnectionLost": {
+			host:     &computev1.HostResource{HostStatus: hrm_status.HostStatusNoConnection.Status, Instance: updating},
+			expected: pb.HostStatus_UNSPECIFIED,
+		},
+		// The metadata is edited by the users, it does not tell the reported status
+		"Metadata": {
+			host: &computev1.HostResource{
+				HostStatus: hrm_status.HostStatusUnknown.Status,
+				Metadata:   `[{"key":"host-status-reported","value":"RUNNING"}]`,
+			},
+			expected: pb.HostStatus_UNSPECIFIED,
+		},
+	}
+	for tcName, tc := range testCases {
+		t.Run(tcName, func(t *testing.T) {
+			assert.Equal(t, tc.expected, util.ReportedHostStatus(tc.host))
+		})
+	}
+}
+
+func TestReportedInstanceStatus(t *testing.T) {
+	testCases := map[string]struct {
+		instance *computev1.InstanceResource
+		expected pb.InstanceStatus
+	}{
+		"NoInstance": {
+			expected: pb.InstanceStatus_INSTANCE_STATUS_UNSPECIFIED,
+		},
+		"Initializing": {
+			instance: &computev1.InstanceResource{InstanceStatus: hrm_status.InstanceStatusInitializing.Status},
+			expected: pb.InstanceStatus_INSTANCE_STATUS_INITIALIZING,
+		},
+		"Running": {
+			instance: &computev1.InstanceResource{InstanceStatus: hrm_status.InstanceStatusRunning.Status},
+			expected: pb.InstanceStatus_INSTANCE_STATUS_RUNNING,
+		},
+		"Error": {
+			instance: &computev1.InstanceResource{InstanceStatus: hrm_status.InstanceStatusError.Status},
+			expected: pb.InstanceStatus_INSTANCE_STATUS_ERROR,
+		},
+		"Unknown": {
+			instance: &computev1.InstanceResource{InstanceStatus: hrm_status.InstanceStatusUnknown.Status},
+			expected: pb.InstanceStatus_INSTANCE_STATUS_UNSPECIFIED,
+		},
+		"ProvisionFailed": {
+			instance: &computev1.InstanceResource{
+				InstanceStatus:              hrm_status.InstanceStatusUnknown.Status,
+				ProvisioningStatus:          om_status.ProvisioningStatusFailed.Status,
+				ProvisioningStatusIndicator: om_status.ProvisioningStatusFailed.StatusIndicator,
+			},
+			expected: pb.InstanceStatus_INSTANCE_STATUS_PROVISION_FAILED,
+		},
+		// The update status does not hide the status reported by the agent
+		"RunningWhileUpdating": {
+			instance: &computev1.InstanceResource{
+				InstanceStatus:        hrm_status.InstanceStatusRunning.Status,
+				UpdateStatus:          mm_status.UpdateStatusInProgress.Status,
+				UpdateStatusIndicator: mm_status.UpdateStatusInProgress.StatusIndicator,
+			},
+			expected: pb.InstanceStatus_INSTANCE_STATUS_RUNNING,
+		},
+	}
+	for tcName, tc := range testCases {
+		t.Run(tcName, func(t *testing.T) {
+			assert.Equal(t, tc.expected, util.ReportedInstanceStatus(tc.instance))
+		})
+	}
+}
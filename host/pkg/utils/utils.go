@@ -9,11 +9,16 @@ package util
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/netip"
+	"slices"
 	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -22,6 +27,7 @@ import (
 
 	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
 	network_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/network/v1"
+	osv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/os/v1"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/errors"
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/logging"
 	inv_status "github.com/open-edge-platform/infra-core/inventory/v2/pkg/status"
@@ -55,6 +61,16 @@ var mapIPConfigMode = map[pb.ConfigMode]network_v1.IPAddressConfigMethod{
 	pb.ConfigMode_CONFIG_MODE_DYNAMIC:     network_v1.IPAddressConfigMethod_IP_ADDRESS_CONFIG_METHOD_DYNAMIC,
 }
 
+// Redfish and FDO controllers have no Inventory kind, they are recorded as unspecified.
+var mapBmTypeToBmcKind = map[pb.BmInfo_BmType]computev1.BaremetalControllerKind{
+	pb.BmInfo_IPMI:    computev1.BaremetalControllerKind_BAREMETAL_CONTROLLER_KIND_IPMI,
+	pb.BmInfo_PDU:     computev1.BaremetalControllerKind_BAREMETAL_CONTROLLER_KIND_PDU,
+	pb.BmInfo_VPRO:    computev1.BaremetalControllerKind_BAREMETAL_CONTROLLER_KIND_VPRO,
+	pb.BmInfo_NONE:    computev1.BaremetalControllerKind_BAREMETAL_CONTROLLER_KIND_NONE,
+	pb.BmInfo_REDFISH: computev1.BaremetalControllerKind_BAREMETAL_CONTROLLER_KIND_UNSPECIFIED,
+	pb.BmInfo_FDO:     computev1.BaremetalControllerKind_BAREMETAL_CONTROLLER_KIND_UNSPECIFIED,
+}
+
 var instanceStateToInstanceResourceState = map[pb.InstanceState]computev1.InstanceState{
 	pb.InstanceState_INSTANCE_STATE_UNSPECIFIED: computev1.InstanceState_INSTANCE_STATE_UNSPECIFIED,
 	pb.InstanceState_INSTANCE_STATE_RUNNING:     computev1.InstanceState_INSTANCE_STATE_RUNNING,
@@ -186,6 +202,39 @@ func PopulateHostgpuWithGpuInfo(gpu *pb.SystemGPU, host *computev1.HostResource)
 	return &gpures, nil
 }
 
+// Keys of the Host metadata managed by Host Manager.
+const (
+	// KubeconfigMetadataKey is the legacy key of a kubeconfig stored in clear in the metadata.
+	KubeconfigMetadataKey = "kubeconfig"
+	// KubeconfigRefMetadataKey is the key of the reference to the kubeconfig in the secret store.
+	KubeconfigRefMetadataKey = "kubeconfig-ref"
+	// BmcCredentialsRefMetadataKey is the key of the reference to the BMC credentials in the secret store.
+	BmcCredentialsRefMetadataKey = "bmc-credentials-ref"
+	// OSKernelVersionMetadataKey is the key of the version of the running kernel.
+	OSKernelVersionMetadataKey = "os-kernel-version"
+	// OSKernelConfigMetadataKey is the key of the SHA-256 digest of the running kernel config.
+	OSKernelConfigMetadataKey = "os-kernel-config-sha256"
+	// OSReleaseMetadataKey is the key of the ID and version of the running OS release.
+	OSReleaseMetadataKey = "os-release"
+	// OSDriftMetadataKey is the key of the differences between the running OS and the Instance OS, if any.
+	OSDriftMetadataKey = "os-drift"
+	// HostStatusDetailsMetadataKey is the key of the details of the host status reported by the agent.
+	HostStatusDetailsMetadataKey = "host-status-details"
+	// HostStatusMessageMetadataKey is the key of the human-readable host status reported by the agent.
+	HostStatusMessageMetadataKey = "host-status-message"
+	// HostStatusErrorCodeMetadataKey is the key of the error code of the host status reported by the agent.
+	HostStatusErrorCodeMetadataKey = "host-status-error-code"
+)
+
+// MaxHostStatusDetailLength is the maximum number of characters of the host status details kept in the metadata.
+const MaxHostStatusDetailLength = 256
+
+// Keys of the OS release metadata reported by the agent, identifying the installed OS image.
+const (
+	OSReleaseImageIDKey     = "IMAGE_ID"
+	OSReleaseProfileNameKey = "PROFILE_NAME"
+)
+
 type Metadata struct {
 	Key   string `json:"key"`
 	Value string `json:"value"`
@@ -231,11 +280,134 @@ func SerializeMetadata(metadataMap map[string]string) (string, error) {
 	return string(metaBytes), nil
 }
 
+// MergeMetadata sets the given keys in the metadata and removes the deleted ones. The other keys,
+// e.g. the user ones, are kept in their order, the new keys are appended sorted by name.
+func MergeMetadata(metadata string, set map[string]string, deleted ...string) (string, error) {
+	metaList, err := ParseMetadata(metadata)
+	if err != nil {
+		return "", errors.Errorfc(codes.InvalidArgument, "invalid input: metadata deserialization error")
+	}
+	merged := make([]Metadata, 0, len(metaList)+len(set))
+	seen := make(map[string]struct{}, len(set))
+	for _, m := range metaList {
+		if slices.Contains(deleted, m.Key) {
+			continue
+		}
+		if value, ok := set[m.Key]; ok {
+			// Duplicated keys are collapsed into a single one
+			if _, dup := seen[m.Key]; dup {
+				continue
+			}
+			m.Value = value
+			seen[m.Key] = struct{}{}
+		}
+		merged = append(merged, m)
+	}
+	newKeys := make([]string, 0, len(set))
+	for key := range set {
+		if _, ok := seen[key]; !ok {
+			newKeys = append(newKeys, key)
+		}
+	}
+	slices.Sort(newKeys)
+	for _, key := range newKeys {
+		merged = append(merged, Metadata{Key: key, Value: set[key]})
+	}
+
+	metaBytes, err := json.Marshal(merged)
+	if err != nil {
+		zlog.InfraSec().InfraErr(err).Msgf("Error while marshaling the metadata")
+		return "", errors.Errorfc(codes.InvalidArgument, "invalid input: metadata serialization error")
+	}
+	return string(metaBytes), nil
+}
+
+// StaleMetadataKeys returns the keys of the metadata with the given prefix that are not set anymore,
+// e.g. the keys of the agents that are not reported anymore.
+func StaleMetadataKeys(metaList []Metadata, prefix string, set map[string]string) []string {
+	var stale []string
+	for _, m := range metaList {
+		if _, ok := set[m.Key]; !ok && strings.HasPrefix(m.Key, prefix) {
+			stale = append(stale, m.Key)
+		}
+	}
+	return stale
+}
+
+// MetadataUpdate is an update of the Host metadata keys owned by Host Manager. It is applied on the latest
+// metadata of the Host, read from Inventory right before the update, so that the keys of the users are kept.
+type MetadataUpdate struct {
+	// Set are the keys to set, with their values.
+	Set map[string]string
+	// Deleted are the keys to delete.
+	Deleted []string
+	// Prefixes are the prefixes of the keys owned by Host Manager, e.g. the ones of the labels. The keys with
+	// one of these prefixes that are not set are deleted.
+	Prefixes []string
+}
+
+// IsEmpty returns true if the update changes no key.
+func (u *MetadataUpdate) IsEmpty() bool {
+	return len(u.Set) == 0 && len(u.Deleted) == 0 && len(u.Prefixes) == 0
+}
+
+// Apply returns the metadata with the keys of the update set and deleted, the other keys are kept.
+func (u *MetadataUpdate) Apply(metadata string) (string, error) {
+	metaList, err := ParseMetadata(metadata)
+	if err != nil {
+		return "", errors.Errorfc(codes.InvalidArgument, "invalid input: metadata deserialization error")
+	}
+	deleted := slices.Clone(u.Deleted)
+	for _, prefix := range u.Prefixes {
+		deleted = append(deleted, StaleMetadataKeys(metaList, prefix, u.Set)...)
+	}
+	return MergeMetadata(metadata, u.Set, deleted...)
+}
+
+// OSInfoMetadata returns the Host metadata describing the running OS. The kernel config is summarized
+// by its digest, it is too large for the metadata.
+func OSInfoMetadata(osInfo *pb.OsInfo) map[string]string {
+	config := slices.Clone(osInfo.GetKernel().GetConfig())
+	slices.SortFunc(config, func(a, b *pb.Config) int { return strings.Compare(a.GetKey(), b.GetKey()) })
+	digest := sha256.New()
+	for _, c := range config {
+		digest.Write([]byte(c.GetKey() + "=" + c.GetValue() + "\n"))
+	}
+	return map[string]string{
+		OSKernelVersionMetadataKey: osInfo.GetKernel().GetVersion(),
+		OSKernelConfigMetadataKey:  hex.EncodeToString(digest.Sum(nil)),
+		OSReleaseMetadataKey:       strings.TrimSpace(osInfo.GetRelease().GetId() + " " + osInfo.GetRelease().GetVersion()),
+	}
+}
+
+// OSDrift compares the running OS release with the OS that Inventory thinks is installed on the Instance
+// and returns the differences, or an empty string if there is none. The image ID and profile name are
+// compared only when both the Instance OS and the agent report them.
+func OSDrift(instanceOS *osv1.OperatingSystemResource, release *pb.OsRelease) string {
+	reported := make(map[string]string, len(release.GetMetadata()))
+	for _, m := range release.GetMetadata() {
+		reported[m.GetKey()] = m.GetValue()
+	}
+	var drift []string
+	for _, field := range []struct {
+		name, expected, running string
+	}{
+		{"image_id", instanceOS.GetImageId(), reported[OSReleaseImageIDKey]},
+		{"profile_name", instanceOS.GetProfileName(), reported[OSReleaseProfileNameKey]},
+	} {
+		if field.expected != "" && field.running != "" && field.expected != field.running {
+			drift = append(drift, fmt.Sprintf("%s: expected %s, running %s", field.name, field.expected, field.running))
+		}
+	}
+	return strings.Join(drift, "; ")
+}
+
 // PopulateHostResourceWithNewSystemInfo function gets on input System Information to be updated.
 // It constructs a Host resource structure with an updated System Information. Fields not present in
 // the System Information are automatically being set to 'nil'. Fieldmask for System Information is
 // being produced for future update of the Host resource.
-// NIC/Storage/USBs resources are handled in different functions.
+// NIC/Storage/USBs resources are handled in different functions. The kubeconfig of the cluster info
+// is a secret, only a reference to it is kept in the Host metadata (see KubeconfigRefMetadataKey).
 //
 //nolint:cyclop,funlen,nolintlint // complexity is 11
 func PopulateHostResourceWithNewSystemInfo(systemInfo *pb.SystemInfo) (
@@ -292,26 +464,12 @@ func PopulateHostResourceWithNewSystemInfo(systemInfo *pb.SystemInfo) (
 		hr.BiosReleaseDate = systemInfo.BiosInfo.ReleaseDate
 	}
 
-	if systemInfo.KcInfo != nil {
-		// Metadata has the following format in the inventory: [{"key": "my-key", "value": "my-value"}, ...]
-		metaList, err := ParseMetadata(hr.Metadata)
-		if err != nil {
-			return nil, nil, errors.Errorfc(codes.InvalidArgument, "invalid input: metadata deserialization error")
-		}
-
-		// Ensure that kubeconfig is always updated in the metadata, even if there are duplicate keys in the metadata.
-		metaMap, err := MetadataToMetaMap(metaList)
-		if err != nil {
-			zlog.InfraSec().Info().Msgf("Duplicate keys found in metadata, will overwrite the duplicated keys. Keys: %v", err)
-		}
-
-		metaMap["kubeconfig"] = systemInfo.KcInfo.Kubeconfig
-
-		metadata, err := SerializeMetadata(metaMap)
-		if err != nil {
-			return nil, nil, errors.Errorfc(codes.InvalidArgument, "invalid input: metadata serialization error")
+	if systemInfo.BmCtlInfo != nil {
+		// The BMC is only updated when reported, it may have been configured by the user otherwise
+		if err := populateBmcInfo(hr, systemInfo.BmCtlInfo); err != nil {
+			return nil, nil, err
 		}
-		hr.Metadata = metadata
+		fieldmask = append(fieldmask, computev1.HostResourceFieldBmcKind, computev1.HostResourceFieldBmcIp)
 	}
 
 	// adding all expected fields to get updated by invclient.UpdateHostResource function by default
@@ -322,13 +480,45 @@ func PopulateHostResourceWithNewSystemInfo(systemInfo *pb.SystemInfo) (
 		computev1.HostResourceFieldCpuThreads, computev1.HostResourceFieldCpuCapabilities,
 		computev1.HostResourceFieldCpuTopology,
 		computev1.HostResourceFieldBiosVendor, computev1.HostResourceFieldBiosVersion,
-		computev1.HostResourceFieldBiosReleaseDate, computev1.HostResourceFieldMetadata)
+		computev1.HostResourceFieldBiosReleaseDate)
 
 	return hr, &fieldmaskpb.FieldMask{
 		Paths: fieldmask,
 	}, nil
 }
 
+// populateBmcInfo sets the BMC kind and IP of the Host. The BMC credentials are secrets, they are not
+// part of the Host resource.
+func populateBmcInfo(hr *computev1.HostResource, bmInfo *pb.BmInfo) error {
+	hr.BmcKind = mapBmTypeToBmcKind[bmInfo.GetBmType()]
+	bmcIP := bmInfo.GetBmcInfo().GetBmIp()
+	if bmcIP != "" {
+		if _, err := netip.ParseAddr(bmcIP); err != nil {
+			zlog.InfraSec().InfraError("%s is not a valid BMC IP address", bmcIP).Msg("")
+			return errors.Errorfc(codes.InvalidArgument, "%s is not a valid BMC IP address", bmcIP)
+		}
+	}
+	hr.BmcIp = bmcIP
+	return nil
+}
+
+// BmcIPAddress returns the address, in CIDR notation, of the given BMC IP linked to the BMC NIC.
+func BmcIPAddress(bmcIP string, hostNic *computev1.HostnicResource) (*network_v1.IPAddressResource, error) {
+	addr, err := netip.ParseAddr(bmcIP)
+	if err != nil {
+		return nil, errors.Errorfc(codes.InvalidArgument, "%s is not a valid BMC IP address", bmcIP)
+	}
+	return &network_v1.IPAddressResource{
+		TenantId:     hostNic.GetTenantId(),
+		Nic:          hostNic,
+		Address:      netip.PrefixFrom(addr, addr.BitLen()).String(),
+		Status:       network_v1.IPAddressStatus_IP_ADDRESS_STATUS_CONFIGURED,
+		StatusDetail: "IPAddress is configured",
+		CurrentState: network_v1.IPAddressState_IP_ADDRESS_STATE_CONFIGURED,
+		ConfigMethod: network_v1.IPAddressConfigMethod_IP_ADDRESS_CONFIG_METHOD_UNSPECIFIED,
+	}, nil
+}
+
 // PopulateHoststorageWithDiskInfo translates a system disk into an host storage resource.
 func PopulateHoststorageWithDiskInfo(disk *pb.SystemDisk, hostres *computev1.HostResource) (
 	*computev1.HoststorageResource, error,
@@ -470,6 +660,12 @@ func IsHostUntrusted(hostres *computev1.HostResource) bool {
 		hostres.GetDesiredState() == computev1.HostState_HOST_STATE_UNTRUSTED
 }
 
+// IsHostDiscovered checks if a host has been discovered by the Host Manager and is still waiting to be onboarded.
+func IsHostDiscovered(hostres *computev1.HostResource) bool {
+	return hostres.GetHostStatus() == hrm_status.HostStatusDiscovered.Status &&
+		hostres.GetCurrentState() != computev1.HostState_HOST_STATE_ONBOARDED
+}
+
 // IsHostUnderMaintain checks if a host is under maintenance.
 func IsHostUnderMaintain(hostres *computev1.HostResource) bool {
 	hostInstance := hostres.GetInstance()
@@ -481,6 +677,26 @@ func IsHostUnderMaintain(hostres *computev1.HostResource) bool {
 		hostInstance.UpdateStatus == mm_status.UpdateStatusInProgress.Status
 }
 
+// GetHostAction derives the action the agent should take from the Host's desired state in Inventory.
+func GetHostAction(hostres *computev1.HostResource) pb.HostStatusResp_HostAction {
+	switch {
+	case hostres.GetDesiredState() == computev1.HostState_HOST_STATE_UNTRUSTED ||
+		hostres.GetDesiredState() == computev1.HostState_HOST_STATE_DELETED:
+		return pb.HostStatusResp_INVALIDATE
+	case hostres.GetDesiredPowerState() == hostres.GetCurrentPowerState():
+		// Desired power state already reached, nothing to do
+		return pb.HostStatusResp_NONE
+	case hostres.GetDesiredPowerState() == computev1.PowerState_POWER_STATE_OFF:
+		return pb.HostStatusResp_SHUTDOWN
+	case hostres.GetDesiredPowerState() == computev1.PowerState_POWER_STATE_RESET ||
+		hostres.GetDesiredPowerState() == computev1.PowerState_POWER_STATE_POWER_CYCLE ||
+		hostres.GetDesiredPowerState() == computev1.PowerState_POWER_STATE_RESET_REPEAT:
+		return pb.HostStatusResp_RESTART
+	default:
+		return pb.HostStatusResp_NONE
+	}
+}
+
 // IsSameHost checks if two hosts are the same.
 func IsSameHost(
 	originalHostres *computev1.HostResource,
@@ -498,9 +714,66 @@ func IsSameHost(
 	return proto.Equal(clonedHostres, updatedHostres), nil
 }
 
-// IsSameHostStatus checks if two host statuses are the same.
+// SanitizeStatusDetail makes free text reported by an agent safe to store: control characters are
+// dropped, whitespaces are collapsed and the text is cut to MaxHostStatusDetailLength characters.
+func SanitizeStatusDetail(detail string) string {
+	detail = strings.Map(func(r rune) rune {
+		if r == utf8.RuneError || (!unicode.IsPrint(r) && !unicode.IsSpace(r)) {
+			return -1
+		}
+		return r
+	}, detail)
+	detail = strings.Join(strings.Fields(detail), " ")
+	if runes := []rune(detail); len(runes) > MaxHostStatusDetailLength {
+		detail = strings.TrimSpace(string(runes[:MaxHostStatusDetailLength]))
+	}
+	return detail
+}
+
+// HostStatusMetadata returns the Host metadata carrying the sanitized details of the reported status.
+// Empty details are left out, their keys are to be removed from the metadata.
+func HostStatusMetadata(status *pb.HostStatus) map[string]string {
+	metadata := make(map[string]string)
+	for key, value := range map[string]string{
+		HostStatusDetailsMetadataKey:   status.GetDetails(),
+		HostStatusMessageMetadataKey:   status.GetHumanReadableStatus(),
+		HostStatusErrorCodeMetadataKey: status.GetErrorCode(),
+	} {
+		if value = SanitizeStatusDetail(value); value != "" {
+			metadata[key] = value
+		}
+	}
+	return metadata
+}
+
+// HostStatusMetadataKeys are the keys of the Host metadata set by HostStatusMetadata.
+var HostStatusMetadataKeys = []string{
+	HostStatusDetailsMetadataKey,
+	HostStatusMessageMetadataKey,
+	HostStatusErrorCodeMetadataKey,
+}
+
+// IsSameHostStatus checks if two host statuses are the same, including the details stored in the metadata.
+// A running host with a skewed clock has the same status as a running one, only its indication differs.
 func IsSameHostStatus(hostres *computev1.HostResource, status *pb.HostStatus) bool {
-	return hostres.GetHostStatus() == GetHostStatus(status.GetHostStatus()).Status
+	if hostres.GetHostStatus() != GetHostStatus(status.GetHostStatus()).Status {
+		return false
+	}
+	metaList, err := ParseMetadata(hostres.GetMetadata())
+	if err != nil {
+		return false
+	}
+	current, err := MetadataToMetaMap(metaList)
+	if err != nil {
+		return false
+	}
+	reported := HostStatusMetadata(status)
+	for _, key := range HostStatusMetadataKeys {
+		if current[key] != reported[key] {
+			return false
+		}
+	}
+	return true
 }
 
 // IsSameInstanceStateStatusDetail checks if two instance state status details are the same.
@@ -592,3 +865,106 @@ func ProtoEqualSubset[T proto.Message](a, b T, includedFields ...string) bool {
 	// Compare the filtered messages
 	return proto.Equal(aClone, bClone)
 }
+
+// MatchResources pairs each reported resource with at most one current resource, using the given
+// identifiers from the most to the least reliable. An empty identifier is unknown and never matches.
+// Resources are only matched on an identifier if the more reliable ones, when known on both sides,
+// are the same: a replaced device reusing the name of the previous one is not mistaken for it.
+// Among several candidates, the pairs sharing most of the less reliable identifiers are preferred.
+// It returns, for each reported resource, the matching current resource or the zero value.
+func MatchResources[T any](reported, current []T, identifiers ...func(T) string) []T {
+	matches := make([]T, len(reported))
+	matched := make([]bool, len(reported))
+	taken := make([]bool, len(current))
+	// Identifiers are tried level by level, so that a reliable match is never stolen by a weaker one
+	for level := range identifiers {
+		for _, pair := range candidatePairs(reported, current, identifiers, level) {
+			if !matched[pair.reported] && !taken[pair.current] {
+				matches[pair.reported] = current[pair.current]
+				matched[pair.reported], taken[pair.current] = true, true
+			}
+		}
+	}
+	return matches
+}
+
+type candidatePair struct {
+	reported int
+	current  int
+	score    int
+}
+
+// candidatePairs returns the pairs of resources sharing the identifier at the given level,
+// sorted by the number of less reliable identifiers they share.
+func candidatePairs[T any](reported, current []T, identifiers []func(T) string, level int) []candidatePair {
+	var pairs []candidatePair
+	for i, res := range reported {
+		id := identifiers[level](res)
+		if id == "" {
+			continue
+		}
+		for j, candidate := range current {
+			if identifiers[level](candidate) != id || conflicting(res, candidate, identifiers[:level]) {
+				continue
+			}
+			score := 0
+			for _, identifier := range identifiers[level+1:] {
+				if identifier(res) == identifier(candidate) {
+					score++
+				}
+			}
+			pairs = append(pairs, candidatePair{reported: i, current: j, score: score})
+		}
+	}
+	slices.SortStableFunc(pairs, func(a, b candidatePair) int { return b.score - a.score })
+	return pairs
+}
+
+func conflicting[T any](a, b T, identifiers []func(T) string) bool {
+	for _, identifier := range identifiers {
+		idA, idB := identifier(a), identifier(b)
+		if idA != "" && idB != "" && idA != idB {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchHoststorages pairs the reported host storages with the current ones, using the WWID, then
+// the serial number, then the device name. Device names are not stable across reboots, a disk keeps
+// its resource even if its device name changes.
+func MatchHoststorages(reported, current []*computev1.HoststorageResource) []*computev1.HoststorageResource {
+	return MatchResources(reported, current,
+		(*computev1.HoststorageResource).GetWwid,
+		(*computev1.HoststorageResource).GetSerial,
+		(*computev1.HoststorageResource).GetDeviceName,
+	)
+}
+
+// MatchHostnics pairs the reported host NICs with the current ones, using the MAC address, then
+// the PCI identifier, then the interface name. Interface names are not stable across OS updates,
+// a NIC keeps its resource, and so its IP addresses, even if it is renamed.
+func MatchHostnics(reported, current []*computev1.HostnicResource) []*computev1.HostnicResource {
+	return MatchResources(reported, current,
+		func(nic *computev1.HostnicResource) string { return strings.ToLower(nic.GetMacAddr()) },
+		(*computev1.HostnicResource).GetPciIdentifier,
+		(*computev1.HostnicResource).GetDeviceName,
+	)
+}
+
+// MatchHostusbs pairs the reported host USBs with the current ones, using their bus and address.
+func MatchHostusbs(reported, current []*computev1.HostusbResource) []*computev1.HostusbResource {
+	return MatchResources(reported, current, func(usb *computev1.HostusbResource) string {
+		return strconv.FormatUint(uint64(usb.GetBus()), 10) + ":" + strconv.FormatUint(uint64(usb.GetAddr()), 10)
+	})
+}
+
+// MatchHostgpus pairs the reported host GPUs with the current ones, using their PCI identifier.
+func MatchHostgpus(reported, current []*computev1.HostgpuResource) []*computev1.HostgpuResource {
+	return MatchResources(reported, current, (*computev1.HostgpuResource).GetPciId)
+}
+
+// MatchIPAddresses pairs the reported IP addresses of a NIC with the current ones, using their CIDR notation.
+func MatchIPAddresses(reported, current []*network_v1.IPAddressResource) []*network_v1.IPAddressResource {
+	return MatchResources(reported, current, (*network_v1.IPAddressResource).GetAddress)
+}
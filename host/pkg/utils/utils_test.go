@@ -4,13 +4,16 @@
 package util_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
 	computev1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/compute/v1"
 	network_v1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/network/v1"
+	osv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/os/v1"
 	statusv1 "github.com/open-edge-platform/infra-core/inventory/v2/pkg/api/status/v1"
 	inv_testing "github.com/open-edge-platform/infra-core/inventory/v2/pkg/testing"
 	pb "github.com/open-edge-platform/infra-managers/host/pkg/api/hostmgr/proto"
@@ -476,6 +479,54 @@ func TestPopulateHostResourceWithNewSystemInfo(t *testing.T) {
 			},
 			fail: true,
 		},
+		{
+			name: "BmcInfo_Success",
+			args: args{
+				&pb.SystemInfo{
+					BmCtlInfo: &pb.BmInfo{
+						BmType: pb.BmInfo_VPRO,
+						BmcInfo: &pb.BmcInfo{
+							BmIp:       "10.0.0.10",
+							BmUsername: "admin",
+							BmPassword: "secret",
+						},
+					},
+				},
+			},
+			// The credentials are secrets, they are never part of the Host resource
+			want: &computev1.HostResource{
+				BmcKind: computev1.BaremetalControllerKind_BAREMETAL_CONTROLLER_KIND_VPRO,
+				BmcIp:   "10.0.0.10",
+			},
+			fail: false,
+		},
+		{
+			name: "BmcInfo_Redfish_Success",
+			args: args{
+				&pb.SystemInfo{
+					BmCtlInfo: &pb.BmInfo{
+						BmType:  pb.BmInfo_REDFISH,
+						BmcInfo: &pb.BmcInfo{BmIp: "fd00::10"},
+					},
+				},
+			},
+			want: &computev1.HostResource{
+				BmcKind: computev1.BaremetalControllerKind_BAREMETAL_CONTROLLER_KIND_UNSPECIFIED,
+				BmcIp:   "fd00::10",
+			},
+			fail: false,
+		},
+		{
+			name: "Failed_BmcInfo_InvalidIP",
+			args: args{
+				&pb.SystemInfo{
+					BmCtlInfo: &pb.BmInfo{
+						BmcInfo: &pb.BmcInfo{BmIp: "10.0.0.256"},
+					},
+				},
+			},
+			fail: true,
+		},
 		{
 			name: "ClusterInfo_Success",
 			args: args{
@@ -485,9 +536,8 @@ func TestPopulateHostResourceWithNewSystemInfo(t *testing.T) {
 					},
 				},
 			},
-			want: &computev1.HostResource{
-				Metadata: `[{"key":"kubeconfig","value":"test-kubeconfig-content"}]`,
-			},
+			// The kubeconfig is a secret, it is never part of the Host resource
+			want: &computev1.HostResource{},
 			fail: false,
 		},
 		{
@@ -506,7 +556,6 @@ func TestPopulateHostResourceWithNewSystemInfo(t *testing.T) {
 			want: &computev1.HostResource{
 				SerialNumber: "test-serial",
 				ProductName:  "test-product",
-				Metadata:     `[{"key":"kubeconfig","value":"test-kubeconfig-content"}]`,
 			},
 			fail: false,
 		},
@@ -519,9 +568,7 @@ func TestPopulateHostResourceWithNewSystemInfo(t *testing.T) {
 					},
 				},
 			},
-			want: &computev1.HostResource{
-				Metadata: `[{"key":"kubeconfig","value":"new-kubeconfig-content"}]`,
-			},
+			want: &computev1.HostResource{},
 			fail: false,
 		},
 		{
@@ -1081,6 +1128,120 @@ func TestIsHostUnderMaintain(t *testing.T) {
 	}
 }
 
+func TestIsHostDiscovered(t *testing.T) {
+	tests := []struct {
+		name string
+		host *computev1.HostResource
+		want bool
+	}{
+		{
+			name: "discovered",
+			host: &computev1.HostResource{
+				HostStatus:   hrm_status.HostStatusDiscovered.Status,
+				DesiredState: computev1.HostState_HOST_STATE_REGISTERED,
+			},
+			want: true,
+		},
+		{
+			name: "onboarded",
+			host: &computev1.HostResource{
+				HostStatus:   hrm_status.HostStatusDiscovered.Status,
+				CurrentState: computev1.HostState_HOST_STATE_ONBOARDED,
+			},
+		},
+		{
+			name: "registered by the user",
+			host: &computev1.HostResource{DesiredState: computev1.HostState_HOST_STATE_REGISTERED},
+		},
+		{
+			name: "running",
+			host: &computev1.HostResource{HostStatus: hrm_status.HostStatusRunning.Status},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, util.IsHostDiscovered(tt.host))
+		})
+	}
+}
+
+func TestGetHostAction(t *testing.T) {
+	tests := []struct {
+		name    string
+		hostres *computev1.HostResource
+		want    pb.HostStatusResp_HostAction
+	}{
+		{
+			name:    "NoDesiredState",
+			hostres: &computev1.HostResource{},
+			want:    pb.HostStatusResp_NONE,
+		},
+		{
+			name: "Untrusted",
+			hostres: &computev1.HostResource{
+				DesiredState:      computev1.HostState_HOST_STATE_UNTRUSTED,
+				DesiredPowerState: computev1.PowerState_POWER_STATE_OFF,
+			},
+			want: pb.HostStatusResp_INVALIDATE,
+		},
+		{
+			name:    "Deleted",
+			hostres: &computev1.HostResource{DesiredState: computev1.HostState_HOST_STATE_DELETED},
+			want:    pb.HostStatusResp_INVALIDATE,
+		},
+		{
+			name: "PowerOff",
+			hostres: &computev1.HostResource{
+				DesiredState:      computev1.HostState_HOST_STATE_ONBOARDED,
+				DesiredPowerState: computev1.PowerState_POWER_STATE_OFF,
+				CurrentPowerState: computev1.PowerState_POWER_STATE_ON,
+			},
+			want: pb.HostStatusResp_SHUTDOWN,
+		},
+		{
+			name: "AlreadyPoweredOff",
+			hostres: &computev1.HostResource{
+				DesiredState:      computev1.HostState_HOST_STATE_ONBOARDED,
+				DesiredPowerState: computev1.PowerState_POWER_STATE_OFF,
+				CurrentPowerState: computev1.PowerState_POWER_STATE_OFF,
+			},
+			want: pb.HostStatusResp_NONE,
+		},
+		{
+			name: "Reset",
+			hostres: &computev1.HostResource{
+				DesiredState:      computev1.HostState_HOST_STATE_ONBOARDED,
+				DesiredPowerState: computev1.PowerState_POWER_STATE_RESET,
+				CurrentPowerState: computev1.PowerState_POWER_STATE_ON,
+			},
+			want: pb.HostStatusResp_RESTART,
+		},
+		{
+			name: "PowerCycle",
+			hostres: &computev1.HostResource{
+				DesiredState:      computev1.HostState_HOST_STATE_ONBOARDED,
+				DesiredPowerState: computev1.PowerState_POWER_STATE_POWER_CYCLE,
+				CurrentPowerState: computev1.PowerState_POWER_STATE_ON,
+			},
+			want: pb.HostStatusResp_RESTART,
+		},
+		{
+			name: "PowerOn",
+			hostres: &computev1.HostResource{
+				DesiredState:      computev1.HostState_HOST_STATE_ONBOARDED,
+				DesiredPowerState: computev1.PowerState_POWER_STATE_ON,
+				CurrentPowerState: computev1.PowerState_POWER_STATE_OFF,
+			},
+			want: pb.HostStatusResp_NONE,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, util.GetHostAction(tt.hostres))
+		})
+	}
+}
+
 //nolint:funlen // this is a table-driven test, length is expected to be big
 func TestIsSameHostSystemInfo(t *testing.T) {
 	hostRes := &computev1.HostResource{
@@ -1932,3 +2093,392 @@ func TestProtoEqualSubset(t *testing.T) {
 		})
 	}
 }
+
+func TestOSInfoMetadata(t *testing.T) {
+	osInfo := &pb.OsInfo{
+		Kernel: &pb.OsKernel{
+			Version: "6.12.0-1-emt",
+			Config:  []*pb.Config{{Key: "CONFIG_SMP", Value: "y"}, {Key: "CONFIG_KVM", Value: "m"}},
+		},
+		Release: &pb.OsRelease{Id: "emt", Version: "3.0"},
+	}
+	metadata := util.OSInfoMetadata(osInfo)
+	assert.Equal(t, "6.12.0-1-emt", metadata[util.OSKernelVersionMetadataKey])
+	assert.Equal(t, "emt 3.0", metadata[util.OSReleaseMetadataKey])
+	assert.Len(t, metadata[util.OSKernelConfigMetadataKey], 64)
+
+	// The digest does not depend on the order of the kernel config
+	reordered, ok := proto.Clone(osInfo).(*pb.OsInfo)
+	require.True(t, ok)
+	reordered.Kernel.Config[0], reordered.Kernel.Config[1] = reordered.Kernel.Config[1], reordered.Kernel.Config[0]
+	assert.Equal(t, metadata, util.OSInfoMetadata(reordered))
+	reordered.Kernel.Config[0].Value = "y"
+	assert.NotEqual(t, metadata[util.OSKernelConfigMetadataKey],
+		util.OSInfoMetadata(reordered)[util.OSKernelConfigMetadataKey])
+}
+
+func TestOSDrift(t *testing.T) {
+	instanceOS := &osv1.OperatingSystemResource{ImageId: "3.0.20250101", ProfileName: "microvisor-nonrt"}
+	release := func(metadata ...*pb.Metadata) *pb.OsRelease {
+		return &pb.OsRelease{Id: "emt", Version: "3.0", Metadata: metadata}
+	}
+	testCases := map[string]struct {
+		instanceOS *osv1.OperatingSystemResource
+		release    *pb.OsRelease
+		expected   string
+	}{
+		"NotReported": {
+			instanceOS: instanceOS,
+			release:    release(),
+		},
+		"SameImage": {
+			instanceOS: instanceOS,
+			release: release(&pb.Metadata{Key: util.OSReleaseImageIDKey, Value: "3.0.20250101"},
+				&pb.Metadata{Key: util.OSReleaseProfileNameKey, Value: "microvisor-nonrt"}),
+		},
+		"Reimaged": {
+			instanceOS: instanceOS,
+			release: release(&pb.Metadata{Key: util.OSReleaseImageIDKey, Value: "3.0.20250601"},
+				&pb.Metadata{Key: util.OSReleaseProfileNameKey, Value: "microvisor-rt"}),
+			expected: "image_id: expected 3.0.20250101, running 3.0.20250601; " +
+				"profile_name: expected microvisor-nonrt, running microvisor-rt",
+		},
+		"NoImageInInventory": {
+			instanceOS: &osv1.OperatingSystemResource{ProfileName: "microvisor-nonrt"},
+			release:    release(&pb.Metadata{Key: util.OSReleaseImageIDKey, Value: "3.0.20250601"}),
+		},
+	}
+	for tcName, tc := range testCases {
+		t.Run(tcName, func(t *testing.T) {
+			assert.Equal(t, tc.expected, util.OSDrift(tc.instanceOS, tc.release))
+		})
+	}
+}
+
+func TestMergeMetadata(t *testing.T) {
+	testCases := map[string]struct {
+		metadata string
+		set      map[string]string
+		deleted  []string
+		expected string
+		valid    bool
+	}{
+		"Empty": {
+			set:      map[string]string{"b": "2", "a": "1"},
+			expected: `[{"key":"a","value":"1"},{"key":"b","value":"2"}]`,
+			valid:    true,
+		},
+		"UserKeysKept": {
+			metadata: `[{"key":"site","value":"lab"},{"key":"kubeconfig-ref","value":"old"},{"key":"app","value":"x"}]`,
+			set:      map[string]string{"kubeconfig-ref": "new"},
+			expected: `[{"key":"site","value":"lab"},{"key":"kubeconfig-ref","value":"new"},{"key":"app","value":"x"}]`,
+			valid:    true,
+		},
+		"DeletedAndDuplicatedKeys": {
+			metadata: `[{"key":"kubeconfig","value":"secret"},{"key":"ref","value":"1"},{"key":"ref","value":"2"}]`,
+			set:      map[string]string{"ref": "3"},
+			deleted:  []string{"kubeconfig"},
+			expected: `[{"key":"ref","value":"3"}]`,
+			valid:    true,
+		},
+		"InvalidMetadata": {
+			metadata: `{"key":"site"}`,
+			valid:    false,
+		},
+	}
+	for tcName, tc := range testCases {
+		t.Run(tcName, func(t *testing.T) {
+			merged, err := util.MergeMetadata(tc.metadata, tc.set, tc.deleted...)
+			if !tc.valid {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expected, merged)
+		})
+	}
+}
+
+func TestMetadataUpdate_Apply(t *testing.T) {
+	metadata := `[{"key":"site","value":"lab"},{"key":"label/gpu","value":"true"},{"key":"os-drift","value":"x"}]`
+	testCases := map[string]struct {
+		update   util.MetadataUpdate
+		expected string
+	}{
+		"Empty": {
+			expected: metadata,
+		},
+		"SetAndDeleted": {
+			update: util.MetadataUpdate{Set: map[string]string{"os-release": "Ubuntu"}, Deleted: []string{"os-drift"}},
+			expected: `[{"key":"site","value":"lab"},{"key":"label/gpu","value":"true"},` +
+				`{"key":"os-release","value":"Ubuntu"}]`,
+		},
+		"StalePrefixedKeys": {
+			update: util.MetadataUpdate{Set: map[string]string{"label/cpu": "true"}, Prefixes: []string{"label/"}},
+			expected: `[{"key":"site","value":"lab"},{"key":"os-drift","value":"x"},` +
+				`{"key":"label/cpu","value":"true"}]`,
+		},
+	}
+	for tcName, tc := range testCases {
+		t.Run(tcName, func(t *testing.T) {
+			assert.Equal(t, tcName == "Empty", tc.update.IsEmpty())
+			applied, err := tc.update.Apply(metadata)
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expected, applied)
+		})
+	}
+
+	_, err := (&util.MetadataUpdate{}).Apply(`{"key":"site"}`)
+	assert.Error(t, err)
+}
+
+func TestSanitizeStatusDetail(t *testing.T) {
+	testCases := map[string]struct {
+		detail   string
+		expected string
+	}{
+		"Empty":               {detail: "", expected: ""},
+		"Unchanged":           {detail: "5 of 5 components are running", expected: "5 of 5 components are running"},
+		"CollapsedWhitespace": {detail: "  disk\tfull\n\non /var  ", expected: "disk full on /var"},
+		"ControlCharacters":   {detail: "disk\x1b[31m full\x00", expected: "disk[31m full"},
+		"InvalidUTF8":         {detail: "disk \xff\xfefull", expected: "disk full"},
+		"Truncated": {
+			detail:   strings.Repeat("é", util.MaxHostStatusDetailLength+10),
+			expected: strings.Repeat("é", util.MaxHostStatusDetailLength),
+		},
+	}
+	for tcName, tc := range testCases {
+		t.Run(tcName, func(t *testing.T) {
+			assert.Equal(t, tc.expected, util.SanitizeStatusDetail(tc.detail))
+		})
+	}
+}
+
+func TestIsSameHostStatus(t *testing.T) {
+	host := &computev1.HostResource{
+		HostStatus: hrm_status.HostStatusError.Status,
+		Metadata: `[{"key":"site","value":"lab"},{"key":"host-status-message","value":"disk full"},` +
+			`{"key":"host-status-error-code","value":"DISK_FULL"}]`,
+	}
+	testCases := map[string]struct {
+		status   *pb.HostStatus
+		expected bool
+	}{
+		"Same": {
+			status:   &pb.HostStatus{HostStatus: pb.HostStatus_ERROR, HumanReadableStatus: "disk  full", ErrorCode: "DISK_FULL"},
+			expected: true,
+		},
+		"OtherStatus": {
+			status:   &pb.HostStatus{HostStatus: pb.HostStatus_RUNNING, HumanReadableStatus: "disk full", ErrorCode: "DISK_FULL"},
+			expected: false,
+		},
+		"OtherMessage": {
+			status:   &pb.HostStatus{HostStatus: pb.HostStatus_ERROR, HumanReadableStatus: "disk failed", ErrorCode: "DISK_FULL"},
+			expected: false,
+		},
+		"NewDetails": {
+			status: &pb.HostStatus{
+				HostStatus: pb.HostStatus_ERROR, HumanReadableStatus: "disk full", ErrorCode: "DISK_FULL", Details: "/var",
+			},
+			expected: false,
+		},
+		"ClearedErrorCode": {
+			status:   &pb.HostStatus{HostStatus: pb.HostStatus_ERROR, HumanReadableStatus: "disk full"},
+			expected: false,
+		},
+	}
+	for tcName, tc := range testCases {
+		t.Run(tcName, func(t *testing.T) {
+			assert.Equal(t, tc.expected, util.IsSameHostStatus(host, tc.status))
+		})
+	}
+}
+
+//nolint:funlen // it is a table-driven test
+func TestMatchHoststorages(t *testing.T) {
+	current := []*computev1.HoststorageResource{
+		{ResourceId: "hoststorage-00000001", DeviceName: "sda", Wwid: "wwid1", Serial: "serial1"},
+		{ResourceId: "hoststorage-00000002", DeviceName: "sdb", Wwid: "wwid2", Serial: "serial2"},
+		{ResourceId: "hoststorage-00000003", DeviceName: "sdc", Serial: "serial3"},
+		{ResourceId: "hoststorage-00000004", DeviceName: "sdd"},
+	}
+	testCases := map[string]struct {
+		reported []*computev1.HoststorageResource
+		expected []string
+	}{
+		"Unchanged": {
+			reported: []*computev1.HoststorageResource{
+				{DeviceName: "sda", Wwid: "wwid1", Serial: "serial1"},
+				{DeviceName: "sdb", Wwid: "wwid2", Serial: "serial2"},
+				{DeviceName: "sdc", Serial: "serial3"},
+				{DeviceName: "sdd"},
+			},
+			expected: []string{"hoststorage-00000001", "hoststorage-00000002", "hoststorage-00000003", "hoststorage-00000004"},
+		},
+		"SwappedByWwid": {
+			reported: []*computev1.HoststorageResource{
+				{DeviceName: "sda", Wwid: "wwid2", Serial: "serial2"},
+				{DeviceName: "sdb", Wwid: "wwid1", Serial: "serial1"},
+			},
+			expected: []string{"hoststorage-00000002", "hoststorage-00000001"},
+		},
+		"RenamedBySerial": {
+			reported: []*computev1.HoststorageResource{
+				{DeviceName: "sdd", Serial: "serial3"},
+				{DeviceName: "sdc"},
+			},
+			// sdc is now sdd, the new sdc is another disk
+			expected: []string{"hoststorage-00000003", ""},
+		},
+		"SerialWithoutWwid": {
+			reported: []*computev1.HoststorageResource{
+				{DeviceName: "nvme0n1", Serial: "serial1"},
+			},
+			expected: []string{"hoststorage-00000001"},
+		},
+		"ReplacedDiskWithSameName": {
+			reported: []*computev1.HoststorageResource{
+				{DeviceName: "sda", Wwid: "wwid9", Serial: "serial9"},
+				{DeviceName: "sdc", Serial: "serial8"},
+			},
+			expected: []string{"", ""},
+		},
+		"NewDisks": {
+			reported: []*computev1.HoststorageResource{
+				{DeviceName: "sde", Wwid: "wwid5"},
+				{DeviceName: "sdf"},
+			},
+			expected: []string{"", ""},
+		},
+		"SameWwidPreferSameName": {
+			// Multipath devices report the same WWID
+			reported: []*computev1.HoststorageResource{
+				{DeviceName: "sdb", Wwid: "wwid1", Serial: "serial1"},
+				{DeviceName: "sda", Wwid: "wwid1", Serial: "serial1"},
+			},
+			expected: []string{"", "hoststorage-00000001"},
+		},
+		"SameSerialPreferSameName": {
+			reported: []*computev1.HoststorageResource{
+				{DeviceName: "sdx", Serial: "serial3"},
+				{DeviceName: "sdc", Serial: "serial3"},
+			},
+			expected: []string{"", "hoststorage-00000003"},
+		},
+		"NoCurrent": {
+			reported: []*computev1.HoststorageResource{{DeviceName: "sda", Wwid: "wwid1"}},
+			expected: []string{""},
+		},
+		"NoReported": {
+			reported: []*computev1.HoststorageResource{},
+			expected: []string{},
+		},
+	}
+	for tcName, tc := range testCases {
+		t.Run(tcName, func(t *testing.T) {
+			currentStorages := current
+			if tcName == "NoCurrent" {
+				currentStorages = nil
+			}
+			matches := util.MatchHoststorages(tc.reported, currentStorages)
+			require.Len(t, matches, len(tc.reported))
+			matchedIDs := make([]string, 0, len(matches))
+			for _, match := range matches {
+				matchedIDs = append(matchedIDs, match.GetResourceId())
+			}
+			assert.Equal(t, tc.expected, matchedIDs)
+		})
+	}
+}
+
+func TestMatchHostnics(t *testing.T) {
+	current := []*computev1.HostnicResource{
+		{ResourceId: "hostnic-00000001", DeviceName: "eth0", MacAddr: "90:49:fa:07:6c:fa", PciIdentifier: "0000:00:1f.6"},
+		{ResourceId: "hostnic-00000002", DeviceName: "eth1", MacAddr: "90:49:fa:07:6c:fb", PciIdentifier: "0000:00:1f.7"},
+		{ResourceId: "hostnic-00000003", DeviceName: "bond0", MacAddr: "90:49:fa:07:6c:fa"},
+		{ResourceId: "hostnic-00000004", DeviceName: "ens3", PciIdentifier: "0000:03:00.0"},
+	}
+	testCases := map[string]struct {
+		reported []*computev1.HostnicResource
+		expected []string
+	}{
+		"RenamedByMac": {
+			reported: []*computev1.HostnicResource{
+				{DeviceName: "enp0s31f7", MacAddr: "90:49:FA:07:6C:FB", PciIdentifier: "0000:00:1f.7"},
+				{DeviceName: "enp0s31f6", MacAddr: "90:49:fa:07:6c:fa", PciIdentifier: "0000:00:1f.6"},
+			},
+			expected: []string{"hostnic-00000002", "hostnic-00000001"},
+		},
+		"SameMacPreferSameName": {
+			reported: []*computev1.HostnicResource{
+				{DeviceName: "bond0", MacAddr: "90:49:fa:07:6c:fa"},
+				{DeviceName: "eth0", MacAddr: "90:49:fa:07:6c:fa", PciIdentifier: "0000:00:1f.6"},
+			},
+			expected: []string{"hostnic-00000003", "hostnic-00000001"},
+		},
+		"RenamedByPci": {
+			reported: []*computev1.HostnicResource{
+				{DeviceName: "enp3s0", PciIdentifier: "0000:03:00.0"},
+			},
+			expected: []string{"hostnic-00000004"},
+		},
+		"ReplacedNic": {
+			reported: []*computev1.HostnicResource{
+				{DeviceName: "eth1", MacAddr: "90:49:fa:07:6c:ff", PciIdentifier: "0000:00:1f.7"},
+			},
+			expected: []string{""},
+		},
+		"MatchedByName": {
+			reported: []*computev1.HostnicResource{
+				{DeviceName: "ens3"},
+			},
+			expected: []string{"hostnic-00000004"},
+		},
+	}
+	for tcName, tc := range testCases {
+		t.Run(tcName, func(t *testing.T) {
+			matches := util.MatchHostnics(tc.reported, current)
+			require.Len(t, matches, len(tc.reported))
+			matchedIDs := make([]string, 0, len(matches))
+			for _, match := range matches {
+				matchedIDs = append(matchedIDs, match.GetResourceId())
+			}
+			assert.Equal(t, tc.expected, matchedIDs)
+		})
+	}
+}
+
+func TestMatchHostusbsAndHostgpus(t *testing.T) {
+	currentUsbs := []*computev1.HostusbResource{
+		{ResourceId: "hostusb-00000001", Bus: 1, Addr: 2},
+		{ResourceId: "hostusb-00000002", Bus: 2, Addr: 1},
+	}
+	usbs := util.MatchHostusbs([]*computev1.HostusbResource{{Bus: 2, Addr: 1}, {Bus: 1, Addr: 3}}, currentUsbs)
+	require.Len(t, usbs, 2)
+	assert.Equal(t, "hostusb-00000002", usbs[0].GetResourceId())
+	assert.Nil(t, usbs[1])
+
+	currentGpus := []*computev1.HostgpuResource{
+		{ResourceId: "hostgpu-00000001", PciId: "0000:00:02.0"},
+	}
+	gpus := util.MatchHostgpus([]*computev1.HostgpuResource{{PciId: "0000:03:00.0"}, {PciId: "0000:00:02.0"}}, currentGpus)
+	require.Len(t, gpus, 2)
+	assert.Nil(t, gpus[0])
+	assert.Equal(t, "hostgpu-00000001", gpus[1].GetResourceId())
+}
+
+func TestMatchIPAddresses(t *testing.T) {
+	current := []*network_v1.IPAddressResource{
+		{ResourceId: "ipaddr-00000001", Address: "192.168.1.12/24"},
+		{ResourceId: "ipaddr-00000002", Address: "fe80::1/64"},
+	}
+	reported := []*network_v1.IPAddressResource{
+		{Address: "fe80::1/64"},
+		{Address: "192.168.1.12/16"},
+	}
+	matches := util.MatchIPAddresses(reported, current)
+	require.Len(t, matches, 2)
+	assert.Equal(t, "ipaddr-00000002", matches[0].GetResourceId())
+	// The prefix length is part of the address
+	assert.Nil(t, matches[1])
+}
@@ -0,0 +1,18 @@
+# SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+# SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import rego.v1
+
+# This query checks if caller has write access to the admin service
+hasWriteAccess if {
+    some role in input["realm_access/roles"] # iteration
+    regex.match("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}_im-rw$", role)
+}
+
+# This query checks if caller has read access to the admin service
+hasReadAccess if {
+    some role in input["realm_access/roles"] # iteration
+    regex.match("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}_im-rw?$", role)
+}
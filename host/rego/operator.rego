@@ -0,0 +1,21 @@
+# SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+# SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import rego.v1
+
+# The settings of the admin service shared by all tenants can only be changed by the operators
+# of the platform. Their role is not scoped to a project, the project roles are never enough.
+
+# This query checks if caller has write access to the settings shared by all tenants
+hasWriteAccess if {
+    some role in input["realm_access/roles"] # iteration
+    role == "hostmgr-operator"
+}
+
+# This query checks if caller has read access to the settings shared by all tenants
+hasReadAccess if {
+    some role in input["realm_access/roles"] # iteration
+    role == "hostmgr-operator"
+}
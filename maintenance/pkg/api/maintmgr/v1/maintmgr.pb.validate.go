@@ -234,6 +234,10 @@ func (m *PlatformUpdateStatusRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for SequenceNumber
+
+	// no validation rules for AgentTimestampNs
+
 	if len(errors) > 0 {
 		return PlatformUpdateStatusRequestMultiError(errors)
 	}
@@ -16,13 +16,19 @@ import (
 	pb "github.com/open-edge-platform/infra-managers/maintenance/pkg/api/maintmgr/v1"
 	mmgr_error "github.com/open-edge-platform/infra-managers/maintenance/pkg/errors"
 	"github.com/open-edge-platform/infra-managers/maintenance/pkg/invclient"
+	"github.com/open-edge-platform/infra-managers/maintenance/pkg/ordering"
 	maintgmr_util "github.com/open-edge-platform/infra-managers/maintenance/pkg/utils"
 )
 
+// streamUpdateStatus is the only stream of updates sent by the agents to the Maintenance Manager.
+const streamUpdateStatus = "update_status"
+
 type server struct {
 	pb.UnimplementedMaintmgrServiceServer
 	rbac        *rbac.Policy
 	authEnabled bool
+	// updates orders the update statuses of each host
+	updates *ordering.Tracker
 }
 
 //nolint:cyclop,funlen // cyclomatic complexity is 11 due to validation logic
@@ -55,6 +61,10 @@ func (s *server) PlatformUpdateStatus(ctx context.Context,
 	}
 	zlog.Debug().Msgf("PlatformUpdateStatus: tenantID=%s", tenantID)
 
+	// The update statuses of a host are handled one at a time, from reading its Instance until the update is recorded
+	updates := s.updates.Lock(tenantID, guid)
+	defer updates.Unlock()
+
 	hostRes, instRes, err := getHostAndInstanceFromUUID(ctx, tenantID, guid)
 	if err != nil {
 		return nil, err
@@ -74,7 +84,15 @@ func (s *server) PlatformUpdateStatus(ctx context.Context,
 		return nil, inv_errors.Errorfc(codes.FailedPrecondition, "")
 	}
 
-	updateInventory(ctx, invMgrCli.InvClient, tenantID, in.GetUpdateStatus(), instRes)
+	// Late and replayed update statuses are ignored, the schedules are still returned
+	stamp := ordering.Stamp{Timestamp: in.GetAgentTimestampNs(), Sequence: in.GetSequenceNumber()}
+	if updates.IsStale(streamUpdateStatus, stamp) {
+		zlog.Warn().Msgf("Ignore stale update status (timestamp=%d, sequence=%d) of host tID=%s, UUID=%s",
+			stamp.Timestamp, stamp.Sequence, tenantID, guid)
+	} else {
+		updateInventory(ctx, invMgrCli.InvClient, tenantID, in.GetUpdateStatus(), instRes)
+		updates.Record(streamUpdateStatus, stamp)
+	}
 
 	ssRes, err := invclient.ListSingleSchedules(ctx, invMgrCli, tenantID, hostRes)
 	if err != nil {
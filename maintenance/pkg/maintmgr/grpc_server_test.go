@@ -401,6 +401,41 @@ func TestServer_PlatformUpdateStatus_Isolation(t *testing.T) {
 	})
 }
 
+func TestServer_PlatformUpdateStatus_StaleUpdates(t *testing.T) {
+	dao := inv_testing.NewInvResourceDAOOrFail(t)
+	ctx, cancel := inv_testing.CreateContextWithENJWT(t, mm_testing.Tenant1)
+	defer cancel()
+	client := inv_testing.TestClients[inv_testing.RMClient].GetTenantAwareInventoryClient()
+
+	os := dao.CreateOs(t, mm_testing.Tenant1)
+	h := mm_testing.HostResource1 //nolint:govet // ok to copy locks in test
+	h.Uuid = uuid.NewString()
+	host := mm_testing.CreateHost(t, mm_testing.Tenant1, &h)
+	inst := dao.CreateInstanceWithOpts(t, mm_testing.Tenant1, host, os, true, func(inst *computev1.InstanceResource) {
+		inst.ProvisioningStatus = om_status.ProvisioningStatusDone.Status
+		inst.ProvisioningStatusIndicator = om_status.ProvisioningStatusDone.StatusIndicator
+	})
+
+	updateStatus := func(statusType pb.UpdateStatus_StatusType, timestamp uint64, expected inv_status.ResourceStatus) {
+		t.Helper()
+		_, err := MaintManagerTestClient.PlatformUpdateStatus(ctx, &pb.PlatformUpdateStatusRequest{
+			HostGuid:         host.Uuid,
+			UpdateStatus:     &pb.UpdateStatus{StatusType: statusType},
+			AgentTimestampNs: timestamp,
+		})
+		require.NoError(t, err)
+		gResp, err := client.Get(ctx, mm_testing.Tenant1, inst.ResourceId)
+		require.NoError(t, err)
+		assert.Equal(t, expected.Status, gResp.GetResource().GetInstance().GetUpdateStatus())
+	}
+
+	updateStatus(pb.UpdateStatus_STATUS_TYPE_UNSPECIFIED, 200, mm_status.UpdateStatusUnknown)
+	// A delayed update status cannot overwrite a newer one, nor a replayed one
+	updateStatus(pb.UpdateStatus_STATUS_TYPE_UP_TO_DATE, 100, mm_status.UpdateStatusUnknown)
+	updateStatus(pb.UpdateStatus_STATUS_TYPE_UP_TO_DATE, 200, mm_status.UpdateStatusUnknown)
+	updateStatus(pb.UpdateStatus_STATUS_TYPE_UP_TO_DATE, 300, mm_status.UpdateStatusUpToDate)
+}
+
 func Test_DenyRBAC(t *testing.T) {
 	dao := inv_testing.NewInvResourceDAOOrFail(t)
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
@@ -26,6 +26,7 @@ import (
 	"github.com/open-edge-platform/infra-core/inventory/v2/pkg/tracing"
 	pb "github.com/open-edge-platform/infra-managers/maintenance/pkg/api/maintmgr/v1"
 	"github.com/open-edge-platform/infra-managers/maintenance/pkg/invclient"
+	"github.com/open-edge-platform/infra-managers/maintenance/pkg/ordering"
 )
 
 var zlog = logging.GetLogger("MaintenanceManager")
@@ -36,6 +37,9 @@ var eventsWatcherBufSize = 10
 // TODO(max): remove global instances.
 var invMgrCli invclient.InvGrpcClient
 
+// updateStatuses orders the update statuses of each host, the deleted hosts are forgotten on their deletion event.
+var updateStatuses = ordering.NewTracker()
+
 // EnableAuth enables authentication for the maintenance manager.
 func EnableAuth(enable bool) Option {
 	return func(o *Options) {
@@ -165,15 +169,27 @@ func StartInvGrpcCli(
 
 	go func() {
 		for {
-			_, ok := <-events
+			ev, ok := <-events
 			if !ok {
 				zlog.InfraSec().Fatal().Msg("gRPC stream with inventory closed")
 			}
+			forgetDeletedHost(ev.Event)
 		}
 	}()
 	return nil
 }
 
+// forgetDeletedHost drops the update statuses of a Host removed from Inventory,
+// a Host onboarded again with the same UUID starts a new sequence of updates.
+func forgetDeletedHost(event *inv_v1.SubscribeEventsResponse) {
+	if event.GetEventKind() != inv_v1.SubscribeEventsResponse_EVENT_KIND_DELETED {
+		return
+	}
+	if host := event.GetResource().GetHost(); host != nil {
+		updateStatuses.Forget(host.GetTenantId(), host.GetUuid())
+	}
+}
+
 // SetInvGrpcCli sets the inventory gRPC client instance.
 func SetInvGrpcCli(cli invclient.InvGrpcClient) {
 	invMgrCli = cli
@@ -251,6 +267,7 @@ func StartGrpcSrv(
 	pb.RegisterMaintmgrServiceServer(s, &server{
 		rbac:        opaPolicy,
 		authEnabled: opts.enableAuth,
+		updates:     updateStatuses,
 	})
 	// enable reflection
 	reflection.Register(s)
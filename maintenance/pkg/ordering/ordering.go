@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: (C) 2026 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ordering protects the updates sent by the agents against late and replayed messages.
+package ordering
+
+import (
+	"strings"
+	"sync"
+)
+
+// Stamp is the position of an update in the updates sent by an agent.
+type Stamp struct {
+	// Timestamp is the UTC Unix time in nanoseconds when the agent produced the update, 0 if unknown.
+	Timestamp uint64
+	// Sequence is the sequence number of the update, 0 if unknown.
+	Sequence uint64
+}
+
+// IsZero reports whether the stamp is empty, i.e. the agent does not order its updates.
+func (s Stamp) IsZero() bool {
+	return s == Stamp{}
+}
+
+// After reports whether s comes after o. The sequence numbers are authoritative, the timestamps are only
+// compared when the sequence numbers are equal or one of the stamps has no sequence number, so that
+// a step of the clock of the agent does not reorder its updates.
+func (s Stamp) After(o Stamp) bool {
+	if s.Sequence != 0 && o.Sequence != 0 && s.Sequence != o.Sequence {
+		return s.Sequence > o.Sequence
+	}
+	if s.Timestamp != o.Timestamp {
+		return s.Timestamp > o.Timestamp
+	}
+	return s.Sequence > o.Sequence
+}
+
+type hostKey struct {
+	tenantID string
+	hostGUID string
+}
+
+func newHostKey(tenantID, hostGUID string) hostKey {
+	return hostKey{tenantID: tenantID, hostGUID: strings.ToLower(hostGUID)}
+}
+
+// Tracker keeps the stamp of the last update applied for each host and stream of updates, e.g. each RPC.
+type Tracker struct {
+	mu    sync.Mutex
+	hosts map[hostKey]*HostUpdates
+}
+
+// NewTracker returns a tracker without any update.
+func NewTracker() *Tracker {
+	return &Tracker{hosts: make(map[hostKey]*HostUpdates)}
+}
+
+// Lock locks the updates of the host and returns them. The lock is held from the staleness check of an update
+// until it is recorded, so that concurrent updates of the host are applied one at a time and in order.
+func (t *Tracker) Lock(tenantID, hostGUID string) *HostUpdates {
+	t.mu.Lock()
+	k := newHostKey(tenantID, hostGUID)
+	updates, ok := t.hosts[k]
+	if !ok {
+		updates = &HostUpdates{last: make(map[string]Stamp)}
+		t.hosts[k] = updates
+	}
+	t.mu.Unlock()
+
+	updates.mu.Lock()
+	return updates
+}
+
+// Forget drops the updates of a deleted host. A host onboarded again with the same GUID starts from scratch.
+func (t *Tracker) Forget(tenantID, hostGUID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.hosts, newHostKey(tenantID, hostGUID))
+}
+
+// HostUpdates are the last updates applied to a host, by stream. They are only used while locked.
+type HostUpdates struct {
+	mu   sync.Mutex
+	last map[string]Stamp
+}
+
+// Unlock releases the updates of the host.
+func (u *HostUpdates) Unlock() {
+	u.mu.Unlock()
+}
+
+// IsStale reports whether the update has been superseded by the last one of the stream, or is a replay of it.
+// Updates without a stamp are never stale.
+func (u *HostUpdates) IsStale(stream string, stamp Stamp) bool {
+	if stamp.IsZero() {
+		return false
+	}
+	last, ok := u.last[stream]
+	return ok && !stamp.After(last)
+}
+
+// Record marks the update as applied. It has to be called once the update is successfully applied,
+// so that a failed update can be retried with the same stamp.
+func (u *HostUpdates) Record(stream string, stamp Stamp) {
+	if stamp.IsZero() {
+		return
+	}
+	if last, ok := u.last[stream]; !ok || stamp.After(last) {
+		u.last[stream] = stamp
+	}
+}